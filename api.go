@@ -2,11 +2,13 @@ package main
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -19,7 +21,8 @@ var (
 
 // HTTP client with timeout
 var httpClient = &http.Client{
-	Timeout: 30 * time.Second,
+	Timeout:   30 * time.Second,
+	Transport: upstreamTransport,
 }
 
 // TrueFinals API structures based on OpenAPI spec
@@ -205,6 +208,7 @@ type TournamentListItem struct {
 	Privacy    string `json:"privacy"`
 	CreateTime int64  `json:"createTime"`
 	EndTime    *int64 `json:"endTime"`
+	IsTest     bool   `json:"is_test,omitempty"`
 }
 
 // makeAPIRequest performs HTTP requests to the TrueFinals API
@@ -232,6 +236,7 @@ func makeAPIRequest(method, endpoint string, body interface{}) ([]byte, error) {
 	req.Header.Set("x-api-key", apiKey)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Accept-Encoding", "gzip")
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
@@ -239,7 +244,7 @@ func makeAPIRequest(method, endpoint string, body interface{}) ([]byte, error) {
 	}
 	defer resp.Body.Close()
 
-	responseBody, err := io.ReadAll(resp.Body)
+	responseBody, err := readResponseBody(resp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
@@ -256,6 +261,110 @@ func makeAPIRequest(method, endpoint string, body interface{}) ([]byte, error) {
 	return responseBody, nil
 }
 
+// trueFinalsTime converts a TrueFinals timestamp, which may be in seconds or
+// milliseconds since the epoch, to a time.Time
+func trueFinalsTime(ts int64) time.Time {
+	if ts > 1e12 {
+		return time.UnixMilli(ts)
+	}
+	return time.Unix(ts, 0)
+}
+
+// readResponseBody reads the full response body, transparently decoding gzip.
+// Go's transport only decompresses automatically when it set Accept-Encoding
+// itself, so requests that set the header explicitly must decode here.
+func readResponseBody(resp *http.Response) ([]byte, error) {
+	reader, err := responseBodyReader(resp)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(reader)
+}
+
+// responseBodyReader returns a reader over the decoded response body, for
+// callers that want to stream it instead of reading it all at once
+func responseBodyReader(resp *http.Response) (io.Reader, error) {
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		gzipReader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create gzip reader: %w", err)
+		}
+		return gzipReader, nil
+	}
+	return resp.Body, nil
+}
+
+// jsonShape reports the kind of JSON value in data: "object", "array",
+// "string", "number", "boolean", "null", "empty", or "invalid" for anything
+// that is not well-formed JSON (an HTML error page, a truncated body)
+func jsonShape(data []byte) string {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return "empty"
+	}
+	if !json.Valid(trimmed) {
+		return "invalid"
+	}
+	switch trimmed[0] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	default:
+		return "number"
+	}
+}
+
+// expectJSONShape returns a descriptive error unless data holds a JSON value
+// of the wanted shape, so a response of the wrong kind fails with what was
+// actually received instead of an opaque unmarshal error
+func expectJSONShape(data []byte, want string) error {
+	switch shape := jsonShape(data); shape {
+	case want:
+		return nil
+	case "empty", "invalid":
+		return fmt.Errorf("expected a JSON %s but the response was %s", want, shape)
+	default:
+		return fmt.Errorf("expected a JSON %s but got a JSON %s", want, shape)
+	}
+}
+
+// decodeJSONObject decodes a TrueFinals response that should be a single object
+func decodeJSONObject(data []byte) (map[string]interface{}, error) {
+	if err := expectJSONShape(data, "object"); err != nil {
+		return nil, err
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// decodeJSONArray decodes a TrueFinals response that should be a list
+func decodeJSONArray(data []byte) ([]interface{}, error) {
+	return decodeJSONArrayOf[interface{}](data)
+}
+
+// decodeJSONArrayOf decodes a TrueFinals list response into typed elements,
+// with the same shape check as decodeJSONArray
+func decodeJSONArrayOf[T any](data []byte) ([]T, error) {
+	if err := expectJSONShape(data, "array"); err != nil {
+		return nil, err
+	}
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 // buildQueryParams builds query parameters for GET requests
 func buildQueryParams(params map[string]interface{}) string {
 	if len(params) == 0 {
@@ -374,8 +483,8 @@ func getPlayersData(tournamentID string) (interface{}, error) {
 		return nil, err
 	}
 
-	var players []interface{}
-	if err := json.Unmarshal(data, &players); err != nil {
+	players, err := decodeJSONArray(data)
+	if err != nil {
 		return nil, err
 	}
 
@@ -390,8 +499,8 @@ func getLocationsData(tournamentID string) (interface{}, error) {
 		return nil, err
 	}
 
-	var locations []interface{}
-	if err := json.Unmarshal(data, &locations); err != nil {
+	locations, err := decodeJSONArray(data)
+	if err != nil {
 		return nil, err
 	}
 
@@ -479,8 +588,41 @@ func enrichPlayerDataWithProfileDetails(player map[string]interface{}) map[strin
 	return player
 }
 
+// isTestTournament reports whether a tournament's title marks it as a test
+// event, matching "test" in any case (TEST, Test, test)
+func isTestTournament(title string) bool {
+	return strings.Contains(strings.ToLower(title), "test")
+}
+
+// flagTestTournament marks a tournament-scoped response with is_test when the
+// tournament is a test event, so it isn't reported as a real one. The flag is
+// left out for real events.
+func flagTestTournament(result map[string]interface{}, title string) {
+	if isTestTournament(title) {
+		result["is_test"] = true
+	}
+}
+
+// flagTestTournamentByID flags a response built from a tournament's
+// sub-resources, taking the title from the full tournament. The flag is
+// best-effort, so a failed lookup leaves it out rather than failing the call.
+func flagTestTournamentByID(result map[string]interface{}, tournamentID string) {
+	data, err := makeAPIRequest("GET", fmt.Sprintf("/v1/tournaments/%s", tournamentID), nil)
+	if err != nil {
+		return
+	}
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return
+	}
+	flagTestTournament(result, tournament.Title)
+}
+
 // EnrichTournamentData adds human-readable information to tournament data
 func enrichTournamentData(tournament map[string]interface{}) map[string]interface{} {
+	title, _ := tournament["title"].(string)
+	flagTestTournament(tournament, title)
+
 	// First apply existing TrueFinals enrichment
 	// Enrich players
 	if players, ok := tournament["players"].([]interface{}); ok {
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMakeAPIRequestDecodesGzip(t *testing.T) {
+	want := map[string]interface{}{"id": "t1", "title": "NHRL June"}
+	var acceptEncoding string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptEncoding = r.Header.Get("Accept-Encoding")
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(gzipJSON(t, want))
+	}))
+	defer server.Close()
+
+	previous := APIBaseURL
+	APIBaseURL = server.URL
+	defer func() { APIBaseURL = previous }()
+	resetUpstreamState()
+
+	data, err := makeAPIRequest("GET", "/v1/tournaments/t1", nil)
+	if err != nil {
+		t.Fatalf("makeAPIRequest: %v", err)
+	}
+	if acceptEncoding != "gzip" {
+		t.Errorf("Accept-Encoding = %q, want gzip", acceptEncoding)
+	}
+	got, err := decodeJSONObject(data)
+	if err != nil {
+		t.Fatalf("response was not decoded: %v (%q)", err, data)
+	}
+	if got["title"] != "NHRL June" {
+		t.Errorf("title = %v, want NHRL June", got["title"])
+	}
+}
+
+func TestMakeNHRLAPIRequestDecodesGzip(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.statsbook("get_dumpster_count.php", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(gzipJSON(t, []NHRLDumpsterCount{{BotName: "Lynx", First: 3}}))
+	})
+
+	counts, err := getNHRLDumpsterCount("1")
+	if err != nil {
+		t.Fatalf("getNHRLDumpsterCount: %v", err)
+	}
+	if len(counts) != 1 || counts[0].BotName != "Lynx" || counts[0].First != 3 {
+		t.Errorf("counts = %+v, want one Lynx row with 3 wins", counts)
+	}
+}
+
+func TestMakeAPIRequestPlainResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]string{"id": "t1"})
+	}))
+	defer server.Close()
+
+	previous := APIBaseURL
+	APIBaseURL = server.URL
+	defer func() { APIBaseURL = previous }()
+	resetUpstreamState()
+
+	data, err := makeAPIRequest("GET", "/v1/tournaments/t1", nil)
+	if err != nil {
+		t.Fatalf("makeAPIRequest: %v", err)
+	}
+	if got, err := decodeJSONObject(data); err != nil || got["id"] != "t1" {
+		t.Errorf("decodeJSONObject = %v, %v; want id t1", got, err)
+	}
+}
+
+func TestExpectJSONShape(t *testing.T) {
+	tests := []struct {
+		data    string
+		want    string
+		wantErr string
+	}{
+		{`{"id": "g1"}`, "object", ""},
+		{` [1, 2]`, "array", ""},
+		{`[{"id": "g1"}]`, "object", "expected a JSON object but got a JSON array"},
+		{`{"id": "g1"}`, "array", "expected a JSON array but got a JSON object"},
+		{`null`, "object", "expected a JSON object but got a JSON null"},
+		{``, "array", "expected a JSON array but the response was empty"},
+		{`<html>Bad Gateway</html>`, "object", "expected a JSON object but the response was invalid"},
+	}
+	for _, tt := range tests {
+		err := expectJSONShape([]byte(tt.data), tt.want)
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("expectJSONShape(%q, %s) = %v, want nil", tt.data, tt.want, err)
+		case tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr):
+			t.Errorf("expectJSONShape(%q, %s) = %v, want %q", tt.data, tt.want, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Circuit breaker settings shared by every upstream service
+const (
+	breakerFailureThreshold = 5
+	breakerCooldown         = 30 * time.Second
+)
+
+// errServiceUnavailable is returned while a service's circuit is open
+var errServiceUnavailable = errors.New("service temporarily unavailable")
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// circuitBreaker stops calling an upstream service after repeated failures.
+// After threshold consecutive failures it opens and fails fast for the
+// cooldown window, then half-opens to let a single probe request through:
+// success closes it again, failure re-opens it.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	service   string
+	threshold int
+	cooldown  time.Duration
+	state     breakerState
+	failures  int
+	openedAt  time.Time
+	probing   bool
+}
+
+// newCircuitBreaker creates a closed breaker for the named service
+func newCircuitBreaker(service string, threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		service:   service,
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+// allow reports whether a request may be sent, returning errServiceUnavailable if not
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if time.Since(b.openedAt) < b.cooldown {
+			return fmt.Errorf("%s: %w (retry in %s)", b.service, errServiceUnavailable,
+				(b.cooldown - time.Since(b.openedAt)).Round(time.Second))
+		}
+		b.state = breakerHalfOpen
+		b.probing = true
+		return nil
+	case breakerHalfOpen:
+		// Only one probe at a time while half-open
+		if b.probing {
+			return fmt.Errorf("%s: %w (recovery probe in progress)", b.service, errServiceUnavailable)
+		}
+		b.probing = true
+		return nil
+	}
+	return nil
+}
+
+// success records a successful request and closes the breaker
+func (b *circuitBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = breakerClosed
+	b.failures = 0
+	b.probing = false
+}
+
+// failure records a failed request, opening the breaker once the threshold is reached
+func (b *circuitBreaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+	if b.state == breakerHalfOpen || b.failures >= b.threshold {
+		b.state = breakerOpen
+		b.openedAt = time.Now()
+	}
+}
+
+// breakerTransport is an http.RoundTripper that routes each request through
+// a circuit breaker for its host, so every upstream service (TrueFinals, the
+// NHRL statsbook, BrettZone, the wiki) trips independently. Network errors
+// and 5xx responses count as failures; 4xx responses do not.
+type breakerTransport struct {
+	mu       sync.Mutex
+	base     http.RoundTripper
+	breakers map[string]*circuitBreaker
+}
+
+// newBreakerTransport wraps base (or http.DefaultTransport when nil)
+func newBreakerTransport(base http.RoundTripper) *breakerTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &breakerTransport{
+		base:     base,
+		breakers: make(map[string]*circuitBreaker),
+	}
+}
+
+// breakerFor returns the breaker for host, creating it on first use
+func (t *breakerTransport) breakerFor(host string) *circuitBreaker {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	breaker, ok := t.breakers[host]
+	if !ok {
+		breaker = newCircuitBreaker(host, breakerFailureThreshold, breakerCooldown)
+		t.breakers[host] = breaker
+	}
+	return breaker
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *breakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	breaker := t.breakerFor(req.URL.Host)
+	if err := breaker.allow(); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode >= 500 {
+		breaker.failure()
+	} else {
+		breaker.success()
+	}
+	return resp, err
+}
+
+// upstreamTransport is shared by all upstream HTTP clients
+var upstreamTransport = newBreakerTransport(nil)
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// statusTransport answers every request with the status for its host and
+// counts the requests that reach it
+type statusTransport struct {
+	status map[string]int
+	calls  map[string]int
+}
+
+func (rt *statusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.calls[req.URL.Host]++
+	return &http.Response{
+		StatusCode: rt.status[req.URL.Host],
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+func TestBreakerOpensAndFailsFast(t *testing.T) {
+	base := &statusTransport{
+		status: map[string]int{statsbookHost: http.StatusBadGateway, trueFinalsHost: http.StatusNotFound},
+		calls:  map[string]int{},
+	}
+	transport := newBreakerTransport(base)
+	get := func(host string) error {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, "https://"+host+"/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := transport.RoundTrip(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		return err
+	}
+
+	for i := 0; i < breakerFailureThreshold; i++ {
+		if err := get(statsbookHost); err != nil {
+			t.Fatalf("request %d failed before the breaker opened: %v", i+1, err)
+		}
+	}
+	start := time.Now()
+	err := get(statsbookHost)
+	if !errors.Is(err, errServiceUnavailable) {
+		t.Fatalf("request after %d failures = %v, want errServiceUnavailable", breakerFailureThreshold, err)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("open breaker took %s to fail, want an immediate failure", elapsed)
+	}
+	if base.calls[statsbookHost] != breakerFailureThreshold {
+		t.Errorf("statsbook saw %d requests, want %d", base.calls[statsbookHost], breakerFailureThreshold)
+	}
+
+	// Client errors do not count as failures, and each host trips on its own
+	for i := 0; i <= breakerFailureThreshold; i++ {
+		if err := get(trueFinalsHost); err != nil {
+			t.Fatalf("TrueFinals request %d = %v, want it to pass through", i+1, err)
+		}
+	}
+
+	// Once the cooldown has passed a single probe goes through, and its success closes the breaker
+	breaker := transport.breakerFor(statsbookHost)
+	breaker.mu.Lock()
+	breaker.openedAt = time.Now().Add(-breakerCooldown)
+	breaker.mu.Unlock()
+	base.status[statsbookHost] = http.StatusOK
+	if err := get(statsbookHost); err != nil {
+		t.Fatalf("probe after the cooldown = %v, want it sent", err)
+	}
+	if err := get(statsbookHost); err != nil {
+		t.Errorf("request after a successful probe = %v, want the breaker closed", err)
+	}
+}
+
+func TestBreakerFailedProbeReopens(t *testing.T) {
+	breaker := newCircuitBreaker("stats", 2, time.Minute)
+	breaker.failure()
+	breaker.failure()
+	if err := breaker.allow(); !errors.Is(err, errServiceUnavailable) {
+		t.Fatalf("allow after 2 failures = %v, want errServiceUnavailable", err)
+	}
+
+	breaker.openedAt = time.Now().Add(-time.Minute)
+	if err := breaker.allow(); err != nil {
+		t.Fatalf("allow after the cooldown = %v, want a probe", err)
+	}
+	if err := breaker.allow(); !errors.Is(err, errServiceUnavailable) {
+		t.Errorf("second request during the probe = %v, want errServiceUnavailable", err)
+	}
+	breaker.failure()
+	if err := breaker.allow(); !errors.Is(err, errServiceUnavailable) {
+		t.Errorf("allow after a failed probe = %v, want the breaker open again", err)
+	}
+}
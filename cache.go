@@ -0,0 +1,78 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// ttlCache is a small in-memory cache whose entries expire after a fixed TTL.
+// Expired entries are dropped when read, and set sweeps out the rest at most
+// once per TTL so keys that are never read again don't accumulate.
+type ttlCache struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	entries   map[string]ttlCacheEntry
+	lastSweep time.Time
+}
+
+type ttlCacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// newTTLCache creates a cache whose entries live for ttl
+func newTTLCache(ttl time.Duration) *ttlCache {
+	return &ttlCache{
+		ttl:     ttl,
+		entries: make(map[string]ttlCacheEntry),
+	}
+}
+
+// get returns the cached value for key if present and not expired
+func (c *ttlCache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// set stores value under key, replacing any existing entry
+func (c *ttlCache) set(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.lastSweep) >= c.ttl {
+		for k, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastSweep = now
+	}
+
+	c.entries[key] = ttlCacheEntry{
+		value:     value,
+		expiresAt: now.Add(c.ttl),
+	}
+}
+
+// NHRL statsbook data changes at most a few times per event, so short-lived
+// caching is safe and saves repeated fan-out requests
+var nhrlCache = newTTLCache(10 * time.Minute)
+
+// Weight class rosters are served from their own cache so the refresh
+// interval can be tuned independently (see --roster-refresh)
+var rosterCache = newTTLCache(time.Hour)
+
+// Driver identity (hometown, team, bot type) almost never changes, so live
+// stats profile lookups are kept for a day
+var botProfileCache = newTTLCache(24 * time.Hour)
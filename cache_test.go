@@ -0,0 +1,18 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTTLCacheSweepsExpiredEntriesOnSet(t *testing.T) {
+	cache := newTTLCache(time.Millisecond)
+	cache.set("stale", 1)
+	time.Sleep(5 * time.Millisecond)
+
+	// "stale" is never read again; the next write must still drop it
+	cache.set("fresh", 2)
+	if _, ok := cache.entries["stale"]; ok || len(cache.entries) != 1 {
+		t.Errorf("entries = %v, want only fresh", cache.entries)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRotatingFileWriterRotatesOnce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.log")
+	w, err := newRotatingFileWriter(path, 100, 2)
+	if err != nil {
+		t.Fatalf("newRotatingFileWriter: %v", err)
+	}
+	defer func() { w.file.Close() }()
+
+	// Each line is 20 bytes: five fill the file, the sixth forces one rotation
+	line := strings.Repeat("x", 19) + "\n"
+	for i := 0; i < 6; i++ {
+		if _, err := w.Write([]byte(line)); err != nil {
+			t.Fatalf("write %d: %v", i, err)
+		}
+	}
+
+	backup, err := os.ReadFile(path + ".1")
+	if err != nil {
+		t.Fatalf("expected a backup file after rotation: %v", err)
+	}
+	if len(backup) != 100 {
+		t.Errorf("backup size = %d, want 100", len(backup))
+	}
+	current, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read current log: %v", err)
+	}
+	if string(current) != line {
+		t.Errorf("current log = %q, want only the line written after rotation", current)
+	}
+	if _, err := os.Stat(path + ".2"); !os.IsNotExist(err) {
+		t.Errorf("unexpected second backup after a single rotation")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 )
 
 // MCP Protocol structures
@@ -57,6 +58,7 @@ const (
 var toolsMode string = ToolsFullSafe // Default to full-safe access
 var disabledTools []string           // List of disabled tool names
 var readOnlyMode bool = false        // Read-only mode flag
+var tombstoneDir string              // Directory for pre-delete tournament snapshots
 
 // Helper functions for tools filtering
 func isToolAllowed(toolName string) bool {
@@ -114,7 +116,7 @@ func isOperationAllowed(toolName, operation string) bool {
 func isReadOnlyTool(toolName string) bool {
 	readOnlyTools := []string{
 		"truefinals_tournaments", "truefinals_games", "truefinals_locations", "truefinals_players", "truefinals_bracket",
-		"nhrl_stats", "nhrl_wiki",
+		"nhrl_stats", "nhrl_wiki", "nhrl_notes",
 	}
 	for _, tool := range readOnlyTools {
 		if tool == toolName {
@@ -134,16 +136,49 @@ func isReadOperation(operation string) bool {
 	readOps := []string{
 		// Basic read operations
 		"get", "list", "details", "format", "overlay_params", "description", "private", "webhooks",
+		"list_tombstones", "preflight", "list_upcoming_events", "reconcile_event",
+		"get_schedule_variance", "reset_preview",
+		// Game read operations
+		"list_exhibitions", "estimate_match_start", "validate_bulk_exhibition",
+		"get_next_opponent_h2h", "get_score_margins", "get_clutch_record", "get_scoreboard",
+		"get_revenge_matchups", "get_marquee_matches", "get_bot_card_assets",
+		"get_stalled_matches",
+		// Location read operations
+		"get_all_queues", "get_active_overlay",
+		// Player read operations
+		"get_seed_rationale", "find_duplicate_players", "get_field_rankings", "get_hot_bots",
 		// Bracket read operations
-		"get_round", "get_standings",
+		"get_round", "get_standings", "get_program", "get_seed_odds", "get_bracket_difficulty",
+		"export_standings", "project_next_round",
 		// NHRL stats read operations
 		"get_bot_rank", "get_bot_fights", "get_bot_head_to_head", "get_bot_stats_by_season",
 		"get_bot_streak_stats", "get_bot_event_participants", "get_weight_class_dumpster_count",
 		"get_weight_class_event_winners", "get_weight_class_fastest_kos", "get_weight_class_longest_streaks",
 		"get_weight_class_stat_summary", "get_random_fight", "get_tournament_matches",
 		"get_match_review_url", "get_qualification_system", "get_live_fight_stats", "get_bot_picture_url",
+		"get_recent_results", "get_matchup_probability", "get_bot_class_standing", "get_bot_videos",
+		"get_closest_fights", "get_career_bookends", "get_active_rankings",
+		"get_rivalries", "get_roster", "get_match_timeline", "get_global_leaderboard",
+		"find_idle_gaps", "get_debut_bots", "get_record_vs_bot_type", "get_brettzone_bracket",
+		"get_giant_killer", "search_by_annotation", "get_event_highlights",
+		"find_bot_live", "get_qualifier_vs_placement",
+		"get_activity_trend", "get_bot_event_matches",
+		"get_consistency", "get_tournament_cameras",
+		"get_bot_kos", "get_rank_delta", "search_by_duration",
+		"get_championship_lineage", "get_finals_record", "plan_bracket",
+		"get_record_by_cage", "export_fights", "get_parity_index",
+		"get_results_summary", "get_bots_by_region", "get_streak_composition",
+		"list_win_methods", "get_opponent_quality_trend", "get_first_time_winners",
+		"get_intro_script", "get_career_length_stats", "list_opponents",
+		"get_comeback_runs", "get_shared_events", "get_attrition",
+		"get_signature_finish", "get_iron_bot", "get_bot_career_vs_season",
+		"get_record_vs_top_n", "get_weekly_digest", "get_event_videos", "get_fights_to_win",
+		"get_fun_facts", "get_vs_class_average", "get_body_count",
+		"get_rivalry_timeline",
 		// NHRL wiki read operations
-		"search", "get_page", "get_page_extract",
+		"search", "get_page", "get_page_extract", "recent_changes",
+		// NHRL notes read operations
+		"get_notes",
 	}
 	for _, op := range readOps {
 		if op == operation {
@@ -182,9 +217,16 @@ func main() {
 	var cliBaseURL = flag.String("base-url", "", "Base URL for TrueFinals API (overrides TRUEFINALS_BASE_URL environment variable)")
 	var cliTools = flag.String("tools", "", "Tools mode: reporting, full-safe, full (overrides TRUEFINALS_TOOLS environment variable)")
 	var cliDisabledTools = flag.String("disabled-tools", "", "Comma-separated list of tool names to disable (overrides TRUEFINALS_DISABLED_TOOLS environment variable)")
+	var cliTombstoneDir = flag.String("tombstone-dir", "", "Directory to save tournament snapshots before deletion (overrides TRUEFINALS_TOMBSTONE_DIR environment variable)")
+	var cliNotesFile = flag.String("notes-file", "", "Path of the local bot notes store (overrides NHRL_NOTES_FILE environment variable)")
 	var cliReadOnly = flag.Bool("read-only", false, "Enable read-only mode - only allow read operations (overrides TRUEFINALS_READ_ONLY environment variable)")
+	var dumpTools = flag.Bool("dump-tools", false, "Print the tool catalog (for the current tools mode) as JSON and exit")
 	var showVersion = flag.Bool("version", false, "Show version information and exit")
 	var exitAfterFirst = flag.Bool("exit-after-first", false, "Exit after processing the first request instead of running continuously")
+	var rosterRefresh = flag.Duration("roster-refresh", time.Hour, "How long cached weight class rosters are served before being refreshed")
+	var logFile = flag.String("log-file", "", "Write logs to this file instead of stderr")
+	var logMaxSize = flag.Int("log-max-size", 10, "Maximum size in megabytes of the log file before it is rotated")
+	var logBackups = flag.Int("log-backups", 3, "Number of rotated log files to keep")
 	flag.Parse()
 
 	// Handle version flag
@@ -193,6 +235,17 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Redirect logs to a rotating file if requested; stderr remains the default
+	if *logFile != "" {
+		writer, err := newRotatingFileWriter(*logFile, int64(*logMaxSize)*1024*1024, *logBackups)
+		if err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		log.SetOutput(writer)
+	}
+
+	rosterCache = newTTLCache(*rosterRefresh)
+
 	// Get read-only mode from CLI flag or environment variable
 	// CLI flag takes precedence over environment variable
 	if *cliReadOnly {
@@ -243,6 +296,16 @@ func main() {
 		log.Fatalf("Error: Invalid tools mode '%s'. Valid options: reporting, full-safe, full", toolsMode)
 	}
 
+	// Handle dump-tools flag (does not require API credentials)
+	if *dumpTools {
+		jsonData, err := json.MarshalIndent(map[string]interface{}{"tools": getAllTools()}, "", "  ")
+		if err != nil {
+			log.Fatalf("Error: failed to marshal tools: %v", err)
+		}
+		fmt.Println(string(jsonData))
+		os.Exit(0)
+	}
+
 	// Get base URL from CLI flag or environment variable
 	// CLI flag takes precedence over environment variable
 	if *cliBaseURL != "" {
@@ -251,6 +314,24 @@ func main() {
 		APIBaseURL = envBaseURL
 	}
 
+	// Get tombstone directory from CLI flag or environment variable
+	// CLI flag takes precedence over environment variable
+	if *cliTombstoneDir != "" {
+		tombstoneDir = *cliTombstoneDir
+	} else {
+		tombstoneDir = os.Getenv("TRUEFINALS_TOMBSTONE_DIR")
+	}
+
+	// Get notes file from CLI flag or environment variable
+	// CLI flag takes precedence over environment variable
+	if *cliNotesFile != "" {
+		notesFile = *cliNotesFile
+	} else if envNotesFile := os.Getenv("NHRL_NOTES_FILE"); envNotesFile != "" {
+		notesFile = envNotesFile
+	} else {
+		notesFile = defaultNotesFile()
+	}
+
 	// Get API key from CLI flag or environment variable
 	// CLI flag takes precedence over environment variable
 	if *cliAPIKey != "" {
@@ -524,6 +605,20 @@ func handleToolCall(request MCPRequest) MCPResponse {
 			}
 		}
 
+	case "nhrl_notes":
+		data, err := handleNHRLNotesTool(args)
+		if err != nil {
+			result = ToolResult{
+				Content: []ToolContent{{Type: "text", Text: fmt.Sprintf("Error: %v", err)}},
+				IsError: true,
+			}
+		} else {
+			result = ToolResult{
+				Content: []ToolContent{{Type: "text", Text: data}},
+				IsError: false,
+			}
+		}
+
 	default:
 		return sendError(request.ID, -32601, fmt.Sprintf("Unknown tool: %s", name), nil)
 	}
@@ -576,6 +671,9 @@ func getAllTools() []ToolInfo {
 	if isToolAllowed("nhrl_wiki") {
 		tools = append(tools, getNHRLWikiToolInfo())
 	}
+	if isToolAllowed("nhrl_notes") {
+		tools = append(tools, getNHRLNotesToolInfo())
+	}
 
 	return tools
 }
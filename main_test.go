@@ -0,0 +1,51 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"testing"
+)
+
+// TestDumpToolsHelper runs main with --dump-tools when re-executed by
+// TestDumpTools; it is a no-op in a normal test run
+func TestDumpToolsHelper(t *testing.T) {
+	if os.Getenv("DUMP_TOOLS_HELPER") != "1" {
+		return
+	}
+	os.Args = []string{"truefinals-mcp-server", "--dump-tools", "--tools", "full"}
+	main()
+}
+
+func TestDumpTools(t *testing.T) {
+	cmd := exec.Command(os.Args[0], "-test.run=^TestDumpToolsHelper$")
+	// No credentials: --dump-tools must not require them
+	cmd.Env = []string{"DUMP_TOOLS_HELPER=1"}
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("--dump-tools did not exit cleanly: %v", err)
+	}
+
+	var catalog struct {
+		Tools []ToolInfo `json:"tools"`
+	}
+	if err := json.Unmarshal(output, &catalog); err != nil {
+		t.Fatalf("--dump-tools output is not valid JSON: %v\n%s", err, output)
+	}
+
+	names := make(map[string]bool)
+	for _, tool := range catalog.Tools {
+		names[tool.Name] = true
+		if tool.InputSchema == nil {
+			t.Errorf("tool %s has no input schema", tool.Name)
+		}
+	}
+	for _, want := range []string{
+		"truefinals_tournaments", "truefinals_games", "truefinals_locations", "truefinals_players",
+		"truefinals_bracket", "nhrl_stats", "nhrl_wiki", "nhrl_notes",
+	} {
+		if !names[want] {
+			t.Errorf("tool catalog is missing %s", want)
+		}
+	}
+}
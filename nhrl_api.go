@@ -1,13 +1,20 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"net/url"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode"
 )
 
 // NHRL Statsbook API client configuration
@@ -19,7 +26,8 @@ const (
 
 // HTTP client for NHRL API with timeout
 var nhrlHttpClient = &http.Client{
-	Timeout: 30 * time.Second,
+	Timeout:   30 * time.Second,
+	Transport: upstreamTransport,
 }
 
 // NHRL API response structures
@@ -42,6 +50,11 @@ type NHRLRanking struct {
 	Ranking int `json:"ranking"`
 }
 
+// NHRLFight is one row of the statsbook's get_fights response. The row carries
+// the fight's points, date, match number, round, method, length, and video
+// link, but not who the opponent was or who won. OpponentName, Result,
+// TournamentID, and EventName are filled by getNHRLFightsResolved from the
+// BrettZone match the video link points at, and are empty otherwise.
 type NHRLFight struct {
 	Points          string  `json:"points"`
 	Date            string  `json:"date"`
@@ -50,6 +63,10 @@ type NHRLFight struct {
 	ResultBy        string  `json:"result_by"`
 	FightLengthSecs *string `json:"fight_length_secs"`
 	VideoLink       *string `json:"video_link"`
+	OpponentName    string  `json:"opponent_name,omitempty"`
+	Result          string  `json:"result,omitempty"`
+	TournamentID    string  `json:"tournament_id,omitempty"`
+	EventName       string  `json:"event_name,omitempty"`
 }
 
 type NHRLHeadToHead struct {
@@ -179,6 +196,115 @@ func normalizeBotName(botName string) string {
 	return strings.ReplaceAll(botName, " ", "_")
 }
 
+// Helper function to compare bot names regardless of case or space/underscore style
+func botNamesMatch(a, b string) bool {
+	return strings.EqualFold(normalizeBotName(strings.TrimSpace(a)), normalizeBotName(strings.TrimSpace(b)))
+}
+
+// botNameSimilarity scores how alike two bot names are, from 0 (nothing in
+// common) to 1 (identical once case, spaces, and punctuation are ignored),
+// using Levenshtein edit distance over the normalized names
+func botNameSimilarity(a, b string) float64 {
+	normalize := func(name string) []rune {
+		var runes []rune
+		for _, r := range strings.ToLower(name) {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				runes = append(runes, r)
+			}
+		}
+		return runes
+	}
+
+	ra, rb := normalize(a), normalize(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 0
+	}
+
+	// Single-row dynamic programming edit distance
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr := make([]int, len(rb)+1)
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev = curr
+	}
+
+	return 1 - float64(prev[len(rb)])/float64(longest)
+}
+
+// Helper function to parse statsbook dates (YYYY-MM-DD, optionally with a time)
+func parseStatsbookDate(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{"2006-01-02", "2006-01-02 15:04:05"} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// RankMovement describes how a bot's ranking changed since the last update
+type RankMovement struct {
+	Direction string `json:"direction"` // "up", "down", "none", or "new"
+	Magnitude int    `json:"magnitude"`
+	IsNew     bool   `json:"is_new"`
+	Indicator string `json:"indicator"`
+}
+
+// Helper function to parse the statsbook's rank_change value. Accepts signed
+// numbers ("+2", "-1"), arrows ("↑2", "▼1"), "NEW"/"NR" for new entries, and
+// blank/zero/dash for no change.
+func parseRankChange(value string) RankMovement {
+	value = strings.TrimSpace(value)
+	upper := strings.ToUpper(value)
+
+	if upper == "NEW" || upper == "NR" {
+		return RankMovement{Direction: "new", IsNew: true, Indicator: "NEW"}
+	}
+
+	direction := ""
+	switch {
+	case strings.HasPrefix(value, "+"), strings.HasPrefix(value, "↑"), strings.HasPrefix(value, "▲"):
+		direction = "up"
+	case strings.HasPrefix(value, "-"), strings.HasPrefix(value, "↓"), strings.HasPrefix(value, "▼"):
+		direction = "down"
+	}
+
+	digits := strings.TrimLeft(value, "+-↑↓▲▼ ")
+	magnitude, err := strconv.Atoi(digits)
+	if err != nil || magnitude == 0 {
+		return RankMovement{Direction: "none", Indicator: "–"}
+	}
+	if magnitude < 0 {
+		magnitude = -magnitude
+		direction = "down"
+	}
+	if direction == "" {
+		// Unsigned positive numbers mean the bot moved up
+		direction = "up"
+	}
+
+	indicator := fmt.Sprintf("↑%d", magnitude)
+	if direction == "down" {
+		indicator = fmt.Sprintf("↓%d", magnitude)
+	}
+
+	return RankMovement{Direction: direction, Magnitude: magnitude, Indicator: indicator}
+}
+
 // Generic function to make NHRL API requests
 func makeNHRLAPIRequest(endpoint string, params map[string]string) ([]byte, error) {
 	// Build query parameters
@@ -200,6 +326,7 @@ func makeNHRLAPIRequest(endpoint string, params map[string]string) ([]byte, erro
 
 	// Set headers
 	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Accept-Encoding", "gzip")
 	req.Header.Set("User-Agent", "NHRL-MCP-Server/1.0.0")
 
 	resp, err := nhrlHttpClient.Do(req)
@@ -208,7 +335,7 @@ func makeNHRLAPIRequest(endpoint string, params map[string]string) ([]byte, erro
 	}
 	defer resp.Body.Close()
 
-	responseBody, err := io.ReadAll(resp.Body)
+	responseBody, err := readResponseBody(resp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
@@ -316,6 +443,310 @@ func getNHRLFights(botName string) ([]NHRLFight, error) {
 	return result, nil
 }
 
+// Maximum number of BrettZone tournaments fetched at once when resolving a fight history
+const fightResolveConcurrency = 4
+
+// brettZoneReviewRef reads the game and tournament IDs from a BrettZone fight
+// review link (see generateBrettZoneReviewURL). ok is false for other links.
+func brettZoneReviewRef(link *string) (gameID, tournamentID string, ok bool) {
+	if link == nil {
+		return "", "", false
+	}
+	parsed, err := url.Parse(strings.TrimSpace(*link))
+	if err != nil {
+		return "", "", false
+	}
+	query := parsed.Query()
+	gameID, tournamentID = query.Get("gameID"), query.Get("tournamentID")
+	return gameID, tournamentID, gameID != "" && tournamentID != ""
+}
+
+// Get a tournament's BrettZone matches, served from the NHRL cache when fresh
+func getBrettZoneMatchesCached(tournamentID string) ([]BrettZoneMatch, error) {
+	key := "brettzone_matches:" + tournamentID
+	if cached, ok := nhrlCache.get(key); ok {
+		return cached.([]BrettZoneMatch), nil
+	}
+
+	matches, err := getBrettZoneLatestMatches(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	nhrlCache.set(key, matches)
+	return matches, nil
+}
+
+// brettZoneSide reports which side of a BrettZone match a bot fought on: 1, 2, or 0 if neither
+func brettZoneSide(match BrettZoneMatch, botName string) int {
+	switch {
+	case botNamesMatch(match.Player1, botName) || (match.Player1Clean != "" && botNamesMatch(match.Player1Clean, botName)):
+		return 1
+	case botNamesMatch(match.Player2, botName) || (match.Player2Clean != "" && botNamesMatch(match.Player2Clean, botName)):
+		return 2
+	}
+	return 0
+}
+
+// Get a bot's statsbook fights with the opponent, result, and event filled in
+// from BrettZone. Each fight's video link names its BrettZone game and
+// tournament; those tournaments are fetched (at most fightResolveConcurrency
+// at a time, through the NHRL cache) and the matching game supplies the other
+// bot, the winner, and the event name. Fights without a BrettZone link, or
+// whose game is missing, keep those fields empty and have an unknown outcome.
+func getNHRLFightsResolved(botName string) ([]NHRLFight, error) {
+	key := "fights_resolved:" + strings.ToLower(normalizeBotName(botName))
+	if cached, ok := nhrlCache.get(key); ok {
+		// Callers sort and filter in place, so each gets its own copy
+		return append([]NHRLFight(nil), cached.([]NHRLFight)...), nil
+	}
+
+	fights, err := getNHRLFights(botName)
+	if err != nil {
+		return nil, err
+	}
+
+	var tournamentIDs []string
+	seen := make(map[string]bool)
+	for _, fight := range fights {
+		if _, tournamentID, ok := brettZoneReviewRef(fight.VideoLink); ok && !seen[tournamentID] {
+			seen[tournamentID] = true
+			tournamentIDs = append(tournamentIDs, tournamentID)
+		}
+	}
+
+	games := make([]map[string]BrettZoneMatch, len(tournamentIDs))
+	failed := make([]bool, len(tournamentIDs))
+	sem := make(chan struct{}, fightResolveConcurrency)
+	var wg sync.WaitGroup
+	for i, tournamentID := range tournamentIDs {
+		wg.Add(1)
+		go func(i int, tournamentID string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			matches, err := getBrettZoneMatchesCached(tournamentID)
+			if err != nil {
+				failed[i] = true
+				return
+			}
+			games[i] = make(map[string]BrettZoneMatch, len(matches))
+			for _, match := range matches {
+				games[i][match.ID] = match
+			}
+		}(i, tournamentID)
+	}
+	wg.Wait()
+
+	byTournament := make(map[string]map[string]BrettZoneMatch, len(tournamentIDs))
+	complete := true
+	for i, tournamentID := range tournamentIDs {
+		if failed[i] {
+			complete = false
+			continue
+		}
+		byTournament[tournamentID] = games[i]
+	}
+
+	for i := range fights {
+		fight := &fights[i]
+		fight.OpponentName, fight.Result, fight.TournamentID, fight.EventName = "", "", "", ""
+
+		gameID, tournamentID, ok := brettZoneReviewRef(fight.VideoLink)
+		if !ok {
+			continue
+		}
+		fight.TournamentID = tournamentID
+		match, ok := byTournament[tournamentID][gameID]
+		if !ok {
+			continue
+		}
+		fight.EventName = match.TournamentName
+
+		side := brettZoneSide(match, botName)
+		if side == 0 {
+			continue
+		}
+		own, other := match.Player1Wins, match.Player2Wins
+		fight.OpponentName = match.Player2
+		if side == 2 {
+			own, other = other, own
+			fight.OpponentName = match.Player1
+		}
+		switch {
+		case own == "1":
+			fight.Result = "W"
+		case other == "1":
+			fight.Result = "L"
+		}
+	}
+
+	// A failed tournament fetch is retried on the next call rather than cached
+	if complete {
+		nhrlCache.set(key, append([]NHRLFight(nil), fights...))
+	}
+	return fights, nil
+}
+
+// NHRLBotEvent is one BrettZone tournament in a bot's fight history
+type NHRLBotEvent struct {
+	TournamentID string      `json:"tournament_id"`
+	EventName    string      `json:"event_name"`
+	EventDate    string      `json:"event_date"`
+	Placement    *int        `json:"placement"`
+	Date         time.Time   `json:"-"`
+	Fights       []NHRLFight `json:"-"`
+}
+
+// Get the events a bot fought in, oldest first, with its placement at each.
+// Statsbook event participation rows are not used: events are the BrettZone
+// tournaments named by the bot's resolved fights, dated by the bot's earliest
+// fight there, with placement from brettZonePlacement. unlinked counts fights
+// with no BrettZone link, which belong to no event.
+func getNHRLBotEvents(botName string) (events []NHRLBotEvent, unlinked int, err error) {
+	fights, err := getNHRLFightsResolved(botName)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	byTournament := make(map[string]*NHRLBotEvent)
+	var order []string
+	for _, fight := range fights {
+		if fight.TournamentID == "" {
+			unlinked++
+			continue
+		}
+		event, ok := byTournament[fight.TournamentID]
+		if !ok {
+			event = &NHRLBotEvent{TournamentID: fight.TournamentID}
+			byTournament[fight.TournamentID] = event
+			order = append(order, fight.TournamentID)
+		}
+		if event.EventName == "" {
+			event.EventName = fight.EventName
+		}
+		if date, ok := parseStatsbookDate(fight.Date); ok && (event.Date.IsZero() || date.Before(event.Date)) {
+			event.Date = date
+			event.EventDate = fight.Date
+		}
+		event.Fights = append(event.Fights, fight)
+	}
+
+	for _, tournamentID := range order {
+		event := byTournament[tournamentID]
+		if matches, err := getBrettZoneMatchesCached(tournamentID); err == nil {
+			if placement, ok := brettZonePlacement(matches, botName); ok {
+				event.Placement = &placement
+			}
+		}
+		events = append(events, *event)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Date.Before(events[j].Date)
+	})
+	return events, unlinked, nil
+}
+
+// bracketStageKey orders main bracket rounds by how late in the event they
+// fall; qualifier and unrecognized rounds return false. Winners and losers
+// finals close out their sides, and grand finals come last.
+func bracketStageKey(roundCode string) (int, bool) {
+	bracket, _, key := getBrettZoneRoundOrder(roundCode)
+	switch bracket {
+	case "winners", "losers", "grand_finals":
+		return key, true
+	}
+	return 0, false
+}
+
+// lastGrandFinal returns the grand finals match played last: the one in the
+// latest grand finals round (a reset comes after the grand final), and the
+// one that ended latest when rounds tie, counting a match with no end time as
+// still to come. The match may be undecided, in which case the event has no
+// champion yet. ok is false when the event has no grand finals match.
+func lastGrandFinal(matches []BrettZoneMatch) (BrettZoneMatch, bool) {
+	var last BrettZoneMatch
+	var lastEnded time.Time
+	lastKey, lastHasEnded := -1, false
+	for _, match := range matches {
+		bracket, _, key := getBrettZoneRoundOrder(match.Round)
+		if bracket != "grand_finals" {
+			continue
+		}
+		ended, hasEnded := parseBrettZoneTime(match.EndTime)
+		if !hasEnded {
+			ended, hasEnded = parseBrettZoneTime(match.StopTime)
+		}
+		later := key > lastKey
+		if key == lastKey {
+			later = !hasEnded || (lastHasEnded && ended.After(lastEnded))
+		}
+		if later {
+			last, lastEnded, lastKey, lastHasEnded = match, ended, key, hasEnded
+		}
+	}
+	return last, lastKey >= 0
+}
+
+// brettZonePlacement derives a bot's final placement from an event's main
+// bracket matches. The winner of the last bracket match is the champion.
+// Every other bot's run ends at its last bracket loss, and it places one
+// behind every bot whose run ended at a later stage, so bots knocked out in
+// the same round share a placement (in a 16-bot single elimination bracket:
+// 1, 2, 3, 3, 5, 5, 5, 5, 9, ...). ok is false when the bot never reached the
+// bracket or the bracket is not finished.
+func brettZonePlacement(matches []BrettZoneMatch, botName string) (int, bool) {
+	lastLoss := make(map[string]int)
+	inBracket := false
+	finalKey, champion := -1, ""
+	for _, match := range matches {
+		key, ok := bracketStageKey(match.Round)
+		if !ok {
+			continue
+		}
+		if brettZoneSide(match, botName) != 0 {
+			inBracket = true
+		}
+		winner := getMatchWinner(match)
+		if winner == "undecided" {
+			return 0, false
+		}
+		loser := match.Player1
+		if winner == match.Player1 {
+			loser = match.Player2
+		}
+		loserKey := strings.ToLower(normalizeBotName(loser))
+		if key > lastLoss[loserKey] {
+			lastLoss[loserKey] = key
+		}
+		if key > finalKey {
+			finalKey, champion = key, winner
+		}
+	}
+	if !inBracket || champion == "" {
+		return 0, false
+	}
+	if botNamesMatch(champion, botName) {
+		return 1, true
+	}
+
+	botKey := strings.ToLower(normalizeBotName(botName))
+	eliminatedAt, ok := lastLoss[botKey]
+	if !ok {
+		return 0, false
+	}
+	championKey := strings.ToLower(normalizeBotName(champion))
+	placement := 2
+	for bot, key := range lastLoss {
+		if bot != championKey && key > eliminatedAt {
+			placement++
+		}
+	}
+	return placement, true
+}
+
 // Get head-to-head record for a specific bot
 func getNHRLHeadToHead(botName string) ([]NHRLHeadToHead, error) {
 	params := map[string]string{
@@ -335,6 +766,22 @@ func getNHRLHeadToHead(botName string) ([]NHRLHeadToHead, error) {
 	return result, nil
 }
 
+// Get head-to-head record for a bot, served from the NHRL cache when fresh
+func getNHRLHeadToHeadCached(botName string) ([]NHRLHeadToHead, error) {
+	key := "head_to_head:" + strings.ToLower(normalizeBotName(botName))
+	if cached, ok := nhrlCache.get(key); ok {
+		return cached.([]NHRLHeadToHead), nil
+	}
+
+	result, err := getNHRLHeadToHead(botName)
+	if err != nil {
+		return nil, err
+	}
+
+	nhrlCache.set(key, result)
+	return result, nil
+}
+
 // Get longest winning streaks for a weight class
 func getNHRLLongestWinningStreak(categoryID string) ([]NHRLWinningStreak, error) {
 	params := map[string]string{
@@ -393,6 +840,27 @@ func getNHRLBotRank(botName string) (*NHRLRanking, error) {
 	return &result, nil
 }
 
+// Get a bot's current rank, served from the NHRL cache when fresh. Returns 0
+// for unranked bots.
+func getNHRLBotRankCached(botName string) (int, error) {
+	key := "rank:" + strings.ToLower(normalizeBotName(botName))
+	if cached, ok := nhrlCache.get(key); ok {
+		return cached.(int), nil
+	}
+
+	result, err := getNHRLBotRank(botName)
+	if err != nil {
+		return 0, err
+	}
+
+	rank := 0
+	if result != nil && result.Ranking > 0 {
+		rank = result.Ranking
+	}
+	nhrlCache.set(key, rank)
+	return rank, nil
+}
+
 // Get stat summary for a weight class and season
 func getNHRLStatSummary(categoryID, seasonID string) ([]NHRLStatSummary, error) {
 	params := map[string]string{
@@ -432,6 +900,32 @@ func getNHRLStatSummarySimple(categoryID string) ([]NHRLStatSummary, error) {
 	return result, nil
 }
 
+// Get the sorted list of bot names in a weight class (all-time), served from the roster cache
+func getNHRLRoster(categoryID string) ([]string, bool, error) {
+	key := "roster:" + categoryID
+	if cached, ok := rosterCache.get(key); ok {
+		return cached.([]string), true, nil
+	}
+
+	statSummary, err := getNHRLStatSummarySimple(categoryID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	roster := make([]string, 0, len(statSummary))
+	for _, stat := range statSummary {
+		if stat.Bot != "" {
+			roster = append(roster, stat.Bot)
+		}
+	}
+	sort.Slice(roster, func(i, j int) bool {
+		return strings.ToLower(roster[i]) < strings.ToLower(roster[j])
+	})
+
+	rosterCache.set(key, roster)
+	return roster, false, nil
+}
+
 // Get stats by season for a specific bot
 func getNHRLStatsBySeason(botName, season string) (*NHRLBotStatsBySeason, error) {
 	params := map[string]string{
@@ -481,6 +975,129 @@ func getNHRLStreakStats(botName string) (*NHRLStreakStats, error) {
 	return &result, nil
 }
 
+// Live stats requests are retried on network errors and 5xx responses. The
+// endpoint is a form POST but only reads data, so retrying is safe.
+const (
+	liveStatsMaxAttempts = 3
+	liveStatsRetryDelay  = 500 * time.Millisecond
+)
+
+// Maximum number of live stats pairings looked up at once for bot profiles
+const botProfileConcurrency = 4
+
+// getNHRLBotProfilesCached returns each bot's live stats identity record
+// (driver, hometown, bot type), keyed by bot name. The live stats endpoint only
+// answers for a pairing, so uncached bots are looked up two at a time (at most
+// botProfileConcurrency pairings at once) and both records are cached. A
+// leftover bot is paired with another requested bot, or failing that with an
+// opponent from its own head-to-head history. Bots with no record are cached
+// as misses and omitted; a failed lookup is remembered for the NHRL cache TTL
+// so repeated calls don't refetch it.
+func getNHRLBotProfilesCached(botNames []string) map[string]NHRLLiveFightStats {
+	profiles := make(map[string]NHRLLiveFightStats, len(botNames))
+	profileKey := func(botName string) string {
+		return "bot_profile:" + strings.ToLower(normalizeBotName(botName))
+	}
+	failedKey := func(botName string) string {
+		return "bot_profile_failed:" + strings.ToLower(normalizeBotName(botName))
+	}
+
+	var missing []string
+	for _, botName := range botNames {
+		if _, failed := nhrlCache.get(failedKey(botName)); failed {
+			continue
+		}
+		cached, ok := botProfileCache.get(profileKey(botName))
+		if !ok {
+			missing = append(missing, botName)
+			continue
+		}
+		if profile, ok := cached.(NHRLLiveFightStats); ok {
+			profiles[botName] = profile
+		}
+	}
+
+	type pairing struct {
+		lookup   []string
+		opponent string
+	}
+	var pairings []pairing
+	for i := 0; i+1 < len(missing); i += 2 {
+		pairings = append(pairings, pairing{lookup: missing[i : i+2], opponent: missing[i+1]})
+	}
+	if len(missing)%2 == 1 {
+		leftover := missing[len(missing)-1]
+		if opponent := botProfilePartner(leftover, botNames); opponent != "" {
+			pairings = append(pairings, pairing{lookup: []string{leftover}, opponent: opponent})
+		} else {
+			nhrlCache.set(failedKey(leftover), true)
+		}
+	}
+
+	results := make([][]NHRLLiveFightStats, len(pairings))
+	fetched := make([]bool, len(pairings))
+	sem := make(chan struct{}, botProfileConcurrency)
+	var wg sync.WaitGroup
+	for i, p := range pairings {
+		wg.Add(1)
+		go func(i int, p pairing) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			stats, err := getNHRLLiveFightStats(p.lookup[0], p.opponent, "")
+			if err != nil {
+				for _, botName := range p.lookup {
+					nhrlCache.set(failedKey(botName), true)
+				}
+				return
+			}
+			results[i], fetched[i] = stats, true
+		}(i, p)
+	}
+	wg.Wait()
+
+	for i, p := range pairings {
+		if !fetched[i] {
+			continue
+		}
+		for _, botName := range p.lookup {
+			var found interface{} = false
+			for _, stat := range results[i] {
+				if botNamesMatch(stat.BotName, botName) {
+					profiles[botName] = stat
+					found = stat
+					break
+				}
+			}
+			botProfileCache.set(profileKey(botName), found)
+		}
+	}
+
+	return profiles
+}
+
+// botProfilePartner picks a real bot to pair with botName for a live stats
+// lookup: another of the requested bots if there is one, otherwise the first
+// opponent in botName's head-to-head history. Returns "" if neither exists.
+func botProfilePartner(botName string, requested []string) string {
+	for _, other := range requested {
+		if !botNamesMatch(other, botName) {
+			return other
+		}
+	}
+	headToHead, err := getNHRLHeadToHeadCached(botName)
+	if err != nil {
+		return ""
+	}
+	for _, record := range headToHead {
+		if record.OpponentUniqueName != "" && !botNamesMatch(record.OpponentUniqueName, botName) {
+			return record.OpponentUniqueName
+		}
+	}
+	return ""
+}
+
 // Get live fight stats between two bots for a specific tournament
 func getNHRLLiveFightStats(bot1, bot2, tournamentID string) ([]NHRLLiveFightStats, error) {
 	// Build form data
@@ -491,49 +1108,130 @@ func getNHRLLiveFightStats(bot1, bot2, tournamentID string) ([]NHRLLiveFightStat
 	// Build full URL with tournament ID
 	fullURL := fmt.Sprintf("https://stats.nhrl.io/live_stats/query/get_fight_stats.php?tournament_id=%s", tournamentID)
 
+	var responseBody []byte
+	var lastErr error
+	for attempt := 1; attempt <= liveStatsMaxAttempts; attempt++ {
+		if attempt > 1 {
+			time.Sleep(time.Duration(attempt-1) * liveStatsRetryDelay)
+		}
+
+		var retryable bool
+		responseBody, retryable, lastErr = postNHRLLiveStats(fullURL, formData)
+		if lastErr == nil || !retryable {
+			break
+		}
+		log.Printf("Live fight stats request failed (attempt %d/%d): %v", attempt, liveStatsMaxAttempts, lastErr)
+	}
+	if lastErr != nil {
+		return nil, lastErr
+	}
+
+	// A successful response with no body means there are no stats for this pairing
+	if len(bytes.TrimSpace(responseBody)) == 0 {
+		return []NHRLLiveFightStats{}, nil
+	}
+
+	var result []NHRLLiveFightStats
+	if err := json.Unmarshal(responseBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse live fight stats response: %w", err)
+	}
+
+	return result, nil
+}
+
+// postNHRLLiveStats performs a single live stats form POST. The returned bool
+// reports whether the failure is transient (network error or 5xx) and worth retrying.
+func postNHRLLiveStats(fullURL string, formData url.Values) ([]byte, bool, error) {
 	req, err := http.NewRequest("POST", fullURL, strings.NewReader(formData.Encode()))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Accept-Encoding", "gzip")
 	req.Header.Set("User-Agent", "NHRL-MCP-Server/1.0.0")
 
 	resp, err := nhrlHttpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		// An open circuit won't recover within the retry window
+		return nil, !errors.Is(err, errServiceUnavailable), fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
-	responseBody, err := io.ReadAll(resp.Body)
+	responseBody, err := readResponseBody(resp)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, true, fmt.Errorf("failed to read response body: %w", err)
 	}
 
 	// Check for HTTP error status codes
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("HTTP error %d: %s", resp.StatusCode, string(responseBody))
+		return nil, resp.StatusCode >= 500, fmt.Errorf("HTTP error %d: %s", resp.StatusCode, string(responseBody))
 	}
 
-	// Handle empty response
-	if len(responseBody) == 0 || string(responseBody) == "" {
-		return []NHRLLiveFightStats{}, nil
+	return responseBody, false, nil
+}
+
+// Get a bot's type (e.g. "Vertical Spinner") from the live stats service,
+// served from the NHRL cache when fresh. Returns "" when unavailable; misses
+// are not cached so a later lookup can still find the type.
+func getNHRLBotTypeCached(botName, opponentName, tournamentID string) string {
+	key := "bot_type:" + tournamentID + ":" + strings.ToLower(normalizeBotName(opponentName))
+	if cached, ok := nhrlCache.get(key); ok {
+		return cached.(string)
 	}
 
-	var result []NHRLLiveFightStats
-	if err := json.Unmarshal(responseBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse live fight stats response: %w", err)
+	stats, err := getNHRLLiveFightStats(botName, opponentName, tournamentID)
+	if err != nil {
+		return ""
 	}
 
-	return result, nil
+	botType := ""
+	for _, stat := range stats {
+		if botNamesMatch(stat.BotName, opponentName) {
+			botType = strings.TrimSpace(stat.BotType)
+			break
+		}
+	}
+
+	if botType != "" {
+		nhrlCache.set(key, botType)
+	}
+	return botType
+}
+
+// Helper function to group a free-form bot type into a weapon archetype
+func classifyBotArchetype(botType string) string {
+	t := strings.ToLower(botType)
+	switch {
+	case t == "":
+		return "unknown"
+	case strings.Contains(t, "vertical"), strings.Contains(t, "eggbeater"):
+		return "vertical"
+	case strings.Contains(t, "drum"):
+		return "drum"
+	case strings.Contains(t, "horizontal"), strings.Contains(t, "undercutter"):
+		return "horizontal"
+	case strings.Contains(t, "shell"), strings.Contains(t, "ring"), strings.Contains(t, "full body"), strings.Contains(t, "spinner"):
+		return "spinner"
+	case strings.Contains(t, "hammer"), strings.Contains(t, "axe"):
+		return "hammer"
+	case strings.Contains(t, "flipper"), strings.Contains(t, "launcher"):
+		return "flipper"
+	case strings.Contains(t, "wedge"), strings.Contains(t, "lifter"), strings.Contains(t, "grabber"),
+		strings.Contains(t, "clamp"), strings.Contains(t, "crusher"), strings.Contains(t, "control"), strings.Contains(t, "pusher"):
+		return "control"
+	default:
+		return "other"
+	}
 }
 
 // BrettZone API Functions
 
-// Generic function to make BrettZone API requests
-func makeBrettZoneAPIRequest(endpoint string, params map[string]string) ([]byte, error) {
+// streamBrettZoneAPIRequest makes a BrettZone API request and hands the
+// decoded response body to consume without buffering it first
+func streamBrettZoneAPIRequest(endpoint string, params map[string]string, consume func(io.Reader) error) error {
 	// Build query parameters
 	queryParams := url.Values{}
 	for key, value := range params {
@@ -548,49 +1246,95 @@ func makeBrettZoneAPIRequest(endpoint string, params map[string]string) ([]byte,
 
 	req, err := http.NewRequest("GET", fullURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
 	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Accept-Encoding", "gzip")
 	req.Header.Set("User-Agent", "NHRL-MCP-Server/1.0.0")
 
 	resp, err := nhrlHttpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
-	responseBody, err := io.ReadAll(resp.Body)
+	body, err := responseBodyReader(resp)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return fmt.Errorf("failed to read response body: %w", err)
 	}
 
 	// Check for HTTP error status codes
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("HTTP error %d: %s", resp.StatusCode, string(responseBody))
+		errorBody, _ := io.ReadAll(io.LimitReader(body, 4096))
+		return fmt.Errorf("HTTP error %d: %s", resp.StatusCode, string(errorBody))
 	}
 
-	return responseBody, nil
+	return consume(body)
 }
 
+// Maximum number of matches decoded from a single BrettZone tournament response
+const maxBrettZoneMatches = 5000
+
 // Get latest matches for a tournament
 func getBrettZoneLatestMatches(tournamentID string) ([]BrettZoneMatch, error) {
-	params := map[string]string{
-		"tournamentID": tournamentID,
+	matches, truncated, err := getBrettZoneLatestMatchesLimited(tournamentID, maxBrettZoneMatches)
+	if err != nil {
+		return nil, err
+	}
+	if truncated {
+		log.Printf("BrettZone tournament %s returned more than %d matches; extra matches were ignored", tournamentID, maxBrettZoneMatches)
 	}
+	return matches, nil
+}
 
-	data, err := makeBrettZoneAPIRequest("getLatestMatches.php", params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get latest matches: %w", err)
+// Get up to maxMatches latest matches for a tournament, decoding the response
+// incrementally. The boolean result reports whether matches were dropped.
+func getBrettZoneLatestMatchesLimited(tournamentID string, maxMatches int) ([]BrettZoneMatch, bool, error) {
+	params := map[string]string{
+		"tournamentID": tournamentID,
 	}
 
 	var result []BrettZoneMatch
-	if err := json.Unmarshal(data, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse latest matches response: %w", err)
+	truncated := false
+
+	err := streamBrettZoneAPIRequest("getLatestMatches.php", params, func(body io.Reader) error {
+		decoder := json.NewDecoder(body)
+
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to parse latest matches response: %w", err)
+		}
+		if token == nil {
+			// "null" means no matches
+			return nil
+		}
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			return fmt.Errorf("failed to parse latest matches response: expected a JSON array")
+		}
+
+		for decoder.More() {
+			if len(result) >= maxMatches {
+				truncated = true
+				break
+			}
+			var match BrettZoneMatch
+			if err := decoder.Decode(&match); err != nil {
+				return fmt.Errorf("failed to parse latest matches response: %w", err)
+			}
+			result = append(result, match)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get latest matches: %w", err)
 	}
 
-	return result, nil
+	return result, truncated, nil
 }
 
 // Generate BrettZone fight review URL
@@ -604,6 +1348,60 @@ func generateBrettZoneReviewURL(gameID, tournamentID string, cageNum int, timeSe
 		BrettZoneReviewURL, gameID, tournamentID, cage, timeSeconds)
 }
 
+// parseBrettZoneTime parses a BrettZone timestamp string. BrettZone reports
+// times as Unix epoch values (seconds or milliseconds) but older events use
+// formatted datetimes, so both are accepted. Returns false for empty/zero values.
+func parseBrettZoneTime(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "0" || strings.EqualFold(value, "null") {
+		return time.Time{}, false
+	}
+
+	if epoch, err := strconv.ParseFloat(value, 64); err == nil {
+		if epoch <= 0 {
+			return time.Time{}, false
+		}
+		// Epoch values this large can only be milliseconds
+		if epoch > 1e12 {
+			return time.UnixMilli(int64(epoch)), true
+		}
+		return time.Unix(int64(epoch), 0), true
+	}
+
+	layouts := []string{
+		"2006-01-02 15:04:05",
+		time.RFC3339,
+		"2006-01-02T15:04:05",
+		"2006-01-02",
+	}
+	for _, layout := range layouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// Helper function to split a BrettZone cams field into camera identifiers.
+// The field is a delimited list (commas, semicolons, pipes, or spaces) and
+// occasionally a JSON array.
+func parseBrettZoneCams(cams string) []string {
+	cams = strings.TrimSpace(cams)
+	if cams == "" || strings.EqualFold(cams, "null") {
+		return nil
+	}
+
+	var list []string
+	if strings.HasPrefix(cams, "[") && json.Unmarshal([]byte(cams), &list) == nil {
+		return list
+	}
+
+	return strings.FieldsFunc(cams, func(r rune) bool {
+		return r == ',' || r == ';' || r == '|' || r == ' '
+	})
+}
+
 // Helper function to get cage number from cage string
 func extractCageNumber(cageStr string) int {
 	if strings.Contains(cageStr, "Cage 1") {
@@ -644,6 +1442,42 @@ func getWeightClassCategoryID(weightClass string) string {
 	}
 }
 
+// brettZoneWeightClass maps a weight class argument to the pound value
+// BrettZone records on its matches (e.g. "3lb" or "beetleweight" to "3").
+// Unlike getWeightClassCategoryID it has no default: an unknown class
+// returns "", so a filter can reject it instead of silently using 3lb.
+func brettZoneWeightClass(wc string) string {
+	switch strings.ToLower(strings.TrimSpace(wc)) {
+	case "3", "3lb", "3 lb", "beetleweight":
+		return "3"
+	case "12", "12lb", "12 lb", "antweight":
+		return "12"
+	case "30", "30lb", "30 lb", "hobbyweight":
+		return "30"
+	}
+	return ""
+}
+
+// unknownWeightClassError reports a weight class brettZoneWeightClass does not recognize
+func unknownWeightClassError(wc string) error {
+	return fmt.Errorf("unknown weight_class %q: must be 3lb, 12lb, or 30lb", wc)
+}
+
+// brettZoneWeightClassFilter reads the optional weight_class argument as a
+// filter compared against BrettZone's pound value (e.g. "3"). No argument
+// gives "", meaning no filter; an unrecognized class is an error.
+func brettZoneWeightClassFilter(args map[string]interface{}) (string, error) {
+	wc, _ := args["weight_class"].(string)
+	if wc == "" {
+		return "", nil
+	}
+	filter := brettZoneWeightClass(wc)
+	if filter == "" {
+		return "", unknownWeightClassError(wc)
+	}
+	return filter, nil
+}
+
 // Helper function to get season ID from season name/year
 func getSeasonID(season string) string {
 	// Map user-friendly season names to API expected values
@@ -766,6 +1600,65 @@ func enrichBrettZoneMatches(matches []BrettZoneMatch) []EnrichedBrettZoneMatch {
 	return enrichedMatches
 }
 
+// Helper function to place a BrettZone round code in bracket order. Returns
+// the bracket section, the round number within it, and a sort key ordering
+// Q1 → Q2W → Q2L → Q3 → winners → WF → losers → LF → GF → GFR. The winners
+// and losers finals have no round number; a grand final reset (GFR or GF2) is
+// grand finals round 2.
+func getBrettZoneRoundOrder(roundCode string) (string, int, int) {
+	code := strings.ToUpper(strings.TrimSpace(roundCode))
+
+	switch code {
+	case "Q1":
+		return "qualifying", 1, 1
+	case "Q2W":
+		return "qualifying", 2, 2
+	case "Q2L":
+		return "qualifying", 2, 3
+	case "Q3":
+		return "qualifying", 3, 4
+	case "WF":
+		return "winners", 0, 1999
+	case "LF":
+		return "losers", 0, 2999
+	}
+
+	if strings.HasPrefix(code, "GF") {
+		game := 1
+		if suffix := strings.TrimLeft(code[2:], "-_ "); suffix == "R" {
+			game = 2
+		} else if n, err := strconv.Atoi(suffix); err == nil && n > 1 {
+			game = n
+		}
+		return "grand_finals", game, 2999 + game
+	}
+
+	trimmed := strings.TrimLeft(code, "-_ ")
+	if len(trimmed) > 1 && (trimmed[0] == 'W' || trimmed[0] == 'L') {
+		if n, err := strconv.Atoi(strings.TrimLeft(trimmed[1:], "-_ ")); err == nil {
+			if trimmed[0] == 'W' {
+				return "winners", n, 1000 + n
+			}
+			return "losers", n, 2000 + n
+		}
+	}
+
+	return "other", 0, 4000
+}
+
+// Helper function to report whether a round code is a finals round: winners
+// final (WF), losers final (LF), grand final (GF), or grand final reset (GFR)
+func isFinalsRound(roundCode string) bool {
+	code := strings.ToUpper(strings.TrimSpace(roundCode))
+	switch code {
+	case "WF", "LF", "GF", "GFR":
+		return true
+	}
+	// Grand final variants such as GF2 or GF-R
+	bracket, _, _ := getBrettZoneRoundOrder(code)
+	return bracket == "grand_finals"
+}
+
 // Helper function to explain the qualification path
 func getQualificationPathExplanation() string {
 	return `NHRL Tournament Qualification System:
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestParseRankChange(t *testing.T) {
+	tests := []struct {
+		value string
+		want  RankMovement
+	}{
+		{"+2", RankMovement{Direction: "up", Magnitude: 2, Indicator: "↑2"}},
+		{"3", RankMovement{Direction: "up", Magnitude: 3, Indicator: "↑3"}},
+		{"-1", RankMovement{Direction: "down", Magnitude: 1, Indicator: "↓1"}},
+		{"↑4", RankMovement{Direction: "up", Magnitude: 4, Indicator: "↑4"}},
+		{"▼ 5", RankMovement{Direction: "down", Magnitude: 5, Indicator: "↓5"}},
+		{"NEW", RankMovement{Direction: "new", IsNew: true, Indicator: "NEW"}},
+		{"nr", RankMovement{Direction: "new", IsNew: true, Indicator: "NEW"}},
+		{"0", RankMovement{Direction: "none", Indicator: "–"}},
+		{"", RankMovement{Direction: "none", Indicator: "–"}},
+		{"--", RankMovement{Direction: "none", Indicator: "–"}},
+	}
+	for _, tt := range tests {
+		if got := parseRankChange(tt.value); got != tt.want {
+			t.Errorf("parseRankChange(%q) = %+v, want %+v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestLiveFightStatsRetriesAfterServerError(t *testing.T) {
+	stub := newUpstreamStub(t)
+	path := statsbookHost + "/live_stats/query/get_fight_stats.php"
+	attempts := 0
+	stub.handle(path, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			http.Error(w, "upstream hiccup", http.StatusBadGateway)
+			return
+		}
+		if r.URL.Query().Get("tournament_id") != "t1" || r.FormValue("bot1") != "Lynx" || r.FormValue("bot2") != "Zeus" {
+			t.Errorf("unexpected request: %s %v", r.URL, r.PostForm)
+		}
+		writeJSON(w, []NHRLLiveFightStats{{BotName: "Zeus", BotType: "Vertical Spinner", HthW: 2}})
+	})
+
+	stats, err := getNHRLLiveFightStats("Lynx", "Zeus", "t1")
+	if err != nil {
+		t.Fatalf("getNHRLLiveFightStats: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want one failure and one retry", attempts)
+	}
+	if len(stats) != 1 || stats[0].BotName != "Zeus" || stats[0].HthW != 2 {
+		t.Errorf("stats = %+v, want Zeus's row", stats)
+	}
+}
+
+func TestLiveFightStatsEmptyAndClientErrors(t *testing.T) {
+	stub := newUpstreamStub(t)
+	path := statsbookHost + "/live_stats/query/get_fight_stats.php"
+
+	// A 200 with no body means no stats for the pairing, not a failure to retry
+	stub.handle(path, func(w http.ResponseWriter, r *http.Request) {})
+	stats, err := getNHRLLiveFightStats("Lynx", "Zeus", "t1")
+	if err != nil || stats == nil || len(stats) != 0 {
+		t.Errorf("empty response = %v, %v; want an empty list", stats, err)
+	}
+	if calls := stub.count(path); calls != 1 {
+		t.Errorf("empty response made %d requests, want 1", calls)
+	}
+
+	// Client errors are not retried
+	stub.handle(path, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad tournament", http.StatusBadRequest)
+	})
+	if _, err := getNHRLLiveFightStats("Lynx", "Zeus", "t1"); err == nil {
+		t.Error("a 400 response was not reported")
+	}
+	if calls := stub.count(path); calls != 2 {
+		t.Errorf("400 response made %d requests, want 1", calls-1)
+	}
+}
+
+func TestBotTypeCacheSkipsMissesAndKeysByTournament(t *testing.T) {
+	stub := newUpstreamStub(t)
+	path := statsbookHost + "/live_stats/query/get_fight_stats.php"
+	types := map[string]NHRLLiveFightStats{}
+	stub.liveStats(types)
+
+	// A miss is not cached, so the type is found once the service has it
+	if got := getNHRLBotTypeCached("Lynx", "Zeus", "t1"); got != "" {
+		t.Errorf("type before the service knows Zeus = %q, want none", got)
+	}
+	types["Zeus"] = NHRLLiveFightStats{BotType: "Vertical Spinner"}
+	for i := 0; i < 2; i++ {
+		if got := getNHRLBotTypeCached("Lynx", "Zeus", "t1"); got != "Vertical Spinner" {
+			t.Errorf("type = %q, want Vertical Spinner", got)
+		}
+	}
+	if calls := stub.count(path); calls != 2 {
+		t.Errorf("made %d requests, want the miss and one cached hit", calls)
+	}
+
+	// Another tournament is looked up on its own
+	types["Zeus"] = NHRLLiveFightStats{BotType: "Drum Spinner"}
+	if got := getNHRLBotTypeCached("Lynx", "Zeus", "t2"); got != "Drum Spinner" {
+		t.Errorf("type in t2 = %q, want Drum Spinner", got)
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+)
+
+// Output formats accepted by operations that support the output_format parameter
+const (
+	outputFormatJSON     = "json"
+	outputFormatMarkdown = "markdown"
+	outputFormatCSV      = "csv"
+	outputFormatText     = "text"
+)
+
+// getOutputFormat reads the output_format argument, defaulting to JSON, and
+// checks it against the formats the operation supports
+func getOutputFormat(args map[string]interface{}, supported ...string) (string, error) {
+	format := outputFormatJSON
+	if f, ok := args["output_format"].(string); ok && f != "" {
+		format = strings.ToLower(f)
+	}
+
+	if format == outputFormatJSON {
+		return format, nil
+	}
+	for _, s := range supported {
+		if format == s {
+			return format, nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported output_format: %s (supported: %s)", format, strings.Join(append([]string{outputFormatJSON}, supported...), ", "))
+}
+
+// markdownTable renders rows as a GitHub-flavored markdown table
+func markdownTable(headers []string, rows [][]string) string {
+	escape := func(cell string) string {
+		return strings.ReplaceAll(strings.ReplaceAll(cell, "|", "\\|"), "\n", " ")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("| ")
+	for i, header := range headers {
+		if i > 0 {
+			sb.WriteString(" | ")
+		}
+		sb.WriteString(escape(header))
+	}
+	sb.WriteString(" |\n|")
+	for range headers {
+		sb.WriteString(" --- |")
+	}
+	sb.WriteString("\n")
+
+	for _, row := range rows {
+		sb.WriteString("| ")
+		for i, cell := range row {
+			if i > 0 {
+				sb.WriteString(" | ")
+			}
+			sb.WriteString(escape(cell))
+		}
+		sb.WriteString(" |\n")
+	}
+
+	return sb.String()
+}
+
+// csvTable renders rows as RFC 4180 CSV with a header line
+func csvTable(headers []string, rows [][]string) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(headers); err != nil {
+		return "", fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return "", fmt.Errorf("failed to write CSV rows: %w", err)
+	}
+	return buf.String(), nil
+}
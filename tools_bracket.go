@@ -3,7 +3,12 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 // handleBracketTool handles bracket visualization operations
@@ -25,6 +30,16 @@ func handleBracketTool(args map[string]interface{}) (string, error) {
 		return getBracketRound(args)
 	case "get_standings":
 		return getBracketStandings(args)
+	case "get_program":
+		return getBracketProgram(args)
+	case "get_seed_odds":
+		return getBracketSeedOdds(args)
+	case "get_bracket_difficulty":
+		return getBracketDifficulty(args)
+	case "project_next_round":
+		return projectNextRound(args)
+	case "export_standings":
+		return exportStandings(args)
 	default:
 		return "", fmt.Errorf("unknown operation: %s", operation)
 	}
@@ -64,8 +79,16 @@ NHRL tournaments typically use double elimination format where:
 
 - get: Retrieve complete bracket with all rounds and matches
 - get_round: Focus on specific round of competition  
-- get_standings: Show current player rankings and records`,
-					"enum": []string{"get", "get_round", "get_standings"},
+- get_standings: Show current player rankings and records (optional sort_by: placement, wins, seed, name)
+- get_program: Printable program/heat sheet with first-round (or Q1) matchups, seeds, cages, scheduled times, and the participant list (output_format: json or markdown)
+- get_seed_odds: Seed-implied probability of each bot reaching each round and winning, from a standard seeded bracket model (ignores fight history)
+- get_bracket_difficulty: Each bot's projected path to the final from seeding, scored by the NHRL ranks of the opponents on it, hardest road first
+- export_standings: Final placements in a neutral upload format for ranking aggregators, one row per bot with columns event, event_date, bot, placement, wins, losses, seed (blank when unknown) (output_format: json or csv)
+- project_next_round: Upcoming matchups if current results hold, following each completed game's winner and loser to their next slots; unfinished feeders show as TBD`,
+					"enum": []string{
+						"get", "get_round", "get_standings", "get_program", "get_seed_odds", "get_bracket_difficulty",
+						"export_standings", "project_next_round",
+					},
 				},
 				"tournament_id": map[string]interface{}{
 					"type":        "string",
@@ -80,6 +103,16 @@ NHRL tournaments typically use double elimination format where:
 					"description": "Filter results by bracket type. Use 'winners' for undefeated path, 'losers' for elimination bracket, 'all' for both.",
 					"enum":        []string{"winners", "losers", "all"},
 				},
+				"output_format": map[string]interface{}{
+					"type":        "string",
+					"description": "Output format for get_program (json or markdown) and export_standings (json or csv). Defaults to json; markdown returns a printable document.",
+					"enum":        []string{"json", "markdown", "csv"},
+				},
+				"sort_by": map[string]interface{}{
+					"type":        "string",
+					"description": "Primary sort key for get_standings. Defaults to placement. Remaining keys break ties in the order placement, wins, seed, name.",
+					"enum":        []string{"placement", "wins", "seed", "name"},
+				},
 			},
 			"required": []string{"operation", "tournament_id"},
 		},
@@ -210,6 +243,9 @@ func getBracket(args map[string]interface{}) (string, error) {
 		},
 	}
 
+	title, _ := tournament["title"].(string)
+	flagTestTournament(bracketSummary, title)
+
 	jsonData, err := json.MarshalIndent(bracketSummary, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal result: %w", err)
@@ -303,6 +339,9 @@ func getBracketRound(args map[string]interface{}) (string, error) {
 		"activeCount":    countActiveGames(roundGames),
 	}
 
+	title, _ := tournament["title"].(string)
+	flagTestTournament(result, title)
+
 	jsonData, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal result: %w", err)
@@ -379,29 +418,29 @@ func getBracketStandings(args map[string]interface{}) (string, error) {
 		}
 	}
 
-	// Sort by placement (nil placement goes to end)
-	sort.Slice(standings, func(i, j int) bool {
-		placeI := standings[i]["placement"]
-		placeJ := standings[j]["placement"]
+	sortBy := "placement"
+	if s, ok := args["sort_by"].(string); ok && s != "" {
+		sortBy = s
+	}
 
-		if placeI == nil && placeJ == nil {
-			// Both nil, sort by wins then losses
-			winsI := standings[i]["wins"].(float64)
-			winsJ := standings[j]["wins"].(float64)
-			if winsI != winsJ {
-				return winsI > winsJ
-			}
-			lossesI := standings[i]["losses"].(float64)
-			lossesJ := standings[j]["losses"].(float64)
-			return lossesI < lossesJ
-		}
-		if placeI == nil {
-			return false
-		}
-		if placeJ == nil {
-			return true
-		}
-		return placeI.(float64) < placeJ.(float64)
+	// Each sort order falls through the remaining keys so ties are always
+	// broken the same way and standings don't reshuffle between calls
+	var keys []string
+	switch sortBy {
+	case "placement":
+		keys = []string{"placement", "wins", "seed", "name"}
+	case "wins":
+		keys = []string{"wins", "placement", "seed", "name"}
+	case "seed":
+		keys = []string{"seed", "placement", "wins", "name"}
+	case "name":
+		keys = []string{"name"}
+	default:
+		return "", fmt.Errorf("invalid sort_by: %s (must be placement, wins, seed, or name)", sortBy)
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		return compareStandings(standings[i], standings[j], keys) < 0
 	})
 
 	result := map[string]interface{}{
@@ -410,9 +449,200 @@ func getBracketStandings(args map[string]interface{}) (string, error) {
 		"status":         getTournamentStatus(tournament),
 		"standings":      standings,
 		"playerCount":    len(standings),
-		"note":           "Players are sorted by placement. Players without placement are sorted by record.",
+		"sortBy":         sortBy,
+		"note":           standingsSortNote(keys),
+	}
+
+	title, _ := tournament["title"].(string)
+	flagTestTournament(result, title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get a printable program/heat sheet: first-round matchups and participants
+func getBracketProgram(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	outputFormat, err := getOutputFormat(args, outputFormatMarkdown)
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	playerMap := make(map[string]Player, len(tournament.Players))
+	var participants []Player
+	for _, player := range tournament.Players {
+		playerMap[player.ID] = player
+		if !player.IsBye {
+			participants = append(participants, player)
+		}
+	}
+	locationNames := make(map[string]string, len(tournament.Locations))
+	for _, location := range tournament.Locations {
+		locationNames[location.ID] = location.Name
+	}
+
+	// The first round is the opening qualifier (Q1) when the event has
+	// qualifiers, otherwise the earliest winners bracket round (highest number)
+	var firstRound []Game
+	for _, game := range tournament.Games {
+		if strings.HasPrefix(strings.ToUpper(game.Name), "Q1") {
+			firstRound = append(firstRound, game)
+		}
+	}
+	roundLabel := "Opening (Q1)"
+	if len(firstRound) == 0 {
+		maxRound := 0
+		for _, game := range tournament.Games {
+			if game.Round > maxRound {
+				maxRound = game.Round
+			}
+		}
+		for _, game := range tournament.Games {
+			if maxRound > 0 && game.Round == maxRound {
+				firstRound = append(firstRound, game)
+			}
+		}
+		roundLabel = getRoundName(maxRound, "winners", tournament.Format.Type)
+	}
+
+	sort.SliceStable(firstRound, func(i, j int) bool {
+		ti, tj := int64(0), int64(0)
+		if firstRound[i].ScheduledTime != nil {
+			ti = *firstRound[i].ScheduledTime
+		}
+		if firstRound[j].ScheduledTime != nil {
+			tj = *firstRound[j].ScheduledTime
+		}
+		if ti != tj {
+			return ti < tj
+		}
+		return firstRound[i].Name < firstRound[j].Name
+	})
+
+	sort.SliceStable(participants, func(i, j int) bool {
+		seedI, seedJ := participants[i].Seed, participants[j].Seed
+		if (seedI == nil) != (seedJ == nil) {
+			return seedI != nil
+		}
+		if seedI != nil && *seedI != *seedJ {
+			return *seedI < *seedJ
+		}
+		return strings.ToLower(participants[i].Name) < strings.ToLower(participants[j].Name)
+	})
+
+	type programEntrant struct {
+		Name string `json:"name"`
+		Seed *int   `json:"seed"`
+	}
+	type programMatch struct {
+		GameID      string           `json:"gameID"`
+		Name        string           `json:"name"`
+		Cage        string           `json:"cage,omitempty"`
+		ScheduledAt string           `json:"scheduledAt,omitempty"`
+		Entrants    []programEntrant `json:"entrants"`
 	}
 
+	matches := make([]programMatch, len(firstRound))
+	for i, game := range firstRound {
+		match := programMatch{GameID: game.ID, Name: game.Name}
+		if game.LocationID != nil {
+			match.Cage = locationNames[*game.LocationID]
+		}
+		if game.ScheduledTime != nil && *game.ScheduledTime > 0 {
+			match.ScheduledAt = trueFinalsTime(*game.ScheduledTime).UTC().Format(time.RFC3339)
+		}
+		for _, slot := range game.Slots {
+			entrant := programEntrant{Name: "TBD"}
+			if slot.PlayerID != nil {
+				if player, ok := playerMap[*slot.PlayerID]; ok {
+					entrant.Name = player.Name
+					entrant.Seed = player.Seed
+					if player.IsBye {
+						entrant.Name = "BYE"
+					}
+				}
+			}
+			match.Entrants = append(match.Entrants, entrant)
+		}
+		matches[i] = match
+	}
+
+	entrantList := make([]programEntrant, len(participants))
+	for i, player := range participants {
+		entrantList[i] = programEntrant{Name: player.Name, Seed: player.Seed}
+	}
+
+	if outputFormat == outputFormatMarkdown {
+		seedText := func(e programEntrant) string {
+			if e.Seed == nil {
+				return e.Name
+			}
+			return fmt.Sprintf("(%d) %s", *e.Seed, e.Name)
+		}
+
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "# %s\n\n", tournament.Title)
+		if tournament.EventLocation != "" {
+			fmt.Fprintf(&sb, "%s\n\n", tournament.EventLocation)
+		}
+
+		fmt.Fprintf(&sb, "## %s\n\n", roundLabel)
+		rows := make([][]string, len(matches))
+		for i, match := range matches {
+			var entrants []string
+			for _, entrant := range match.Entrants {
+				entrants = append(entrants, seedText(entrant))
+			}
+			rows[i] = []string{match.Name, match.Cage, match.ScheduledAt, strings.Join(entrants, " vs ")}
+		}
+		sb.WriteString(markdownTable([]string{"Match", "Cage", "Scheduled (UTC)", "Matchup"}, rows))
+
+		fmt.Fprintf(&sb, "\n## Participants (%d)\n\n", len(entrantList))
+		rows = make([][]string, len(entrantList))
+		for i, entrant := range entrantList {
+			seed := "-"
+			if entrant.Seed != nil {
+				seed = fmt.Sprintf("%d", *entrant.Seed)
+			}
+			rows[i] = []string{seed, entrant.Name}
+		}
+		sb.WriteString(markdownTable([]string{"Seed", "Bot"}, rows))
+
+		return sb.String(), nil
+	}
+
+	result := map[string]interface{}{
+		"tournamentID":     tournamentID,
+		"tournamentName":   tournament.Title,
+		"eventLocation":    tournament.EventLocation,
+		"firstRoundName":   roundLabel,
+		"firstRound":       matches,
+		"matchCount":       len(matches),
+		"participants":     entrantList,
+		"participantCount": len(entrantList),
+	}
+
+	flagTestTournament(result, tournament.Title)
+
 	jsonData, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal result: %w", err)
@@ -421,6 +651,758 @@ func getBracketStandings(args map[string]interface{}) (string, error) {
 	return string(jsonData), nil
 }
 
+// Columns of the neutral standings export, in order
+var exportStandingsColumns = []string{"event", "event_date", "bot", "placement", "wins", "losses", "seed"}
+
+// Export final placements in a neutral format for ranking aggregators
+func exportStandings(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	outputFormat, err := getOutputFormat(args, outputFormatCSV)
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	eventDate := ""
+	for _, ts := range []*int64{tournament.StartTime, tournament.ScheduledStartTime} {
+		if ts != nil && *ts > 0 {
+			eventDate = trueFinalsTime(*ts).UTC().Format("2006-01-02")
+			break
+		}
+	}
+
+	var players []Player
+	for _, player := range tournament.Players {
+		if !player.IsBye {
+			players = append(players, player)
+		}
+	}
+
+	// Placement first (missing placements last), then wins, seed, and name
+	sort.SliceStable(players, func(i, j int) bool {
+		a, b := players[i], players[j]
+		if (a.Placement == nil) != (b.Placement == nil) {
+			return a.Placement != nil
+		}
+		if a.Placement != nil && *a.Placement != *b.Placement {
+			return *a.Placement < *b.Placement
+		}
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		if (a.Seed == nil) != (b.Seed == nil) {
+			return a.Seed != nil
+		}
+		if a.Seed != nil && *a.Seed != *b.Seed {
+			return *a.Seed < *b.Seed
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	})
+
+	type standingRow struct {
+		Event     string `json:"event"`
+		EventDate string `json:"event_date"`
+		Bot       string `json:"bot"`
+		Placement *int   `json:"placement"`
+		Wins      int    `json:"wins"`
+		Losses    int    `json:"losses"`
+		Seed      *int   `json:"seed"`
+	}
+
+	rows := make([]standingRow, len(players))
+	for i, player := range players {
+		rows[i] = standingRow{
+			Event:     tournament.Title,
+			EventDate: eventDate,
+			Bot:       player.Name,
+			Placement: player.Placement,
+			Wins:      player.Wins,
+			Losses:    player.Losses,
+			Seed:      player.Seed,
+		}
+	}
+
+	if outputFormat == outputFormatCSV {
+		optional := func(n *int) string {
+			if n == nil {
+				return ""
+			}
+			return strconv.Itoa(*n)
+		}
+		records := make([][]string, len(rows))
+		for i, row := range rows {
+			records[i] = []string{
+				row.Event, row.EventDate, row.Bot, optional(row.Placement),
+				strconv.Itoa(row.Wins), strconv.Itoa(row.Losses), optional(row.Seed),
+			}
+		}
+		return csvTable(exportStandingsColumns, records)
+	}
+
+	result := map[string]interface{}{
+		"tournamentID": tournamentID,
+		"columns":      exportStandingsColumns,
+		"rowCount":     len(rows),
+		"standings":    rows,
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// seededBracketOrder returns the seeds of a standard seeded bracket of the
+// given power-of-two size in slot order, so 1 and 2 can only meet in the final
+// (for 8: 1, 8, 4, 5, 2, 7, 3, 6)
+func seededBracketOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		next := make([]int, 0, len(order)*2)
+		sum := len(order)*2 + 1
+		for _, seed := range order {
+			next = append(next, seed, sum-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// seedWinProbability is the chance seed a beats seed b when each bot's
+// strength is the inverse of its seed
+func seedWinProbability(a, b int) float64 {
+	return float64(b) / float64(a+b)
+}
+
+// seedRoundName labels the round a bot reaches when fieldSize bots remain
+func seedRoundName(fieldSize int) string {
+	switch fieldSize {
+	case 1:
+		return "Champion"
+	case 2:
+		return "Finals"
+	case 4:
+		return "Semifinals"
+	case 8:
+		return "Quarterfinals"
+	}
+	return fmt.Sprintf("Round of %d", fieldSize)
+}
+
+// seedAdvancementOdds plays out a standard seeded bracket for numBots bots
+// (effective seeds 1..numBots, seeds past numBots are byes) and returns the
+// round names with, per bracket slot, the probability of reaching each round
+func seedAdvancementOdds(numBots int) ([]string, [][]float64) {
+	size := nextPowerOfTwo(numBots)
+	order := seededBracketOrder(size)
+
+	// reach[slot] is the probability the bot in that slot is still alive;
+	// a bye slot holds 0 so its opponent advances unopposed
+	reach := make([]float64, size)
+	for slot, seed := range order {
+		if seed <= numBots {
+			reach[slot] = 1
+		}
+	}
+
+	roundNames := []string{seedRoundName(size)}
+	history := make([][]float64, size)
+	for slot := range history {
+		history[slot] = []float64{reach[slot]}
+	}
+
+	for block := 2; block <= size; block *= 2 {
+		next := make([]float64, size)
+		for slot := 0; slot < size; slot++ {
+			if order[slot] > numBots {
+				continue
+			}
+			// Opponents come from the other half of this slot's block
+			start := slot / block * block
+			half := block / 2
+			oppStart := start + half
+			if slot >= start+half {
+				oppStart = start
+			}
+			win, opposed := 0.0, 0.0
+			for opp := oppStart; opp < oppStart+half; opp++ {
+				if order[opp] > numBots {
+					continue
+				}
+				win += reach[opp] * seedWinProbability(order[slot], order[opp])
+				opposed += reach[opp]
+			}
+			// Any remaining probability mass is a bye through this round
+			next[slot] = reach[slot] * (win + (1 - opposed))
+		}
+		reach = next
+		roundNames = append(roundNames, seedRoundName(size/block))
+		for slot := range history {
+			history[slot] = append(history[slot], reach[slot])
+		}
+	}
+
+	return roundNames, history
+}
+
+// Compute each bot's seed-implied odds of reaching each round and winning
+func getBracketSeedOdds(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	var participants []Player
+	for _, player := range tournament.Players {
+		if !player.IsBye {
+			participants = append(participants, player)
+		}
+	}
+	if len(participants) < 2 {
+		return "", fmt.Errorf("tournament %s needs at least 2 players to compute seed odds", tournamentID)
+	}
+
+	// Seeded bots keep their order; unseeded bots follow alphabetically
+	sort.SliceStable(participants, func(i, j int) bool {
+		seedI, seedJ := participants[i].Seed, participants[j].Seed
+		if (seedI == nil) != (seedJ == nil) {
+			return seedI != nil
+		}
+		if seedI != nil && *seedI != *seedJ {
+			return *seedI < *seedJ
+		}
+		return strings.ToLower(participants[i].Name) < strings.ToLower(participants[j].Name)
+	})
+
+	numBots := len(participants)
+	size := nextPowerOfTwo(numBots)
+	order := seededBracketOrder(size)
+	roundNames, history := seedAdvancementOdds(numBots)
+
+	round := func(p float64) float64 {
+		return float64(int(p*10000+0.5)) / 10000
+	}
+
+	odds := make([]map[string]interface{}, 0, numBots)
+	for slot, seed := range order {
+		if seed > numBots {
+			continue
+		}
+		player := participants[seed-1]
+		rounds := make([]map[string]interface{}, len(roundNames))
+		for i, name := range roundNames {
+			rounds[i] = map[string]interface{}{
+				"round":       name,
+				"probability": round(history[slot][i]),
+			}
+		}
+		entry := map[string]interface{}{
+			"playerID":       player.ID,
+			"name":           player.Name,
+			"effectiveSeed":  seed,
+			"winProbability": round(history[slot][len(roundNames)-1]),
+			"rounds":         rounds,
+		}
+		if player.Seed != nil {
+			entry["seed"] = *player.Seed
+		}
+		odds = append(odds, entry)
+	}
+	sort.SliceStable(odds, func(i, j int) bool {
+		return odds[i]["effectiveSeed"].(int) < odds[j]["effectiveSeed"].(int)
+	})
+
+	result := map[string]interface{}{
+		"tournamentID":   tournamentID,
+		"tournamentName": tournament.Title,
+		"playerCount":    numBots,
+		"bracketSize":    size,
+		"byes":           size - numBots,
+		"odds":           odds,
+		"model":          "Purely seed-driven: bots are placed in a standard seeded single-elimination bracket and seed a beats seed b with probability b/(a+b). Results and fight history are ignored, and the losers bracket is not modeled.",
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Maximum number of bot rank lookups get_bracket_difficulty runs at once
+const bracketDifficultyRankConcurrency = 4
+
+// Rank how hard each bot's projected road to the final is, from seeding and NHRL ranks
+func getBracketDifficulty(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	var participants []Player
+	for _, player := range tournament.Players {
+		if !player.IsBye {
+			participants = append(participants, player)
+		}
+	}
+	if len(participants) < 2 {
+		return "", fmt.Errorf("tournament %s needs at least 2 players to compute bracket difficulty", tournamentID)
+	}
+
+	// Same effective seeding as get_seed_odds
+	sort.SliceStable(participants, func(i, j int) bool {
+		seedI, seedJ := participants[i].Seed, participants[j].Seed
+		if (seedI == nil) != (seedJ == nil) {
+			return seedI != nil
+		}
+		if seedI != nil && *seedI != *seedJ {
+			return *seedI < *seedJ
+		}
+		return strings.ToLower(participants[i].Name) < strings.ToLower(participants[j].Name)
+	})
+
+	numBots := len(participants)
+	size := nextPowerOfTwo(numBots)
+	order := seededBracketOrder(size)
+
+	// Ranks are looked up through the NHRL cache, at most
+	// bracketDifficultyRankConcurrency at a time; 0 means unranked
+	ranks := make([]int, numBots)
+	sem := make(chan struct{}, bracketDifficultyRankConcurrency)
+	var wg sync.WaitGroup
+	for i, player := range participants {
+		wg.Add(1)
+		go func(i int, botName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if rank, err := getNHRLBotRankCached(botName); err == nil {
+				ranks[i] = rank
+			}
+		}(i, player.Name)
+	}
+	wg.Wait()
+	worstRank := 0
+	for _, rank := range ranks {
+		worstRank = max(worstRank, rank)
+	}
+	// Unranked bots count as one place below the lowest-ranked bot in the field
+	effectiveRank := func(seed int) int {
+		if rank := ranks[seed-1]; rank > 0 {
+			return rank
+		}
+		return worstRank + 1
+	}
+
+	type pathOpponent struct {
+		Round string `json:"round"`
+		Name  string `json:"name"`
+		Seed  int    `json:"effectiveSeed"`
+		Rank  int    `json:"rank"`
+	}
+	type road struct {
+		Name            string         `json:"name"`
+		Seed            int            `json:"effectiveSeed"`
+		Rank            int            `json:"rank"`
+		Path            []pathOpponent `json:"projectedPath"`
+		AvgOpponentRank float64        `json:"averageOpponentRank"`
+		SumOpponentRank int            `json:"sumOpponentRank"`
+	}
+
+	roads := make([]road, 0, numBots)
+	for slot, seed := range order {
+		if seed > numBots {
+			continue
+		}
+		r := road{Name: participants[seed-1].Name, Seed: seed, Rank: ranks[seed-1], Path: []pathOpponent{}}
+
+		// In each round the projected opponent is the best seed in the
+		// other half of this slot's block, assuming favorites win
+		for block := 2; block <= size; block *= 2 {
+			start := slot / block * block
+			half := block / 2
+			oppStart := start + half
+			if slot >= start+half {
+				oppStart = start
+			}
+			best := 0
+			for opp := oppStart; opp < oppStart+half; opp++ {
+				if s := order[opp]; s <= numBots && (best == 0 || s < best) {
+					best = s
+				}
+			}
+			if best == 0 {
+				continue // a bye
+			}
+			r.Path = append(r.Path, pathOpponent{
+				Round: seedRoundName(size / (block / 2)),
+				Name:  participants[best-1].Name,
+				Seed:  best,
+				Rank:  ranks[best-1],
+			})
+			r.SumOpponentRank += effectiveRank(best)
+		}
+		if len(r.Path) > 0 {
+			r.AvgOpponentRank = math.Round(float64(r.SumOpponentRank)/float64(len(r.Path))*10) / 10
+		}
+		roads = append(roads, r)
+	}
+
+	// Hardest road first: strongest average opposition, then more matches
+	sort.SliceStable(roads, func(i, j int) bool {
+		if roads[i].AvgOpponentRank != roads[j].AvgOpponentRank {
+			return roads[i].AvgOpponentRank < roads[j].AvgOpponentRank
+		}
+		if len(roads[i].Path) != len(roads[j].Path) {
+			return len(roads[i].Path) > len(roads[j].Path)
+		}
+		return roads[i].Seed < roads[j].Seed
+	})
+
+	result := map[string]interface{}{
+		"tournamentID":   tournamentID,
+		"tournamentName": tournament.Title,
+		"playerCount":    numBots,
+		"bracketSize":    size,
+		"hardestRoad":    roads[0].Name,
+		"roads":          roads,
+		"model":          "Each bot's projected path assumes the better seed wins every match of a standard seeded single-elimination bracket. Lower averageOpponentRank means a harder road; a rank of 0 means unranked, scored one place below the lowest-ranked bot in the field.",
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// parseGameSlotID splits a TrueFinals game slot ID into its game ID and slot
+// index. The index follows the last separator; the game must exist.
+func parseGameSlotID(slotID string, games map[string]*Game) (string, int, bool) {
+	cut := strings.LastIndexAny(slotID, "-_:")
+	if cut <= 0 {
+		return "", 0, false
+	}
+	idx, err := strconv.Atoi(slotID[cut+1:])
+	if err != nil {
+		return "", 0, false
+	}
+	gameID := slotID[:cut]
+	if _, ok := games[gameID]; !ok {
+		return "", 0, false
+	}
+	return gameID, idx, true
+}
+
+// slotFeed records which game feeds a slot and whether its winner or loser advances
+type slotFeed struct {
+	gameID string
+	winner bool
+}
+
+// Project upcoming matchups if current results hold
+func projectNextRound(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	playerNames := make(map[string]string)
+	for _, player := range tournament.Players {
+		playerNames[player.ID] = player.Name
+	}
+
+	games := make(map[string]*Game)
+	for i := range tournament.Games {
+		games[tournament.Games[i].ID] = &tournament.Games[i]
+	}
+
+	// Follow nextGameSlotIDs: the first entry is where the winner goes, the
+	// second where the loser goes
+	feeds := make(map[string]map[int]slotFeed)
+	for _, game := range tournament.Games {
+		for i, slotID := range game.NextGameSlotIDs {
+			if i > 1 {
+				break
+			}
+			nextID, idx, ok := parseGameSlotID(slotID, games)
+			if !ok {
+				continue
+			}
+			if feeds[nextID] == nil {
+				feeds[nextID] = make(map[int]slotFeed)
+			}
+			feeds[nextID][idx] = slotFeed{gameID: game.ID, winner: i == 0}
+		}
+	}
+
+	// Fall back to prevGameID where the slot ID could not be resolved; a feed
+	// that crosses into the losers bracket carries the loser
+	for _, game := range tournament.Games {
+		for _, slot := range game.Slots {
+			if slot.PrevGameID == nil {
+				continue
+			}
+			if _, ok := feeds[game.ID][slot.SlotIdx]; ok {
+				continue
+			}
+			prev, ok := games[*slot.PrevGameID]
+			if !ok {
+				continue
+			}
+			if feeds[game.ID] == nil {
+				feeds[game.ID] = make(map[int]slotFeed)
+			}
+			feeds[game.ID][slot.SlotIdx] = slotFeed{gameID: prev.ID, winner: !(prev.Round > 0 && game.Round < 0)}
+		}
+	}
+
+	var projected []map[string]interface{}
+	readyCount := 0
+	for _, game := range tournament.Games {
+		if game.State == "done" || len(game.Slots) != 2 {
+			continue
+		}
+
+		slots := make([]map[string]interface{}, 0, 2)
+		known := 0
+		for _, slot := range game.Slots {
+			entry := map[string]interface{}{"slotIdx": slot.SlotIdx}
+			feed, fed := feeds[game.ID][slot.SlotIdx]
+			if fed {
+				entry["fromGame"] = games[feed.gameID].Name
+				if feed.winner {
+					entry["fromResult"] = "winner"
+				} else {
+					entry["fromResult"] = "loser"
+				}
+			}
+
+			switch {
+			case slot.PlayerID != nil:
+				entry["bot"] = playerNames[*slot.PlayerID]
+				entry["status"] = "seated"
+				known++
+			case fed && games[feed.gameID].State == "done":
+				prev := games[feed.gameID]
+				winnerID := trueFinalsGameWinner(*prev)
+				botID := winnerID
+				if !feed.winner && winnerID != "" {
+					botID = ""
+					for _, prevSlot := range prev.Slots {
+						if prevSlot.PlayerID != nil && *prevSlot.PlayerID != winnerID {
+							botID = *prevSlot.PlayerID
+						}
+					}
+				}
+				if botID == "" {
+					entry["bot"] = "TBD"
+					entry["status"] = "tbd"
+					break
+				}
+				entry["bot"] = playerNames[botID]
+				entry["status"] = "projected"
+				known++
+			default:
+				entry["bot"] = "TBD"
+				entry["status"] = "tbd"
+			}
+			slots = append(slots, entry)
+		}
+
+		// Games with neither side decided are further out than the next round
+		if known == 0 {
+			continue
+		}
+		if known == 2 {
+			readyCount++
+		}
+
+		projected = append(projected, map[string]interface{}{
+			"gameID":    game.ID,
+			"gameName":  game.Name,
+			"round":     game.Round,
+			"roundName": getRoundName(abs(game.Round), getBracketTypeFromRound(game.Round), tournament.Format.Type),
+			"state":     game.State,
+			"matchup":   fmt.Sprintf("%s vs %s", slots[0]["bot"], slots[1]["bot"]),
+			"slots":     slots,
+			"ready":     known == 2,
+		})
+	}
+
+	sort.SliceStable(projected, func(i, j int) bool {
+		return projected[i]["gameName"].(string) < projected[j]["gameName"].(string)
+	})
+
+	result := map[string]interface{}{
+		"tournamentID":   tournamentID,
+		"tournamentName": tournament.Title,
+		"games":          projected,
+		"gameCount":      len(projected),
+		"readyCount":     readyCount,
+		"note":           "Projected bots advance from completed games via nextGameSlotIDs; TBD marks a slot whose feeding game is not done",
+	}
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// standingsSortNote describes the sort keys applied to standings, naming the
+// primary key and the tiebreakers after it in order
+func standingsSortNote(keys []string) string {
+	labels := make([]string, len(keys))
+	hasOptional := false
+	for i, key := range keys {
+		labels[i] = key
+		switch key {
+		case "wins":
+			labels[i] = "record (wins, then losses)"
+		case "placement", "seed":
+			hasOptional = true
+		}
+	}
+
+	note := "Sorted by " + labels[0]
+	switch tiebreaks := labels[1:]; len(tiebreaks) {
+	case 0:
+	case 1:
+		note += "; ties are broken by " + tiebreaks[0]
+	default:
+		note += "; ties are broken by " + strings.Join(tiebreaks[:len(tiebreaks)-1], ", ") + ", then " + tiebreaks[len(tiebreaks)-1]
+	}
+	note += "."
+	if hasOptional {
+		note += " Missing placements and seeds sort last."
+	}
+	return note
+}
+
+// compareStandings orders two standing entries by the given keys, returning
+// a negative number if a sorts first. The player ID is the final tiebreaker,
+// so the ordering is total.
+func compareStandings(a, b map[string]interface{}, keys []string) int {
+	for _, key := range keys {
+		var c int
+		switch key {
+		case "placement", "seed":
+			// Lower is better; missing values go to the end
+			c = compareOptionalNumbers(a[key], b[key])
+		case "wins":
+			// More wins first, then fewer losses
+			winsA, _ := a["wins"].(float64)
+			winsB, _ := b["wins"].(float64)
+			c = compareFloats(winsB, winsA)
+			if c == 0 {
+				lossesA, _ := a["losses"].(float64)
+				lossesB, _ := b["losses"].(float64)
+				c = compareFloats(lossesA, lossesB)
+			}
+		case "name":
+			c = strings.Compare(strings.ToLower(standingName(a)), strings.ToLower(standingName(b)))
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return strings.Compare(fmt.Sprint(a["playerID"]), fmt.Sprint(b["playerID"]))
+}
+
+// compareOptionalNumbers compares two JSON numbers ascending, with non-numbers last
+func compareOptionalNumbers(a, b interface{}) int {
+	numA, okA := a.(float64)
+	numB, okB := b.(float64)
+	switch {
+	case !okA && !okB:
+		return 0
+	case !okA:
+		return 1
+	case !okB:
+		return -1
+	}
+	return compareFloats(numA, numB)
+}
+
+func compareFloats(a, b float64) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// standingName returns the display name of a standing entry, falling back to its name
+func standingName(standing map[string]interface{}) string {
+	if displayName, ok := standing["displayName"].(string); ok && displayName != "" {
+		return displayName
+	}
+	name, _ := standing["name"].(string)
+	return name
+}
+
 // Helper function to enrich game data for bracket display
 func enrichGameForBracket(game map[string]interface{}, playerMap map[string]map[string]interface{}) map[string]interface{} {
 	enrichedGame := make(map[string]interface{})
@@ -0,0 +1,359 @@
+package main
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestBracketStandingsDeterministicTies(t *testing.T) {
+	stub := newUpstreamStub(t)
+
+	player := func(id, name string, placement, wins, losses, seed interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"id": id, "name": name, "placement": placement, "wins": wins, "losses": losses, "seed": seed,
+		}
+	}
+	players := []interface{}{
+		player("p1", "Zeus", 3, 2, 2, 5),
+		player("p2", "Lynx", 1, 4, 0, 1),
+		player("p3", "Bolt", 3, 2, 2, 4),
+		player("p4", "hydra", 3, 2, 2, nil),
+		player("p5", "Mole", 3, 2, 2, nil),
+		player("p6", "Titan", 3, 2, 1, 8),
+		map[string]interface{}{"id": "bye", "name": "BYE", "isBye": true},
+	}
+
+	// Each call sees the players in a different order, as the API does not promise one
+	calls := 0
+	stub.trueFinals("/v1/tournaments/t1", func(w http.ResponseWriter, r *http.Request) {
+		rotated := append(append([]interface{}{}, players[calls%len(players):]...), players[:calls%len(players)]...)
+		calls++
+		writeJSON(w, map[string]interface{}{"id": "t1", "title": "NHRL June 2025 3lb", "players": rotated})
+	})
+
+	// Titan has fewer losses; Bolt's seed beats Zeus's; unseeded bots follow by name
+	want := []string{"Lynx", "Titan", "Bolt", "Zeus", "hydra", "Mole"}
+	for call := 0; call < len(players); call++ {
+		output, err := getBracketStandings(map[string]interface{}{"tournament_id": "t1"})
+		if err != nil {
+			t.Fatalf("standings: %v", err)
+		}
+		standings := decodeResult(t, output)["standings"].([]interface{})
+		if len(standings) != len(want) {
+			t.Fatalf("call %d: %d standings, want %d", call, len(standings), len(want))
+		}
+		for i, name := range want {
+			if got := standings[i].(map[string]interface{})["name"]; got != name {
+				t.Fatalf("call %d: position %d = %v, want %s", call, i+1, got, name)
+			}
+		}
+	}
+
+	output, err := getBracketStandings(map[string]interface{}{"tournament_id": "t1", "sort_by": "seed"})
+	if err != nil {
+		t.Fatalf("standings: %v", err)
+	}
+	result := decodeResult(t, output)
+	standings := result["standings"].([]interface{})
+	if first, last := standings[0].(map[string]interface{})["name"], standings[5].(map[string]interface{})["name"]; first != "Lynx" || last != "Mole" {
+		t.Errorf("seed order runs %v … %v, want Lynx … Mole", first, last)
+	}
+	if want := "Sorted by seed; ties are broken by placement, record (wins, then losses), then name. Missing placements and seeds sort last."; result["note"] != want {
+		t.Errorf("note = %q, want %q", result["note"], want)
+	}
+
+	output, err = getBracketStandings(map[string]interface{}{"tournament_id": "t1", "sort_by": "name"})
+	if err != nil {
+		t.Fatalf("standings: %v", err)
+	}
+	if note := decodeResult(t, output)["note"]; note != "Sorted by name." {
+		t.Errorf("note = %q, want only the name order", note)
+	}
+	if _, err := getBracketStandings(map[string]interface{}{"tournament_id": "t1", "sort_by": "rank"}); err == nil {
+		t.Error("an unknown sort_by was accepted")
+	}
+}
+
+func TestBracketProgramFirstRoundOfSeededBracket(t *testing.T) {
+	stub := newUpstreamStub(t)
+	// An eight-bot single elimination bracket: round 3 opens, round 1 is the final
+	var games []Game
+	for i, pair := range [][2]string{{"Lynx", "Hydra"}, {"Mole", "Kite"}, {"Bolt", "Titan"}, {"Zeus", "Orca"}} {
+		game := tfGame("W3-"+strconv.Itoa(i+1), "available", pair[0], pair[1])
+		game.Round, game.LocationID = 3, strPtr("l1")
+		games = append(games, game)
+	}
+	for _, id := range []string{"W2-1", "W2-2", "W1-1"} {
+		game := tfGame(id, "unavailable")
+		game.Round = 2
+		if id == "W1-1" {
+			game.Round = 1
+		}
+		games = append(games, game)
+	}
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{
+		ID:        "t1",
+		Title:     "NHRL June 2025 3lb",
+		Format:    TournamentFormat{Type: "single_elimination"},
+		Players:   seeded("Lynx", "Zeus", "Bolt", "Mole", "Kite", "Titan", "Orca", "Hydra"),
+		Locations: []Location{{ID: "l1", Name: "Cage 1"}},
+		Games:     games,
+	})
+
+	output, err := getBracketProgram(map[string]interface{}{"tournament_id": "t1", "output_format": "json"})
+	if err != nil {
+		t.Fatalf("get_program: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["matchCount"] != 4.0 || result["participantCount"] != 8.0 {
+		t.Fatalf("matchCount = %v, participantCount = %v; want 4 and 8", result["matchCount"], result["participantCount"])
+	}
+	first := result["firstRound"].([]interface{})[0].(map[string]interface{})
+	entrants := first["entrants"].([]interface{})
+	top, bottom := entrants[0].(map[string]interface{}), entrants[1].(map[string]interface{})
+	if first["cage"] != "Cage 1" || top["name"] != "Lynx" || top["seed"] != 1.0 || bottom["name"] != "Hydra" || bottom["seed"] != 8.0 {
+		t.Errorf("first match = %v, want (1) Lynx vs (8) Hydra in Cage 1", first)
+	}
+
+	markdown, err := getBracketProgram(map[string]interface{}{"tournament_id": "t1", "output_format": "markdown"})
+	if err != nil {
+		t.Fatalf("get_program markdown: %v", err)
+	}
+	if rows := strings.Count(markdown, "| W3-"); rows != 4 {
+		t.Errorf("markdown program lists %d first-round matches, want 4:\n%s", rows, markdown)
+	}
+	if !strings.Contains(markdown, "(1) Lynx vs (8) Hydra") || !strings.Contains(markdown, "## Participants (8)") {
+		t.Errorf("markdown program is missing the seeded matchup or participant list:\n%s", markdown)
+	}
+}
+
+func TestSeedOddsEightSeedBracket(t *testing.T) {
+	stub := newUpstreamStub(t)
+	// Listed out of seed order, with a bye that is not a participant
+	players := seeded("Lynx", "Zeus", "Bolt", "Mole", "Kite", "Hydra", "Nova", "Wasp")
+	players[0], players[7] = players[7], players[0]
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{ID: "t1", Title: "NHRL June 2025 3lb", Players: players})
+
+	output, err := getBracketSeedOdds(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_seed_odds: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["playerCount"] != 8.0 || result["bracketSize"] != 8.0 || result["byes"] != 0.0 {
+		t.Errorf("playerCount = %v, bracketSize = %v, byes = %v; want 8, 8, 0", result["playerCount"], result["bracketSize"], result["byes"])
+	}
+	odds := result["odds"].([]interface{})
+	if len(odds) != 8 {
+		t.Fatalf("odds = %v, want 8 bots", odds)
+	}
+
+	total, previous := 0.0, 2.0
+	for i, o := range odds {
+		entry := o.(map[string]interface{})
+		win := entry["winProbability"].(float64)
+		total += win
+		if entry["effectiveSeed"] != float64(i+1) {
+			t.Errorf("odds[%d] effectiveSeed = %v, want %d", i, entry["effectiveSeed"], i+1)
+		}
+		if win >= previous {
+			t.Errorf("seed %d winProbability = %v, want below seed %d's %v", i+1, win, i, previous)
+		}
+		previous = win
+	}
+	if total < 0.999 || total > 1.001 {
+		t.Errorf("win probabilities sum to %v, want 1", total)
+	}
+
+	top := odds[0].(map[string]interface{})
+	if top["name"] != "Lynx" || top["seed"] != 1.0 {
+		t.Errorf("top seed = %v, want Lynx", top)
+	}
+	var rounds []string
+	for _, r := range top["rounds"].([]interface{}) {
+		round := r.(map[string]interface{})
+		rounds = append(rounds, round["round"].(string)+"="+strconv.FormatFloat(round["probability"].(float64), 'f', -1, 64))
+	}
+	// Seed 1 beats seed 8 with probability 8/9
+	if got := strings.Join(rounds, ","); !strings.HasPrefix(got, "Quarterfinals=1,Semifinals=0.8889,Finals=") || len(rounds) != 4 {
+		t.Errorf("top seed rounds = %s, want Quarterfinals=1, Semifinals=0.8889, then Finals and the title", got)
+	}
+}
+
+func TestBracketDifficultyEightSeedBracket(t *testing.T) {
+	stub := newUpstreamStub(t)
+	names := []string{"Lynx", "Zeus", "Bolt", "Mole", "Kite", "Hydra", "Nova", "Wasp"}
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{ID: "t1", Title: "NHRL June 2025 3lb", Players: seeded(names...)})
+	// Ranks follow the seeds, except that the 8 seed is unranked
+	ranks := map[string]interface{}{}
+	for i, name := range names[:7] {
+		ranks[name] = NHRLRanking{Ranking: i + 1}
+	}
+	stub.statsbookByBot("get_rank.php", ranks)
+
+	output, err := getBracketDifficulty(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_bracket_difficulty: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["hardestRoad"] != "Hydra" {
+		t.Errorf("hardestRoad = %v, want the 6 seed, who meets seeds 3, 2, and 1", result["hardestRoad"])
+	}
+
+	// Seeds 6 and 7 tie, as do 5 and 8; the top seed has the easiest road
+	var order []string
+	for _, r := range result["roads"].([]interface{}) {
+		road := r.(map[string]interface{})
+		order = append(order, strconv.Itoa(int(road["effectiveSeed"].(float64)))+"="+strconv.FormatFloat(road["averageOpponentRank"].(float64), 'f', -1, 64))
+	}
+	if got := strings.Join(order, ","); got != "6=2,7=2,5=2.3,8=2.3,4=2.7,3=3,2=3.7,1=4.7" {
+		t.Errorf("roads = %s, want 6=2,7=2,5=2.3,8=2.3,4=2.7,3=3,2=3.7,1=4.7", got)
+	}
+
+	top := result["roads"].([]interface{})[7].(map[string]interface{})
+	var path []string
+	for _, o := range top["projectedPath"].([]interface{}) {
+		opponent := o.(map[string]interface{})
+		path = append(path, opponent["round"].(string)+":"+opponent["name"].(string))
+	}
+	if got := strings.Join(path, ","); got != "Quarterfinals:Wasp,Semifinals:Mole,Finals:Zeus" || top["sumOpponentRank"] != 14.0 {
+		t.Errorf("top seed path = %s summing %v, want Wasp, Mole, then Zeus summing 14 (Wasp scored as rank 8)", got, top["sumOpponentRank"])
+	}
+}
+
+func TestExportStandingsRowsMatchStandings(t *testing.T) {
+	stub := newUpstreamStub(t)
+	players := seeded("Lynx", "Zeus", "Bolt, Jr.", "Mole")
+	placed := func(i, placement, wins, losses int) {
+		if placement > 0 {
+			players[i].Placement = &placement
+		}
+		players[i].Wins, players[i].Losses = wins, losses
+	}
+	placed(0, 2, 3, 2)
+	placed(1, 1, 4, 1)
+	placed(2, 3, 2, 2)
+	placed(3, 0, 0, 2)
+	players[3].Seed = nil
+	started := int64(1750000000)
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{ID: "t1", Title: "NHRL June 2025 3lb", StartTime: &started, Players: players})
+
+	output, err := exportStandings(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("export_standings: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["rowCount"] != 4.0 {
+		t.Fatalf("rowCount = %v, want 4 (bye excluded)", result["rowCount"])
+	}
+	first := result["standings"].([]interface{})[0].(map[string]interface{})
+	if first["event"] != "NHRL June 2025 3lb" || first["event_date"] != "2025-06-15" || first["bot"] != "Zeus" ||
+		first["placement"] != 1.0 || first["wins"] != 4.0 || first["losses"] != 1.0 || first["seed"] != 2.0 {
+		t.Errorf("first row = %v, want Zeus placing 1st at 4-1 from seed 2 on 2025-06-15", first)
+	}
+
+	output, err = exportStandings(map[string]interface{}{"tournament_id": "t1", "output_format": "csv"})
+	if err != nil {
+		t.Fatalf("export_standings csv: %v", err)
+	}
+	records, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+	if err != nil {
+		t.Fatalf("parse CSV: %v\n%s", err, output)
+	}
+	want := [][]string{
+		{"event", "event_date", "bot", "placement", "wins", "losses", "seed"},
+		{"NHRL June 2025 3lb", "2025-06-15", "Zeus", "1", "4", "1", "2"},
+		{"NHRL June 2025 3lb", "2025-06-15", "Lynx", "2", "3", "2", "1"},
+		{"NHRL June 2025 3lb", "2025-06-15", "Bolt, Jr.", "3", "2", "2", "3"},
+		{"NHRL June 2025 3lb", "2025-06-15", "Mole", "", "0", "2", ""},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("CSV has %d records, want %d:\n%s", len(records), len(want), output)
+	}
+	for i := range want {
+		if strings.Join(records[i], "|") != strings.Join(want[i], "|") {
+			t.Errorf("CSV record %d = %q, want %q", i, records[i], want[i])
+		}
+	}
+}
+
+func TestProjectNextRoundPartialRound(t *testing.T) {
+	stub := newUpstreamStub(t)
+	opening := func(id, state string, winner int, next []string, playerIDs ...string) Game {
+		game := tfGame(id, state, playerIDs...)
+		game.Round = 1
+		game.NextGameSlotIDs = next
+		if winner > 0 {
+			game.Slots[winner-1].Score = 1
+		}
+		return game
+	}
+	empty := func(id string, round int) Game {
+		return Game{ID: id, Name: id, State: "unavailable", Round: round, Slots: []GameSlot{{GameID: id, SlotIdx: 0}, {GameID: id, SlotIdx: 1}}}
+	}
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{
+		ID:      "t1",
+		Title:   "NHRL June 2025 3lb",
+		Players: tfPlayers("Lynx", "Wasp", "Mole", "Kite", "Zeus", "Nova", "Bolt", "Hydra"),
+		Games: []Game{
+			opening("W1-1", "done", 1, []string{"W2-1-0", "L1-1-0"}, "Lynx", "Wasp"),
+			opening("W1-2", "done", 2, []string{"W2-1-1", "L1-1-1"}, "Mole", "Kite"),
+			opening("W1-3", "done", 1, []string{"W2-2-0", "L1-2-0"}, "Zeus", "Nova"),
+			opening("W1-4", "active", 0, []string{"W2-2-1", "L1-2-1"}, "Bolt", "Hydra"),
+			empty("W2-1", 2),
+			empty("W2-2", 2),
+			empty("L1-1", -1),
+			empty("L1-2", -1),
+		},
+	})
+
+	output, err := projectNextRound(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("project_next_round: %v", err)
+	}
+	result := decodeResult(t, output)
+
+	matchups := make(map[string]string)
+	ready := make(map[string]bool)
+	for _, g := range result["games"].([]interface{}) {
+		game := g.(map[string]interface{})
+		matchups[game["gameID"].(string)] = game["matchup"].(string)
+		ready[game["gameID"].(string)] = game["ready"].(bool)
+	}
+	want := map[string]string{
+		"W2-1": "Lynx vs Kite",
+		"L1-1": "Wasp vs Mole",
+		"W2-2": "Zeus vs TBD",
+		"L1-2": "Nova vs TBD",
+		"W1-4": "Bolt vs Hydra",
+	}
+	if len(matchups) != len(want) {
+		t.Errorf("projected games = %v, want %d", matchups, len(want))
+	}
+	for id, matchup := range want {
+		if matchups[id] != matchup {
+			t.Errorf("%s = %q, want %q", id, matchups[id], matchup)
+		}
+	}
+	if !ready["W2-1"] || !ready["L1-1"] || ready["W2-2"] || ready["L1-2"] || result["readyCount"] != 3.0 {
+		t.Errorf("ready = %v (readyCount %v), want W2-1, L1-1, and the seated W1-4 ready", ready, result["readyCount"])
+	}
+
+	// Each projected slot records the game and result that fills it
+	for _, g := range result["games"].([]interface{}) {
+		game := g.(map[string]interface{})
+		if game["gameID"] != "L1-2" {
+			continue
+		}
+		slots := game["slots"].([]interface{})
+		nova, tbd := slots[0].(map[string]interface{}), slots[1].(map[string]interface{})
+		if nova["status"] != "projected" || nova["fromGame"] != "W1-3" || nova["fromResult"] != "loser" {
+			t.Errorf("L1-2 slot 0 = %v, want Nova projected as the loser of W1-3", nova)
+		}
+		if tbd["status"] != "tbd" || tbd["fromGame"] != "W1-4" {
+			t.Errorf("L1-2 slot 1 = %v, want TBD from W1-4", tbd)
+		}
+	}
+}
@@ -3,6 +3,11 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 )
 
 // handleGamesTool handles all game operations
@@ -22,12 +27,34 @@ func handleGamesTool(args map[string]interface{}) (string, error) {
 		return listGames(args)
 	case "get":
 		return getGame(args)
+	case "list_exhibitions":
+		return listExhibitionGames(args)
+	case "get_scoreboard":
+		return getGameScoreboard(args)
+	case "estimate_match_start":
+		return estimateMatchStart(args)
+	case "get_next_opponent_h2h":
+		return getNextOpponentHeadToHead(args)
+	case "get_bot_card_assets":
+		return getBotCardAssets(args)
+	case "get_score_margins":
+		return getScoreMargins(args)
+	case "get_clutch_record":
+		return getClutchRecord(args)
+	case "get_revenge_matchups":
+		return getRevengeMatchups(args)
+	case "get_marquee_matches":
+		return getMarqueeMatches(args)
+	case "get_stalled_matches":
+		return getStalledMatches(args)
 	case "add_exhibition":
 		return addExhibitionGame(args)
 	case "edit_exhibition":
 		return editExhibitionGame(args)
 	case "delete_exhibition":
 		return deleteExhibitionGame(args)
+	case "validate_bulk_exhibition":
+		return validateBulkExhibitionGames(args)
 	case "bulk_add_exhibition":
 		return bulkAddExhibitionGames(args)
 	case "bulk_delete_exhibition":
@@ -81,6 +108,17 @@ Common match identifiers in NHRL:
 QUERY OPERATIONS:
 - list: Get all matches in a tournament with current status
 - get: Get detailed information about a specific match
+- list_exhibitions: Get only the exhibition (non-bracket) matches in a tournament
+- get_scoreboard: Get a compact overlay-ready scoreboard for one match: each bot's name, photo, seed, record, and current score (requires game_id)
+- validate_bulk_exhibition: Check a bulk exhibition batch (games_info) entry by entry - name, scoreToWin, and playerIDs present in the tournament - without submitting it
+- estimate_match_start: Estimate when a queued match will start from its cage queue position and the cage's average match + turnaround time (requires game_id)
+- get_revenge_matchups: Flag upcoming matches where a bot has previously lost to its opponent, with the prior head-to-head record
+- get_marquee_matches: Rank upcoming matches by the average ranking of their two bots so the most elite fights come first
+- get_stalled_matches: Games called but not yet started across all cages, with how long they have waited (longest first) and which bots have not checked in
+- get_score_margins: Average a bot's winning and losing score margins from its finished games' slot scores (requires bot_name). First-to-1 games reduce this to the win rate; most useful for best-of-N events
+- get_clutch_record: A bot's record in best-of-N games decided in the final round (e.g. 2-1), a measure of composure under pressure; not applicable to first-to-1 games (requires bot_name)
+- get_next_opponent_h2h: Find a bot's next match in the tournament and return only its head-to-head record and a scouting summary for that opponent (requires bot_name)
+- get_bot_card_assets: A bot's picture URLs plus its next match (opponent, cage, time) in one call for "coming up" graphics (requires bot_name)
 
 MATCH UPDATES (require write access):
 - update: Update match score or result
@@ -91,7 +129,10 @@ MATCH UPDATES (require write access):
 - set_in_progress: Mark match as currently being fought
 - set_not_started: Reset match to not started status`,
 					"enum": []string{
-						"list", "get", "update", "create_exhibition", "delete_exhibition",
+						"list", "get", "list_exhibitions", "get_scoreboard", "estimate_match_start", "validate_bulk_exhibition",
+						"get_next_opponent_h2h", "get_score_margins", "get_clutch_record", "get_revenge_matchups",
+						"get_marquee_matches", "get_bot_card_assets", "get_stalled_matches",
+						"update", "create_exhibition", "delete_exhibition",
 						"report_winner", "unreport_winner", "set_in_progress", "set_not_started",
 					},
 				},
@@ -99,6 +140,10 @@ MATCH UPDATES (require write access):
 					"type":        "string",
 					"description": "Tournament identifier. Required for all operations. Format: 'nhrl_month##_weightclass'",
 				},
+				"bot_name": map[string]interface{}{
+					"type":        "string",
+					"description": "Bot/participant name (required for get_next_opponent_h2h, get_bot_card_assets, get_score_margins, and get_clutch_record). Case-insensitive; spaces and underscores are interchangeable.",
+				},
 				"game_id": map[string]interface{}{
 					"type":        "string",
 					"description": "Match/game identifier within the tournament. Required for single match operations. Examples: 'W-5' (winners bracket), 'Q1-12' (qualifying), 'GF' (grand final)",
@@ -131,6 +176,10 @@ MATCH UPDATES (require write access):
 					"type":        "string",
 					"description": "Venue/cage location ID where the match is scheduled. For NHRL, this typically corresponds to a specific combat cage.",
 				},
+				"games_info": map[string]interface{}{
+					"type":        "array",
+					"description": "Exhibition games for bulk operations. Each entry is an object with name, scoreToWin, and playerIDs (tournament player IDs).",
+				},
 				"participant_data": map[string]interface{}{
 					"type":        "array",
 					"description": "Participant information for exhibition matches. Each entry should include bot name and operator details.",
@@ -155,8 +204,8 @@ func listGames(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to list games: %w", err)
 	}
 
-	var games []interface{}
-	if err := json.Unmarshal(data, &games); err != nil {
+	games, err := decodeJSONArray(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse games response: %w", err)
 	}
 
@@ -175,6 +224,50 @@ func listGames(args map[string]interface{}) (string, error) {
 		"count": len(enrichedGames),
 		"note":  "Player names and location names are included for better readability",
 	}
+	flagTestTournamentByID(result, tournamentID)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// List only the exhibition games in a tournament
+func listExhibitionGames(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s/games", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to list games: %w", err)
+	}
+
+	games, err := decodeJSONArray(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse games response: %w", err)
+	}
+
+	// Keep exhibition games and enrich them with player and location names
+	exhibitions := make([]interface{}, 0)
+	for _, g := range games {
+		if game, ok := g.(map[string]interface{}); ok && isExhibitionGame(game) {
+			exhibitions = append(exhibitions, enrichGameWithPlayerAndLocationInfo(game, tournamentID))
+		}
+	}
+
+	result := map[string]interface{}{
+		"games":      exhibitions,
+		"count":      len(exhibitions),
+		"totalGames": len(games),
+		"note":       "Exhibition games are those not placed in a bracket (no bracketID, an exhibition bracketID, or no bracket round)",
+	}
+	flagTestTournamentByID(result, tournamentID)
 
 	jsonData, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
@@ -184,6 +277,17 @@ func listGames(args map[string]interface{}) (string, error) {
 	return string(jsonData), nil
 }
 
+// Helper function to tell exhibition games from bracket games. Bracket games
+// always carry a bracketID and a non-zero round (negative for losers bracket).
+func isExhibitionGame(game map[string]interface{}) bool {
+	bracketID, _ := game["bracketID"].(string)
+	if bracketID == "" || strings.Contains(strings.ToLower(bracketID), "exhibition") {
+		return true
+	}
+	round, ok := game["round"].(float64)
+	return !ok || round == 0
+}
+
 // Get a specific game by ID
 func getGame(args map[string]interface{}) (string, error) {
 	tournamentID, ok := args["tournament_id"].(string)
@@ -203,13 +307,14 @@ func getGame(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to get game: %w", err)
 	}
 
-	var game map[string]interface{}
-	if err := json.Unmarshal(data, &game); err != nil {
+	game, err := decodeJSONObject(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse game response: %w", err)
 	}
 
 	// Enrich game with player and location names
 	enrichedGame := enrichGameWithPlayerAndLocationInfo(game, tournamentID)
+	flagTestTournamentByID(enrichedGame, tournamentID)
 
 	jsonData, err := json.MarshalIndent(enrichedGame, "", "  ")
 	if err != nil {
@@ -254,8 +359,8 @@ func addExhibitionGame(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to add exhibition game: %w", err)
 	}
 
-	var game map[string]interface{}
-	if err := json.Unmarshal(data, &game); err != nil {
+	game, err := decodeJSONObject(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse game response: %w", err)
 	}
 
@@ -310,8 +415,8 @@ func editExhibitionGame(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to edit exhibition game: %w", err)
 	}
 
-	var game map[string]interface{}
-	if err := json.Unmarshal(data, &game); err != nil {
+	game, err := decodeJSONObject(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse game response: %w", err)
 	}
 
@@ -345,8 +450,8 @@ func deleteExhibitionGame(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to delete exhibition game: %w", err)
 	}
 
-	var result map[string]interface{}
-	if err := json.Unmarshal(data, &result); err != nil {
+	result, err := decodeJSONObject(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse response: %w", err)
 	}
 
@@ -381,8 +486,8 @@ func bulkAddExhibitionGames(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to bulk add exhibition games: %w", err)
 	}
 
-	var games []interface{}
-	if err := json.Unmarshal(data, &games); err != nil {
+	games, err := decodeJSONArray(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse games response: %w", err)
 	}
 
@@ -410,6 +515,114 @@ func bulkAddExhibitionGames(args map[string]interface{}) (string, error) {
 	return string(jsonData), nil
 }
 
+// Validate a bulk exhibition batch without submitting it
+func validateBulkExhibitionGames(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	gamesInfo, ok := args["games_info"].([]interface{})
+	if !ok {
+		return "", fmt.Errorf("games_info is required")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s/players", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to list players: %w", err)
+	}
+
+	players, err := decodeJSONArrayOf[Player](data)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse players response: %w", err)
+	}
+
+	playerNames := make(map[string]string, len(players))
+	for _, player := range players {
+		playerNames[player.ID] = player.Name
+	}
+
+	entries := make([]map[string]interface{}, len(gamesInfo))
+	validCount := 0
+	for i, g := range gamesInfo {
+		var issues []string
+		entry := map[string]interface{}{
+			"index": i,
+		}
+
+		gameInfo, ok := g.(map[string]interface{})
+		if !ok {
+			issues = append(issues, "entry is not an object")
+		} else {
+			if name, ok := gameInfo["name"].(string); !ok || strings.TrimSpace(name) == "" {
+				issues = append(issues, "name is required")
+			} else {
+				entry["name"] = name
+			}
+
+			if scoreToWin, ok := gameInfo["scoreToWin"].(float64); !ok {
+				issues = append(issues, "scoreToWin is required")
+			} else if scoreToWin < 1 || scoreToWin != float64(int(scoreToWin)) {
+				issues = append(issues, fmt.Sprintf("scoreToWin must be a positive integer (got %g)", scoreToWin))
+			}
+
+			playerIDs, ok := gameInfo["playerIDs"].([]interface{})
+			if !ok || len(playerIDs) == 0 {
+				issues = append(issues, "playerIDs is required")
+			} else {
+				var resolved []string
+				seen := make(map[string]bool)
+				for _, p := range playerIDs {
+					playerID, ok := p.(string)
+					if !ok || playerID == "" {
+						issues = append(issues, fmt.Sprintf("player ID %v is not a string", p))
+						continue
+					}
+					name, found := playerNames[playerID]
+					if !found {
+						issues = append(issues, fmt.Sprintf("player ID %s is not in the tournament", playerID))
+						continue
+					}
+					if seen[playerID] {
+						issues = append(issues, fmt.Sprintf("player %s is listed more than once", name))
+						continue
+					}
+					seen[playerID] = true
+					resolved = append(resolved, name)
+				}
+				entry["playerNames"] = resolved
+			}
+		}
+
+		entry["valid"] = len(issues) == 0
+		if len(issues) > 0 {
+			entry["issues"] = issues
+		} else {
+			validCount++
+		}
+		entries[i] = entry
+	}
+
+	result := map[string]interface{}{
+		"tournament_id": tournamentID,
+		"valid":         validCount == len(entries),
+		"entryCount":    len(entries),
+		"validCount":    validCount,
+		"invalidCount":  len(entries) - validCount,
+		"entries":       entries,
+		"note":          "Nothing was submitted. A batch with any invalid entry will be rejected as a whole by bulk_add_exhibition.",
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
 // Bulk delete exhibition games
 func bulkDeleteExhibitionGames(args map[string]interface{}) (string, error) {
 	tournamentID, ok := args["tournament_id"].(string)
@@ -433,8 +646,8 @@ func bulkDeleteExhibitionGames(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to bulk delete exhibition games: %w", err)
 	}
 
-	var result map[string]interface{}
-	if err := json.Unmarshal(data, &result); err != nil {
+	result, err := decodeJSONObject(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse response: %w", err)
 	}
 
@@ -446,6 +659,1052 @@ func bulkDeleteExhibitionGames(args map[string]interface{}) (string, error) {
 	return string(jsonData), nil
 }
 
+// Cycle time assumed when a cage has too few completed games to measure one
+const defaultMatchCycleTime = 8 * time.Minute
+
+// Gaps between consecutive games longer than this are breaks, not turnaround
+const maxMatchCycleTime = time.Hour
+
+// Estimate when a queued game will start from its cage queue position and the
+// cage's measured match + turnaround time
+func estimateMatchStart(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	gameID, ok := args["game_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("game_id is required")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	// Find the cage the game is queued at (or already running on)
+	var location *Location
+	position := -1
+	for i := range tournament.Locations {
+		loc := &tournament.Locations[i]
+		if loc.ActiveGameID != nil && *loc.ActiveGameID == gameID {
+			location, position = loc, 0
+			break
+		}
+		for idx, queuedID := range loc.Queue {
+			if queuedID == gameID {
+				location, position = loc, idx+1
+				break
+			}
+		}
+		if location != nil {
+			break
+		}
+	}
+	if location == nil {
+		return "", fmt.Errorf("game %s is not queued at any location in tournament %s", gameID, tournamentID)
+	}
+
+	result := map[string]interface{}{
+		"tournament_id": tournamentID,
+		"gameID":        gameID,
+		"locationID":    location.ID,
+		"locationName":  location.Name,
+		"queueDepth":    len(location.Queue),
+	}
+
+	if position == 0 {
+		result["status"] = "in_progress"
+		result["matchesAhead"] = 0
+	} else {
+		// Matches ahead include the one currently running at the cage
+		matchesAhead := position - 1
+		if location.ActiveGameID != nil && *location.ActiveGameID != "" {
+			matchesAhead++
+		}
+
+		// Measure match + turnaround time from consecutive completed games at this cage
+		var endTimes []time.Time
+		for _, game := range tournament.Games {
+			if game.LocationID != nil && *game.LocationID == location.ID && game.EndTime != nil && *game.EndTime > 0 {
+				endTimes = append(endTimes, trueFinalsTime(*game.EndTime))
+			}
+		}
+		sort.Slice(endTimes, func(i, j int) bool {
+			return endTimes[i].Before(endTimes[j])
+		})
+
+		var total time.Duration
+		samples := 0
+		for i := 1; i < len(endTimes); i++ {
+			gap := endTimes[i].Sub(endTimes[i-1])
+			if gap > 0 && gap <= maxMatchCycleTime {
+				total += gap
+				samples++
+			}
+		}
+
+		cycleTime := defaultMatchCycleTime
+		basis := "default"
+		if samples > 0 {
+			cycleTime = total / time.Duration(samples)
+			basis = "measured"
+		}
+
+		wait := time.Duration(matchesAhead) * cycleTime
+		result["status"] = "queued"
+		result["queuePosition"] = position
+		result["matchesAhead"] = matchesAhead
+		result["avgCycleTimeSecs"] = int(cycleTime.Seconds())
+		result["cycleTimeBasis"] = basis
+		result["cycleTimeSamples"] = samples
+		result["estimatedWaitMins"] = int(wait.Round(time.Minute).Minutes())
+		result["estimatedStart"] = time.Now().Add(wait).UTC().Format(time.RFC3339)
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Order in which undecided games are played: already running first, then
+// called, available, and finally games still waiting on earlier results
+var nextGameStateOrder = map[string]int{"active": 0, "called": 1, "available": 2}
+
+// nextMatch is a bot's next undecided game and the opponent it faces
+type nextMatch struct {
+	game         Game
+	opponentID   string
+	opponentName string
+}
+
+// findNextMatch returns the bot's next undecided game with a known opponent,
+// preferring running, then called, then available games, then the earliest
+// scheduled
+func findNextMatch(tournament *Tournament, botName string) (nextMatch, error) {
+	playerNames := make(map[string]string, len(tournament.Players))
+	var botID string
+	for _, player := range tournament.Players {
+		playerNames[player.ID] = player.Name
+		if botID == "" && botNamesMatch(player.Name, botName) {
+			botID = player.ID
+		}
+	}
+	if botID == "" {
+		return nextMatch{}, fmt.Errorf("bot %s is not registered in tournament %s", botName, tournament.ID)
+	}
+
+	// Candidate games: unfinished, with the bot in one slot and a known opponent in the other
+	var upcoming []nextMatch
+	for _, game := range tournament.Games {
+		if game.State == "done" || len(game.Slots) != 2 {
+			continue
+		}
+		var opponentID string
+		hasBot := false
+		for _, slot := range game.Slots {
+			if slot.PlayerID == nil {
+				continue
+			}
+			if *slot.PlayerID == botID {
+				hasBot = true
+			} else {
+				opponentID = *slot.PlayerID
+			}
+		}
+		if hasBot && opponentID != "" {
+			upcoming = append(upcoming, nextMatch{game: game, opponentID: opponentID, opponentName: playerNames[opponentID]})
+		}
+	}
+	if len(upcoming) == 0 {
+		return nextMatch{}, fmt.Errorf("no upcoming match with a known opponent for %s in tournament %s", botName, tournament.ID)
+	}
+
+	stateRank := func(state string) int {
+		if rank, ok := nextGameStateOrder[state]; ok {
+			return rank
+		}
+		return len(nextGameStateOrder)
+	}
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		a, b := upcoming[i].game, upcoming[j].game
+		if stateRank(a.State) != stateRank(b.State) {
+			return stateRank(a.State) < stateRank(b.State)
+		}
+		if (a.ScheduledTime == nil) != (b.ScheduledTime == nil) {
+			return a.ScheduledTime != nil
+		}
+		if a.ScheduledTime != nil && *a.ScheduledTime != *b.ScheduledTime {
+			return *a.ScheduledTime < *b.ScheduledTime
+		}
+		return false
+	})
+
+	return upcoming[0], nil
+}
+
+// details describes the match for output: game, opponent, time, and cage
+func (m nextMatch) details(tournament *Tournament) map[string]interface{} {
+	details := map[string]interface{}{
+		"gameID":     m.game.ID,
+		"name":       m.game.Name,
+		"state":      m.game.State,
+		"round":      m.game.Round,
+		"opponentID": m.opponentID,
+		"opponent":   m.opponentName,
+	}
+	if m.game.ScheduledTime != nil {
+		details["scheduledTime"] = trueFinalsTime(*m.game.ScheduledTime).UTC().Format(time.RFC3339)
+	}
+	if m.game.LocationID != nil {
+		for _, location := range tournament.Locations {
+			if location.ID == *m.game.LocationID {
+				details["locationName"] = location.Name
+				break
+			}
+		}
+	}
+
+	return details
+}
+
+// Find a bot's next match and return the head-to-head against that opponent
+func getNextOpponentHeadToHead(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	botName, ok := args["bot_name"].(string)
+	if !ok || strings.TrimSpace(botName) == "" {
+		return "", fmt.Errorf("bot_name is required for get_next_opponent_h2h operation")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	next, err := findNextMatch(&tournament, botName)
+	if err != nil {
+		return "", err
+	}
+	opponentName := next.opponentName
+	nextMatch := next.details(&tournament)
+
+	// Head-to-head from the bot's side; a first meeting has no record
+	headToHead := map[string]interface{}{
+		"meetings": 0,
+		"wins":     0,
+		"losses":   0,
+	}
+	if records, err := getNHRLHeadToHeadCached(botName); err == nil {
+		for _, record := range records {
+			if botNamesMatch(record.OpponentUniqueName, opponentName) {
+				headToHead = map[string]interface{}{
+					"meetings":    record.NumFights,
+					"wins":        record.Wins,
+					"losses":      record.Losses,
+					"kos":         record.KOs,
+					"kod":         record.KOd,
+					"lastMeeting": record.LastMeeting,
+				}
+				break
+			}
+		}
+	} else {
+		headToHead["error"] = err.Error()
+	}
+
+	// Scouting summary for the opponent
+	botType := getNHRLBotTypeCached(botName, opponentName, tournamentID)
+	scouting := map[string]interface{}{
+		"botType":    botType,
+		"archetype":  classifyBotArchetype(botType),
+		"pictureURL": fmt.Sprintf("https://brettzone.nhrl.io/brettZone/getBotPic.php?bot=%s&thumb", normalizeBotName(opponentName)),
+	}
+	if rank, err := getNHRLBotRank(opponentName); err == nil && rank != nil && rank.Ranking > 0 {
+		scouting["rank"] = rank.Ranking
+	}
+	for _, player := range tournament.Players {
+		if player.ID == next.opponentID {
+			scouting["seed"] = player.Seed
+			scouting["eventRecord"] = fmt.Sprintf("%d-%d", player.Wins, player.Losses)
+			break
+		}
+	}
+
+	summary := fmt.Sprintf("First meeting between %s and %s", botName, opponentName)
+	if meetings, _ := headToHead["meetings"].(int); meetings > 0 {
+		summary = fmt.Sprintf("%s is %d-%d all-time against %s", botName, headToHead["wins"], headToHead["losses"], opponentName)
+	}
+
+	result := map[string]interface{}{
+		"tournament_id": tournamentID,
+		"botName":       botName,
+		"nextMatch":     nextMatch,
+		"headToHead":    headToHead,
+		"scouting":      scouting,
+		"summary":       summary,
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get a bot's picture URLs and its next match in one call for a "coming up" graphic
+func getBotCardAssets(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	botName, ok := args["bot_name"].(string)
+	if !ok || strings.TrimSpace(botName) == "" {
+		return "", fmt.Errorf("bot_name is required for get_bot_card_assets operation")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	next, err := findNextMatch(&tournament, botName)
+	if err != nil {
+		return "", err
+	}
+
+	pictures := func(name string) map[string]interface{} {
+		formattedName := strings.ReplaceAll(name, " ", "_")
+		return map[string]interface{}{
+			"thumbnailURL": fmt.Sprintf("https://brettzone.nhrl.io/brettZone/getBotPic.php?bot=%s&thumb", formattedName),
+			"fullSizeURL":  fmt.Sprintf("https://brettzone.nhrl.io/brettZone/getBotPic.php?bot=%s", formattedName),
+		}
+	}
+
+	nextMatch := next.details(&tournament)
+	nextMatch["opponentPicture"] = pictures(next.opponentName)
+
+	result := map[string]interface{}{
+		"tournament_id": tournamentID,
+		"botName":       botName,
+		"picture":       pictures(botName),
+		"nextMatch":     nextMatch,
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get a compact, overlay-ready scoreboard for one game
+func getGameScoreboard(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	gameID, ok := args["game_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("game_id is required")
+	}
+
+	// The full tournament carries the game and its players in one request
+	data, err := makeAPIRequest("GET", fmt.Sprintf("/v1/tournaments/%s", tournamentID), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	var game *Game
+	for i := range tournament.Games {
+		if tournament.Games[i].ID == gameID {
+			game = &tournament.Games[i]
+			break
+		}
+	}
+	if game == nil {
+		return "", fmt.Errorf("game %s not found in tournament %s", gameID, tournamentID)
+	}
+
+	playerMap := make(map[string]Player, len(tournament.Players))
+	for _, player := range tournament.Players {
+		playerMap[player.ID] = player
+	}
+
+	players := make([]map[string]interface{}, 0, len(game.Slots))
+	for _, slot := range game.Slots {
+		overlayPlayer := overlayPlayerForSlot(slot, playerMap)
+		players = append(players, map[string]interface{}{
+			"name":     overlayPlayer.Name,
+			"photoUrl": overlayPlayer.PhotoURL,
+			"seed":     overlayPlayer.Seed,
+			"record":   fmt.Sprintf("%d-%d", overlayPlayer.Wins, overlayPlayer.Losses),
+			"score":    overlayPlayer.ScoreText,
+		})
+	}
+
+	result := map[string]interface{}{
+		"gameID":     game.ID,
+		"gameName":   game.Name,
+		"state":      game.State,
+		"scoreToWin": game.ScoreToWin,
+		"players":    players,
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Flag upcoming matches where a bot has previously lost to its opponent
+func getRevengeMatchups(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	playerNames := make(map[string]string, len(tournament.Players))
+	for _, player := range tournament.Players {
+		playerNames[player.ID] = player.Name
+	}
+
+	// Each bot's head-to-head history is fetched once and shared across its matches
+	headToHeads := make(map[string][]NHRLHeadToHead)
+	lossesTo := func(botName, opponentName string) *NHRLHeadToHead {
+		records, ok := headToHeads[botName]
+		if !ok {
+			records, _ = getNHRLHeadToHeadCached(botName)
+			headToHeads[botName] = records
+		}
+		for i := range records {
+			if botNamesMatch(records[i].OpponentUniqueName, opponentName) && records[i].Losses > 0 {
+				return &records[i]
+			}
+		}
+		return nil
+	}
+
+	scheduled := 0
+	revenge := make([]map[string]interface{}, 0)
+	for _, game := range tournament.Games {
+		if game.State == "done" || len(game.Slots) != 2 {
+			continue
+		}
+		if game.Slots[0].PlayerID == nil || game.Slots[1].PlayerID == nil {
+			continue
+		}
+		botA, botB := playerNames[*game.Slots[0].PlayerID], playerNames[*game.Slots[1].PlayerID]
+		if botA == "" || botB == "" {
+			continue
+		}
+		scheduled++
+
+		seekers := make([]map[string]interface{}, 0, 2)
+		for _, pair := range [][2]string{{botA, botB}, {botB, botA}} {
+			record := lossesTo(pair[0], pair[1])
+			if record == nil {
+				continue
+			}
+			seekers = append(seekers, map[string]interface{}{
+				"bot":         pair[0],
+				"lostTo":      pair[1],
+				"priorRecord": fmt.Sprintf("%d-%d", record.Wins, record.Losses),
+				"meetings":    record.NumFights,
+				"timesKOd":    record.KOd,
+				"lastMeeting": record.LastMeeting,
+			})
+		}
+		if len(seekers) == 0 {
+			continue
+		}
+
+		revenge = append(revenge, map[string]interface{}{
+			"gameID":         game.ID,
+			"name":           game.Name,
+			"state":          game.State,
+			"matchup":        fmt.Sprintf("%s vs %s", botA, botB),
+			"revengeSeekers": seekers,
+		})
+	}
+
+	result := map[string]interface{}{
+		"tournament_id":    tournamentID,
+		"scheduledMatches": scheduled,
+		"revengeCount":     len(revenge),
+		"revengeMatchups":  revenge,
+		"note":             "priorRecord is the revenge-seeking bot's all-time record against its opponent (wins-losses); matches where both bots have beaten each other list both",
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// List called-but-not-started games across all cages, longest wait first
+func getStalledMatches(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	playerNames := make(map[string]string, len(tournament.Players))
+	for _, player := range tournament.Players {
+		playerNames[player.ID] = player.Name
+	}
+	locationNames := make(map[string]string, len(tournament.Locations))
+	for _, location := range tournament.Locations {
+		locationNames[location.ID] = location.Name
+	}
+
+	now := time.Now()
+	type stalledMatch struct {
+		GameID         string   `json:"gameID"`
+		Name           string   `json:"name"`
+		Cage           string   `json:"cage,omitempty"`
+		CalledSince    string   `json:"calledSince,omitempty"`
+		WaitMinutes    *float64 `json:"waitMinutes"`
+		Bots           []string `json:"bots"`
+		NotCheckedIn   []string `json:"notCheckedIn"`
+		waitForSorting time.Duration
+	}
+
+	var stalled []stalledMatch
+	for _, game := range tournament.Games {
+		if game.State != "called" {
+			continue
+		}
+
+		match := stalledMatch{GameID: game.ID, Name: game.Name, Bots: []string{}, NotCheckedIn: []string{}}
+		if game.LocationID != nil {
+			match.Cage = locationNames[*game.LocationID]
+		}
+		if game.CalledSince != nil && *game.CalledSince > 0 {
+			calledAt := trueFinalsTime(*game.CalledSince)
+			wait := now.Sub(calledAt)
+			minutes := math.Round(wait.Minutes()*10) / 10
+			match.CalledSince = calledAt.UTC().Format(time.RFC3339)
+			match.WaitMinutes = &minutes
+			match.waitForSorting = wait
+		}
+		for _, slot := range game.Slots {
+			if slot.PlayerID == nil {
+				continue
+			}
+			name := playerNames[*slot.PlayerID]
+			match.Bots = append(match.Bots, name)
+			if slot.CheckInTime == nil || *slot.CheckInTime <= 0 {
+				match.NotCheckedIn = append(match.NotCheckedIn, name)
+			}
+		}
+		stalled = append(stalled, match)
+	}
+
+	// Longest wait first; games with no called time go last
+	sort.SliceStable(stalled, func(i, j int) bool {
+		return stalled[i].waitForSorting > stalled[j].waitForSorting
+	})
+
+	result := map[string]interface{}{
+		"tournament_id": tournamentID,
+		"stalledCount":  len(stalled),
+		"matches":       stalled,
+		"checkedAt":     now.UTC().Format(time.RFC3339),
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Maximum number of bot rank lookups get_marquee_matches runs at once
+const marqueeRankConcurrency = 4
+
+// Rank upcoming matches by how elite the two bots are, best first
+func getMarqueeMatches(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	playerNames := make(map[string]string, len(tournament.Players))
+	for _, player := range tournament.Players {
+		playerNames[player.ID] = player.Name
+	}
+
+	type marqueeMatch struct {
+		game  Game
+		botA  string
+		botB  string
+		rankA int
+		rankB int
+	}
+	var upcoming []marqueeMatch
+	var botNames []string
+	seen := make(map[string]bool)
+	for _, game := range tournament.Games {
+		if game.State == "done" || len(game.Slots) != 2 {
+			continue
+		}
+		if game.Slots[0].PlayerID == nil || game.Slots[1].PlayerID == nil {
+			continue
+		}
+		botA, botB := playerNames[*game.Slots[0].PlayerID], playerNames[*game.Slots[1].PlayerID]
+		if botA == "" || botB == "" {
+			continue
+		}
+		for _, botName := range []string{botA, botB} {
+			if !seen[botName] {
+				seen[botName] = true
+				botNames = append(botNames, botName)
+			}
+		}
+		upcoming = append(upcoming, marqueeMatch{game: game, botA: botA, botB: botB})
+	}
+
+	// Each bot's rank is looked up once, through the NHRL cache and at most
+	// marqueeRankConcurrency at a time; 0 means unranked
+	rankList := make([]int, len(botNames))
+	sem := make(chan struct{}, marqueeRankConcurrency)
+	var wg sync.WaitGroup
+	for i, botName := range botNames {
+		wg.Add(1)
+		go func(i int, botName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if rank, err := getNHRLBotRankCached(botName); err == nil {
+				rankList[i] = rank
+			}
+		}(i, botName)
+	}
+	wg.Wait()
+	ranks := make(map[string]int, len(botNames))
+	for i, botName := range botNames {
+		ranks[botName] = rankList[i]
+	}
+
+	worstRank := 0
+	for i := range upcoming {
+		upcoming[i].rankA, upcoming[i].rankB = ranks[upcoming[i].botA], ranks[upcoming[i].botB]
+		worstRank = max(worstRank, upcoming[i].rankA, upcoming[i].rankB)
+	}
+
+	// Unranked bots count as one place below the lowest-ranked bot in the field
+	unrankedRank := worstRank + 1
+	effective := func(rank int) int {
+		if rank == 0 {
+			return unrankedRank
+		}
+		return rank
+	}
+
+	// Most elite first; ties go to the match whose better bot ranks higher
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		sumI := effective(upcoming[i].rankA) + effective(upcoming[i].rankB)
+		sumJ := effective(upcoming[j].rankA) + effective(upcoming[j].rankB)
+		if sumI != sumJ {
+			return sumI < sumJ
+		}
+		return min(effective(upcoming[i].rankA), effective(upcoming[i].rankB)) <
+			min(effective(upcoming[j].rankA), effective(upcoming[j].rankB))
+	})
+
+	results := make([]map[string]interface{}, 0, len(upcoming))
+	for _, match := range upcoming {
+		avgRank := float64(effective(match.rankA)+effective(match.rankB)) / 2
+		entry := map[string]interface{}{
+			"gameID":       match.game.ID,
+			"name":         match.game.Name,
+			"state":        match.game.State,
+			"matchup":      fmt.Sprintf("%s vs %s", match.botA, match.botB),
+			"bots":         []map[string]interface{}{{"name": match.botA, "rank": match.rankA}, {"name": match.botB, "rank": match.rankB}},
+			"averageRank":  avgRank,
+			"combinedRank": effective(match.rankA) + effective(match.rankB),
+		}
+		if match.game.ScheduledTime != nil {
+			entry["scheduledTime"] = trueFinalsTime(*match.game.ScheduledTime).UTC().Format(time.RFC3339)
+		}
+		results = append(results, entry)
+	}
+
+	result := map[string]interface{}{
+		"tournament_id": tournamentID,
+		"matchCount":    len(results),
+		"matches":       results,
+		"note":          "Lower averageRank means a more elite matchup. A rank of 0 means unranked; unranked bots are scored one place below the lowest-ranked bot in these matches.",
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Average a bot's winning and losing score margins across its finished games
+func getScoreMargins(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	botName, ok := args["bot_name"].(string)
+	if !ok || strings.TrimSpace(botName) == "" {
+		return "", fmt.Errorf("bot_name is required for get_score_margins operation")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	playerNames := make(map[string]string, len(tournament.Players))
+	byePlayers := make(map[string]bool)
+	var botID string
+	for _, player := range tournament.Players {
+		playerNames[player.ID] = player.Name
+		if player.IsBye {
+			byePlayers[player.ID] = true
+		}
+		if botID == "" && botNamesMatch(player.Name, botName) {
+			botID = player.ID
+		}
+	}
+	if botID == "" {
+		return "", fmt.Errorf("bot %s is not registered in tournament %s", botName, tournamentID)
+	}
+
+	var wins, losses, ties, skipped, byes int
+	var winMargin, lossMargin, totalDiff float64
+	maxScoreToWin := 0
+	games := make([]map[string]interface{}, 0)
+	for _, game := range tournament.Games {
+		if game.State != "done" || len(game.Slots) != 2 {
+			continue
+		}
+
+		var botSlot, opponentSlot *GameSlot
+		for i := range game.Slots {
+			slot := &game.Slots[i]
+			if slot.PlayerID != nil && *slot.PlayerID == botID {
+				botSlot = slot
+			} else {
+				opponentSlot = slot
+			}
+		}
+		if botSlot == nil || opponentSlot == nil {
+			continue
+		}
+
+		// A game against a bye was never fought, so it has no score to compare
+		if opponentSlot.PlayerID != nil && byePlayers[*opponentSlot.PlayerID] {
+			byes++
+			continue
+		}
+
+		// Negative scores mark forfeits and disqualifications, which have no margin
+		if botSlot.Score < 0 || opponentSlot.Score < 0 {
+			skipped++
+			continue
+		}
+
+		diff := botSlot.Score - opponentSlot.Score
+		totalDiff += diff
+		switch {
+		case diff > 0:
+			wins++
+			winMargin += diff
+		case diff < 0:
+			losses++
+			lossMargin += -diff
+		default:
+			ties++
+		}
+		if game.ScoreToWin > maxScoreToWin {
+			maxScoreToWin = game.ScoreToWin
+		}
+
+		opponent := ""
+		if opponentSlot.PlayerID != nil {
+			opponent = playerNames[*opponentSlot.PlayerID]
+		}
+		games = append(games, map[string]interface{}{
+			"gameID":     game.ID,
+			"name":       game.Name,
+			"opponent":   opponent,
+			"score":      fmt.Sprintf("%g-%g", botSlot.Score, opponentSlot.Score),
+			"margin":     diff,
+			"scoreToWin": game.ScoreToWin,
+		})
+	}
+
+	played := wins + losses + ties
+	average := func(total float64, count int) float64 {
+		if count == 0 {
+			return 0
+		}
+		return math.Round(total/float64(count)*100) / 100
+	}
+
+	result := map[string]interface{}{
+		"tournament_id":        tournamentID,
+		"botName":              botName,
+		"gamesScored":          played,
+		"wins":                 wins,
+		"losses":               losses,
+		"ties":                 ties,
+		"winRate":              average(float64(wins), played),
+		"avgWinningMargin":     average(winMargin, wins),
+		"avgLosingMargin":      average(lossMargin, losses),
+		"avgScoreDifferential": average(totalDiff, played),
+		"games":                games,
+	}
+	if skipped > 0 {
+		result["forfeitsSkipped"] = skipped
+	}
+	if byes > 0 {
+		result["byesSkipped"] = byes
+	}
+	if maxScoreToWin <= 1 {
+		// Every game was a single decision, so margins carry no information beyond the result
+		result["note"] = "All games were first-to-1 (e.g. single elimination 0/1 scoring): every winning margin is 1, so these numbers reduce to the win rate. Margins are meaningful for best-of-N games."
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get a bot's record in best-of-N games that went to a deciding final round
+func getClutchRecord(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	botName, ok := args["bot_name"].(string)
+	if !ok || strings.TrimSpace(botName) == "" {
+		return "", fmt.Errorf("bot_name is required for get_clutch_record operation")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	playerNames := make(map[string]string, len(tournament.Players))
+	byePlayers := make(map[string]bool)
+	var botID string
+	for _, player := range tournament.Players {
+		playerNames[player.ID] = player.Name
+		if player.IsBye {
+			byePlayers[player.ID] = true
+		}
+		if botID == "" && botNamesMatch(player.Name, botName) {
+			botID = player.ID
+		}
+	}
+	if botID == "" {
+		return "", fmt.Errorf("bot %s is not registered in tournament %s", botName, tournamentID)
+	}
+
+	var wins, losses, bestOfGames int
+	games := make([]map[string]interface{}, 0)
+	for _, game := range tournament.Games {
+		if game.State != "done" || len(game.Slots) != 2 || game.ScoreToWin < 2 {
+			continue
+		}
+
+		var botSlot, opponentSlot *GameSlot
+		for i := range game.Slots {
+			slot := &game.Slots[i]
+			if slot.PlayerID != nil && *slot.PlayerID == botID {
+				botSlot = slot
+			} else {
+				opponentSlot = slot
+			}
+		}
+		if botSlot == nil || opponentSlot == nil {
+			continue
+		}
+		// A bye advances the bot without a fight, so it is not a best-of-N game played
+		if opponentSlot.PlayerID != nil && byePlayers[*opponentSlot.PlayerID] {
+			continue
+		}
+		bestOfGames++
+
+		// A deciding round means the loser finished one short of scoreToWin,
+		// e.g. 2-1 in a best-of-3. Forfeits (negative scores) never qualify.
+		decider := float64(game.ScoreToWin - 1)
+		high, low := math.Max(botSlot.Score, opponentSlot.Score), math.Min(botSlot.Score, opponentSlot.Score)
+		if high != float64(game.ScoreToWin) || low != decider {
+			continue
+		}
+
+		outcome := "loss"
+		if botSlot.Score > opponentSlot.Score {
+			outcome = "win"
+			wins++
+		} else {
+			losses++
+		}
+
+		opponent := ""
+		if opponentSlot.PlayerID != nil {
+			opponent = playerNames[*opponentSlot.PlayerID]
+		}
+		games = append(games, map[string]interface{}{
+			"gameID":     game.ID,
+			"name":       game.Name,
+			"opponent":   opponent,
+			"score":      fmt.Sprintf("%g-%g", botSlot.Score, opponentSlot.Score),
+			"outcome":    outcome,
+			"scoreToWin": game.ScoreToWin,
+		})
+	}
+
+	result := map[string]interface{}{
+		"tournament_id": tournamentID,
+		"botName":       botName,
+		"applicable":    bestOfGames > 0,
+		"bestOfGames":   bestOfGames,
+		"clutchGames":   len(games),
+		"clutchWins":    wins,
+		"clutchLosses":  losses,
+		"games":         games,
+	}
+	if len(games) > 0 {
+		result["clutchWinRate"] = math.Round(float64(wins)/float64(len(games))*100) / 100
+	}
+	if bestOfGames == 0 {
+		result["note"] = "Not applicable: the bot played no finished best-of-N games here. Single elimination (first-to-1) games have no deciding round, so there is no clutch record to measure."
+	}
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
 // Update a game
 func updateGame(args map[string]interface{}) (string, error) {
 	tournamentID, ok := args["tournament_id"].(string)
@@ -483,8 +1742,8 @@ func updateGame(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to update game: %w", err)
 	}
 
-	var game map[string]interface{}
-	if err := json.Unmarshal(data, &game); err != nil {
+	game, err := decodeJSONObject(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse game response: %w", err)
 	}
 
@@ -531,8 +1790,8 @@ func updateGameScore(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to update game score: %w", err)
 	}
 
-	var game map[string]interface{}
-	if err := json.Unmarshal(data, &game); err != nil {
+	game, err := decodeJSONObject(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse game response: %w", err)
 	}
 
@@ -575,8 +1834,8 @@ func updateGameState(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to update game state: %w", err)
 	}
 
-	var game map[string]interface{}
-	if err := json.Unmarshal(data, &game); err != nil {
+	game, err := decodeJSONObject(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse game response: %w", err)
 	}
 
@@ -619,8 +1878,8 @@ func updateGameScheduledTime(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to update game scheduled time: %w", err)
 	}
 
-	var game map[string]interface{}
-	if err := json.Unmarshal(data, &game); err != nil {
+	game, err := decodeJSONObject(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse game response: %w", err)
 	}
 
@@ -668,8 +1927,8 @@ func updateGameLocation(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to update game location: %w", err)
 	}
 
-	var game map[string]interface{}
-	if err := json.Unmarshal(data, &game); err != nil {
+	game, err := decodeJSONObject(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse game response: %w", err)
 	}
 
@@ -718,8 +1977,8 @@ func updateGameCheckin(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to update game check-in: %w", err)
 	}
 
-	var game map[string]interface{}
-	if err := json.Unmarshal(data, &game); err != nil {
+	game, err := decodeJSONObject(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse game response: %w", err)
 	}
 
@@ -755,8 +2014,8 @@ func undoGame(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to undo game: %w", err)
 	}
 
-	var game map[string]interface{}
-	if err := json.Unmarshal(data, &game); err != nil {
+	game, err := decodeJSONObject(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse game response: %w", err)
 	}
 
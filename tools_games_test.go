@@ -0,0 +1,596 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGameHandlersRejectWrongShape(t *testing.T) {
+	stub := newUpstreamStub(t)
+	// updateGame expects an object back, bulkAddExhibitionGames a list
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1/games/g1", []interface{}{map[string]interface{}{"id": "g1"}})
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1/bulkGames/add", map[string]interface{}{"id": "g2"})
+
+	_, err := updateGame(map[string]interface{}{"tournament_id": "t1", "game_id": "g1", "state": "active"})
+	if err == nil || !strings.Contains(err.Error(), "expected a JSON object but got a JSON array") {
+		t.Errorf("updateGame error = %v, want a shape mismatch", err)
+	}
+
+	_, err = bulkAddExhibitionGames(map[string]interface{}{
+		"tournament_id": "t1",
+		"games_info":    []interface{}{map[string]interface{}{"slots": []interface{}{}}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "expected a JSON array but got a JSON object") {
+		t.Errorf("bulkAddExhibitionGames error = %v, want a shape mismatch", err)
+	}
+}
+
+func TestUpdateGameRejectsNonJSON(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.trueFinals("/v1/tournaments/t1/games/g1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte("<html>maintenance</html>"))
+	})
+
+	_, err := updateGame(map[string]interface{}{"tournament_id": "t1", "game_id": "g1", "state": "active"})
+	if err == nil || !strings.Contains(err.Error(), "the response was invalid") {
+		t.Errorf("updateGame error = %v, want an invalid response error", err)
+	}
+}
+
+func TestListExhibitionsFiltersBracketGames(t *testing.T) {
+	stub := newUpstreamStub(t)
+
+	bracket := tfGame("W1-1", "done", "Lynx", "Zeus")
+	bracket.BracketID, bracket.Round = "main", 1
+	loserBracket := tfGame("L1-1", "available", "Bolt", "Hydra")
+	loserBracket.BracketID, loserBracket.Round = "main", -1
+	unbracketed := tfGame("E-1", "available", "Lynx", "Bolt")
+	exhibitionBracket := tfGame("E-2", "done", "Zeus", "Hydra")
+	exhibitionBracket.BracketID, exhibitionBracket.Round = "exhibition-2", 1
+	roundless := tfGame("E-3", "available", "Hydra", "Lynx")
+	roundless.BracketID = "main"
+	stub.trueFinalsLists("t1",
+		[]Location{{ID: "l1", Name: "Cage 1"}},
+		[]Game{bracket, loserBracket, unbracketed, exhibitionBracket, roundless},
+		tfPlayers("Lynx", "Zeus", "Bolt", "Hydra"),
+	)
+
+	output, err := listExhibitionGames(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("list_exhibitions: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["totalGames"] != 5.0 || result["count"] != 3.0 {
+		t.Fatalf("totalGames = %v, count = %v; want 5 and 3", result["totalGames"], result["count"])
+	}
+	for i, want := range []string{"E-1", "E-2", "E-3"} {
+		game := result["games"].([]interface{})[i].(map[string]interface{})
+		if game["id"] != want {
+			t.Errorf("exhibition %d = %v, want %s", i, game["id"], want)
+		}
+	}
+	slot := result["games"].([]interface{})[0].(map[string]interface{})["slots"].([]interface{})[0].(map[string]interface{})
+	if slot["playerName"] != "Lynx" {
+		t.Errorf("first slot = %v, want it enriched with Lynx's name", slot)
+	}
+}
+
+func TestEstimateMatchStartThirdInQueue(t *testing.T) {
+	stub := newUpstreamStub(t)
+	var games []Game
+	// Completed games at Cage 1 ten minutes apart, then one after a two hour break
+	for i, end := range []int64{1750000000, 1750000600, 1750001200, 1750001800, 1750009000} {
+		game := tfGame("done-"+strconv.Itoa(i), "done", "Lynx", "Zeus")
+		game.LocationID, game.EndTime = strPtr("l1"), int64Ptr(end)
+		games = append(games, game)
+	}
+	elsewhere := tfGame("done-l2", "done", "Bolt", "Hydra")
+	elsewhere.LocationID, elsewhere.EndTime = strPtr("l2"), int64Ptr(1750000060)
+	games = append(games, elsewhere)
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{
+		ID:    "t1",
+		Title: "NHRL June 2025 3lb",
+		Locations: []Location{
+			{ID: "l2", Name: "Cage 2", Queue: []string{"other"}},
+			{ID: "l1", Name: "Cage 1", ActiveGameID: strPtr("running"), Queue: []string{"q1", "q2", "target", "q4"}},
+		},
+		Games: games,
+	})
+
+	before := time.Now()
+	output, err := estimateMatchStart(map[string]interface{}{"tournament_id": "t1", "game_id": "target"})
+	if err != nil {
+		t.Fatalf("estimate_match_start: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["locationID"] != "l1" || result["status"] != "queued" || result["queuePosition"] != 3.0 || result["queueDepth"] != 4.0 {
+		t.Fatalf("result = %v, want third of four queued at l1", result)
+	}
+	// The two games queued ahead plus the one running
+	if result["matchesAhead"] != 3.0 {
+		t.Errorf("matchesAhead = %v, want 3", result["matchesAhead"])
+	}
+	if result["avgCycleTimeSecs"] != 600.0 || result["cycleTimeSamples"] != 3.0 || result["cycleTimeBasis"] != "measured" {
+		t.Errorf("cycle time = %vs from %v samples (%v), want 600s measured from 3", result["avgCycleTimeSecs"], result["cycleTimeSamples"], result["cycleTimeBasis"])
+	}
+	if result["estimatedWaitMins"] != 30.0 {
+		t.Errorf("estimatedWaitMins = %v, want 30", result["estimatedWaitMins"])
+	}
+	start, err := time.Parse(time.RFC3339, result["estimatedStart"].(string))
+	if err != nil {
+		t.Fatalf("estimatedStart: %v", err)
+	}
+	if want := before.Add(30 * time.Minute); start.Before(want.Add(-time.Second)) || start.After(time.Now().Add(30*time.Minute)) {
+		t.Errorf("estimatedStart = %s, want about %s", start, want.UTC().Format(time.RFC3339))
+	}
+
+	if _, err := estimateMatchStart(map[string]interface{}{"tournament_id": "t1", "game_id": "missing"}); err == nil {
+		t.Error("estimate for a game in no queue succeeded, want an error")
+	}
+}
+
+func TestValidateBulkExhibitionFlagsUnknownPlayer(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.trueFinalsLists("t1", nil, nil, tfPlayers("Lynx", "Zeus", "Bolt"))
+	game := func(name string, scoreToWin float64, playerIDs ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"name": name, "scoreToWin": scoreToWin, "playerIDs": playerIDs}
+	}
+
+	output, err := validateBulkExhibitionGames(map[string]interface{}{
+		"tournament_id": "t1",
+		"games_info": []interface{}{
+			game("Exhibition 1", 1, "Lynx", "Zeus"),
+			game("Exhibition 2", 1, "Bolt", "Ghost"),
+			game("Exhibition 3", 2, "Zeus", "Bolt"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("validate_bulk_exhibition: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["valid"] != false || result["validCount"] != 2.0 || result["invalidCount"] != 1.0 {
+		t.Errorf("batch valid = %v with %v valid and %v invalid, want false with 2 and 1", result["valid"], result["validCount"], result["invalidCount"])
+	}
+
+	entries := result["entries"].([]interface{})
+	for i, wantValid := range []bool{true, false, true} {
+		if entries[i].(map[string]interface{})["valid"] != wantValid {
+			t.Errorf("entry %d valid = %v, want %v", i, entries[i].(map[string]interface{})["valid"], wantValid)
+		}
+	}
+	bad := entries[1].(map[string]interface{})
+	issues := bad["issues"].([]interface{})
+	if len(issues) != 1 || !strings.Contains(issues[0].(string), "Ghost") {
+		t.Errorf("entry 1 issues = %v, want one naming the unknown player ID Ghost", issues)
+	}
+	if names := bad["playerNames"].([]interface{}); len(names) != 1 || names[0] != "Bolt" {
+		t.Errorf("entry 1 playerNames = %v, want just Bolt", names)
+	}
+	if calls := stub.count(trueFinalsHost + "/api/v1/tournaments/t1/bulkGames/add"); calls != 0 {
+		t.Errorf("validation submitted the batch %d times, want 0", calls)
+	}
+}
+
+func TestNextOpponentHeadToHeadUpcomingMatch(t *testing.T) {
+	stub := newUpstreamStub(t)
+	players := seeded("Lynx", "Zeus", "Mole")
+	players[1].Wins, players[1].Losses = 2, 1
+	done := tfGame("Q1-1", "done", "Lynx", "Mole")
+	waiting := tfGame("W1-1", "unavailable", "Lynx")
+	waiting.Slots = append(waiting.Slots, GameSlot{GameID: "W1-1", SlotIdx: 1})
+	upcoming := tfGame("Q2W-1", "available", "Zeus", "Lynx")
+	upcoming.LocationID = strPtr("l1")
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{
+		ID:        "t1",
+		Title:     "NHRL June 2025 3lb",
+		Players:   players,
+		Locations: []Location{{ID: "l1", Name: "Cage 2"}},
+		Games:     []Game{done, waiting, upcoming},
+	})
+	stub.statsbookByBot("get_head_to_head.php", map[string]interface{}{"Lynx": []NHRLHeadToHead{
+		{OpponentUniqueName: "Mole", NumFights: 1, Wins: 1},
+		{OpponentUniqueName: "Zeus", NumFights: 3, Wins: 2, Losses: 1, KOs: 1, LastMeeting: "2025-03-08"},
+	}})
+	stub.statsbookByBot("get_rank.php", map[string]interface{}{"Zeus": NHRLRanking{Ranking: 7}})
+	stub.liveStats(map[string]NHRLLiveFightStats{"Zeus": {BotType: "Vertical Spinner"}})
+
+	output, err := getNextOpponentHeadToHead(map[string]interface{}{"tournament_id": "t1", "bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_next_opponent_h2h: %v", err)
+	}
+	result := decodeResult(t, output)
+	next := result["nextMatch"].(map[string]interface{})
+	if next["gameID"] != "Q2W-1" || next["opponent"] != "Zeus" || next["locationName"] != "Cage 2" {
+		t.Errorf("nextMatch = %v, want Q2W-1 against Zeus in Cage 2", next)
+	}
+	h2h := result["headToHead"].(map[string]interface{})
+	if h2h["meetings"] != 3.0 || h2h["wins"] != 2.0 || h2h["losses"] != 1.0 || h2h["lastMeeting"] != "2025-03-08" {
+		t.Errorf("headToHead = %v, want 2-1 over 3 meetings, last 2025-03-08", h2h)
+	}
+	if result["summary"] != "Lynx is 2-1 all-time against Zeus" {
+		t.Errorf("summary = %q", result["summary"])
+	}
+	scouting := result["scouting"].(map[string]interface{})
+	if scouting["botType"] != "Vertical Spinner" || scouting["rank"] != 7.0 || scouting["seed"] != 2.0 || scouting["eventRecord"] != "2-1" {
+		t.Errorf("scouting = %v, want a rank 7, seed 2 vertical spinner at 2-1 in the event", scouting)
+	}
+}
+
+func TestScoreMarginsFromVariedScores(t *testing.T) {
+	stub := newUpstreamStub(t)
+	scored := func(id, state string, scoreToWin int, player1 string, score1 float64, player2 string, score2 float64) Game {
+		game := tfGame(id, state, player1, player2)
+		game.ScoreToWin = scoreToWin
+		game.Slots[0].Score, game.Slots[1].Score = score1, score2
+		return game
+	}
+	players := tfPlayers("Lynx", "Zeus", "Bolt", "Mole", "Kite", "BYE")
+	players[5].IsBye = true
+	stub.json(trueFinalsHost+"/api/v1/tournaments/bo5", Tournament{
+		ID:      "bo5",
+		Title:   "NHRL June 2025 3lb",
+		Players: players,
+		Games: []Game{
+			scored("g1", "done", 3, "Lynx", 3, "Zeus", 0),
+			scored("g2", "done", 3, "Bolt", 2, "Lynx", 3),
+			scored("g3", "done", 3, "Lynx", 1, "Mole", 3),
+			scored("g4", "done", 3, "Lynx", -1, "Kite", 3),
+			scored("g5", "active", 3, "Lynx", 1, "Zeus", 0),
+			scored("g6", "done", 3, "Zeus", 3, "Bolt", 0),
+			scored("g7", "done", 3, "Lynx", 0, "BYE", 0),
+		},
+	})
+	stub.json(trueFinalsHost+"/api/v1/tournaments/single", Tournament{
+		ID:      "single",
+		Title:   "NHRL July 2025 3lb",
+		Players: players,
+		Games: []Game{
+			scored("h1", "done", 1, "Lynx", 1, "Zeus", 0),
+			scored("h2", "done", 1, "Bolt", 1, "Lynx", 0),
+		},
+	})
+
+	output, err := getScoreMargins(map[string]interface{}{"tournament_id": "bo5", "bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_score_margins: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["gamesScored"] != 3.0 || result["wins"] != 2.0 || result["losses"] != 1.0 || result["forfeitsSkipped"] != 1.0 {
+		t.Errorf("scored %v games (%v-%v, %v forfeits skipped), want 3 (2-1, 1 skipped)", result["gamesScored"], result["wins"], result["losses"], result["forfeitsSkipped"])
+	}
+	if result["ties"] != 0.0 || result["byesSkipped"] != 1.0 {
+		t.Errorf("ties = %v, byes skipped = %v; want the bye left out of the margins and reported", result["ties"], result["byesSkipped"])
+	}
+	if result["avgWinningMargin"] != 2.0 || result["avgLosingMargin"] != 2.0 || result["avgScoreDifferential"] != 0.67 || result["winRate"] != 0.67 {
+		t.Errorf("margins = win %v, loss %v, differential %v, rate %v; want 2, 2, 0.67, 0.67",
+			result["avgWinningMargin"], result["avgLosingMargin"], result["avgScoreDifferential"], result["winRate"])
+	}
+	if second := result["games"].([]interface{})[1].(map[string]interface{}); second["opponent"] != "Bolt" || second["score"] != "3-2" || second["margin"] != 1.0 {
+		t.Errorf("second game = %v, want 3-2 over Bolt by 1", second)
+	}
+	if _, ok := result["note"]; ok {
+		t.Errorf("best-of-5 result carries the first-to-1 note: %v", result["note"])
+	}
+
+	output, err = getScoreMargins(map[string]interface{}{"tournament_id": "single", "bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_score_margins single: %v", err)
+	}
+	result = decodeResult(t, output)
+	if result["winRate"] != 0.5 || result["avgWinningMargin"] != 1.0 || result["note"] == nil {
+		t.Errorf("first-to-1 result = %v, want win rate 0.5, margin 1, and the explanatory note", result)
+	}
+}
+
+func TestGameScoreboardCompactOutput(t *testing.T) {
+	stub := newUpstreamStub(t)
+	players := seeded("Lynx", "Zeus")
+	players[0].PhotoURL, players[0].Wins, players[0].Losses = strPtr("https://img.example/lynx.png"), 3, 1
+	players[1].Wins = 2
+	players[1].ProfileInfo = &ProfileInfo{Tag: "ZEUS", Pronouns: "he/him"}
+	game := tfGame("W1-1", "active", "Lynx", "Zeus")
+	game.ScoreToWin = 2
+	game.Slots[0].Score, game.Slots[1].Score = 1, 0
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{
+		ID:      "t1",
+		Title:   "NHRL June 2025 3lb",
+		Players: players,
+		Games:   []Game{tfGame("Q1-1", "done", "Lynx", "Zeus"), game},
+	})
+
+	output, err := getGameScoreboard(map[string]interface{}{"tournament_id": "t1", "game_id": "W1-1"})
+	if err != nil {
+		t.Fatalf("get_scoreboard: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["gameID"] != "W1-1" || result["state"] != "active" || result["scoreToWin"] != 2.0 {
+		t.Errorf("scoreboard = %v, want active W1-1 first to 2", result)
+	}
+	want := []map[string]interface{}{
+		{"name": "Lynx", "photoUrl": "https://img.example/lynx.png", "seed": 1.0, "record": "3-1", "score": "1"},
+		{"name": "Zeus", "photoUrl": nil, "seed": 2.0, "record": "2-0", "score": "0"},
+	}
+	scoreboardPlayers := result["players"].([]interface{})
+	if len(scoreboardPlayers) != len(want) {
+		t.Fatalf("got %d players, want %d", len(scoreboardPlayers), len(want))
+	}
+	for i, w := range want {
+		player := scoreboardPlayers[i].(map[string]interface{})
+		// Only the overlay fields, nothing else from the player or profile
+		if len(player) != len(w) {
+			t.Errorf("player %d has fields %v, want exactly %v", i, player, w)
+		}
+		for key, value := range w {
+			if player[key] != value {
+				t.Errorf("player %d %s = %v, want %v", i, key, player[key], value)
+			}
+		}
+	}
+
+	if _, err := getGameScoreboard(map[string]interface{}{"tournament_id": "t1", "game_id": "nope"}); err == nil {
+		t.Error("scoreboard for a missing game succeeded, want an error")
+	}
+}
+
+func TestRevengeMatchupsRematchOfPriorLoss(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{
+		ID:      "t1",
+		Title:   "NHRL June 2025 3lb",
+		Players: tfPlayers("Lynx", "Zeus", "Bolt", "Mole"),
+		Games: []Game{
+			tfGame("Q1-1", "done", "Mole", "Zeus"),
+			tfGame("Q2W-1", "available", "Lynx", "Zeus"),
+			tfGame("Q2W-2", "called", "Bolt", "Mole"),
+		},
+	})
+	stub.statsbookByBot("get_head_to_head.php", map[string]interface{}{
+		"Zeus": []NHRLHeadToHead{
+			{OpponentUniqueName: "Lynx", NumFights: 3, Wins: 1, Losses: 2, KOd: 1, LastMeeting: "2025-03-08"},
+			{OpponentUniqueName: "Mole", NumFights: 1, Losses: 1},
+		},
+		"Lynx": []NHRLHeadToHead{{OpponentUniqueName: "Zeus", NumFights: 3, Wins: 2, Losses: 1, KOs: 1, LastMeeting: "2025-03-08"}},
+		"Bolt": []NHRLHeadToHead{{OpponentUniqueName: "Mole", NumFights: 1, Wins: 1}},
+		"Mole": []NHRLHeadToHead{{OpponentUniqueName: "Bolt", NumFights: 1, Losses: 1}},
+	})
+
+	output, err := getRevengeMatchups(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_revenge_matchups: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["scheduledMatches"] != 2.0 || result["revengeCount"] != 2.0 {
+		t.Fatalf("result = %v, want 2 revenge matchups among 2 scheduled matches", result)
+	}
+	matchups := result["revengeMatchups"].([]interface{})
+
+	// Both bots have beaten each other before, so both are seeking revenge
+	rematch := matchups[0].(map[string]interface{})
+	if rematch["gameID"] != "Q2W-1" || rematch["matchup"] != "Lynx vs Zeus" {
+		t.Errorf("first revenge matchup = %v, want Q2W-1 Lynx vs Zeus", rematch)
+	}
+	seekers := rematch["revengeSeekers"].([]interface{})
+	if len(seekers) != 2 {
+		t.Fatalf("revengeSeekers = %v, want both bots", seekers)
+	}
+	zeus := seekers[1].(map[string]interface{})
+	if zeus["bot"] != "Zeus" || zeus["lostTo"] != "Lynx" || zeus["priorRecord"] != "1-2" || zeus["timesKOd"] != 1.0 || zeus["lastMeeting"] != "2025-03-08" {
+		t.Errorf("Zeus seeker = %v, want a 1-2 record against Lynx, KOd once, last 2025-03-08", zeus)
+	}
+
+	// Only the bot that lost is seeking revenge
+	seekers = matchups[1].(map[string]interface{})["revengeSeekers"].([]interface{})
+	if len(seekers) != 1 || seekers[0].(map[string]interface{})["bot"] != "Mole" || seekers[0].(map[string]interface{})["priorRecord"] != "0-1" {
+		t.Errorf("Bolt vs Mole seekers = %v, want only Mole at 0-1", seekers)
+	}
+}
+
+func TestMarqueeMatchesEliteFightsFirst(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{
+		ID:      "t1",
+		Title:   "NHRL June 2025 3lb",
+		Players: tfPlayers("Lynx", "Zeus", "Bolt", "Mole", "Kite", "Hydra", "Nova"),
+		Games: []Game{
+			tfGame("Q1-1", "done", "Lynx", "Bolt"),
+			tfGame("Q2W-1", "available", "Mole", "Kite"),
+			tfGame("Q2W-2", "available", "Zeus", "Hydra"),
+			tfGame("Q2W-3", "called", "Bolt", "Hydra"),
+			tfGame("Q2W-4", "available", "Lynx", "Nova"),
+			tfGame("Q2W-5", "available", "Zeus", "Lynx"),
+		},
+	})
+	// Kite has no rank
+	stub.statsbookByBot("get_rank.php", map[string]interface{}{
+		"Lynx":  NHRLRanking{Ranking: 1},
+		"Bolt":  NHRLRanking{Ranking: 2},
+		"Zeus":  NHRLRanking{Ranking: 3},
+		"Hydra": NHRLRanking{Ranking: 5},
+		"Nova":  NHRLRanking{Ranking: 7},
+		"Mole":  NHRLRanking{Ranking: 8},
+	})
+
+	output, err := getMarqueeMatches(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_marquee_matches: %v", err)
+	}
+	result := decodeResult(t, output)
+	matches := result["matches"].([]interface{})
+	// Q2W-2 and Q2W-4 tie on combined rank; Lynx outranks Zeus, so Q2W-4 goes first.
+	// Kite counts as one place below Mole, the lowest-ranked bot in the field.
+	want := []struct {
+		gameID   string
+		combined float64
+		average  float64
+	}{
+		{"Q2W-5", 4, 2},
+		{"Q2W-3", 7, 3.5},
+		{"Q2W-4", 8, 4},
+		{"Q2W-2", 8, 4},
+		{"Q2W-1", 17, 8.5},
+	}
+	if len(matches) != len(want) {
+		t.Fatalf("matches = %v, want the %d upcoming matches", matches, len(want))
+	}
+	for i, w := range want {
+		match := matches[i].(map[string]interface{})
+		if match["gameID"] != w.gameID || match["combinedRank"] != w.combined || match["averageRank"] != w.average {
+			t.Errorf("matches[%d] = %s combined %v average %v, want %s combined %v average %v",
+				i, match["gameID"], match["combinedRank"], match["averageRank"], w.gameID, w.combined, w.average)
+		}
+	}
+	kite := matches[4].(map[string]interface{})["bots"].([]interface{})[1].(map[string]interface{})
+	if kite["name"] != "Kite" || kite["rank"] != 0.0 {
+		t.Errorf("Kite = %v, want reported unranked as rank 0", kite)
+	}
+}
+
+func TestBotCardAssetsPictureAndNextMatch(t *testing.T) {
+	stub := newUpstreamStub(t)
+	scheduled := int64(1750000000)
+	upcoming := tfGame("Q2W-1", "available", "Big Lynx", "Zeus")
+	upcoming.LocationID = strPtr("l3")
+	upcoming.ScheduledTime = &scheduled
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{
+		ID:        "t1",
+		Title:     "NHRL June 2025 3lb",
+		Players:   tfPlayers("Big Lynx", "Zeus", "Mole"),
+		Locations: []Location{{ID: "l3", Name: "Cage 3"}},
+		Games:     []Game{tfGame("Q1-1", "done", "Big Lynx", "Mole"), upcoming},
+	})
+
+	output, err := getBotCardAssets(map[string]interface{}{"tournament_id": "t1", "bot_name": "Big Lynx"})
+	if err != nil {
+		t.Fatalf("get_bot_card_assets: %v", err)
+	}
+	result := decodeResult(t, output)
+	picture := result["picture"].(map[string]interface{})
+	if picture["thumbnailURL"] != "https://brettzone.nhrl.io/brettZone/getBotPic.php?bot=Big_Lynx&thumb" ||
+		picture["fullSizeURL"] != "https://brettzone.nhrl.io/brettZone/getBotPic.php?bot=Big_Lynx" {
+		t.Errorf("picture = %v, want Big_Lynx thumbnail and full-size URLs", picture)
+	}
+
+	next := result["nextMatch"].(map[string]interface{})
+	if next["gameID"] != "Q2W-1" || next["opponent"] != "Zeus" || next["locationName"] != "Cage 3" || next["scheduledTime"] != "2025-06-15T15:06:40Z" {
+		t.Errorf("nextMatch = %v, want Q2W-1 against Zeus in Cage 3 at 2025-06-15T15:06:40Z", next)
+	}
+	opponentPicture, _ := next["opponentPicture"].(map[string]interface{})
+	if opponentPicture["thumbnailURL"] != "https://brettzone.nhrl.io/brettZone/getBotPic.php?bot=Zeus&thumb" {
+		t.Errorf("opponentPicture = %v, want Zeus's thumbnail", opponentPicture)
+	}
+}
+
+func TestStalledMatchesLongestWaitFirst(t *testing.T) {
+	stub := newUpstreamStub(t)
+	called := func(id, location string, since time.Time, playerIDs ...string) Game {
+		game := tfGame(id, "called", playerIDs...)
+		game.LocationID = strPtr(location)
+		calledSince := since.UnixMilli()
+		game.CalledSince = &calledSince
+		return game
+	}
+	now := time.Now()
+	recent := called("Q1-2", "l2", now.Add(-3*time.Minute), "Bolt", "Mole")
+	checkedIn := now.Add(-2 * time.Minute).UnixMilli()
+	recent.Slots[0].CheckInTime, recent.Slots[1].CheckInTime = &checkedIn, &checkedIn
+	stalled := called("Q1-1", "l1", now.Add(-12*time.Minute), "Lynx", "Zeus")
+	stalled.Slots[0].CheckInTime = &checkedIn
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{
+		ID:        "t1",
+		Title:     "NHRL June 2025 3lb",
+		Players:   tfPlayers("Lynx", "Zeus", "Bolt", "Mole"),
+		Locations: []Location{{ID: "l1", Name: "Cage 1"}, {ID: "l2", Name: "Cage 2"}},
+		Games:     []Game{recent, tfGame("Q1-3", "active", "Lynx", "Bolt"), stalled},
+	})
+
+	output, err := getStalledMatches(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_stalled_matches: %v", err)
+	}
+	result := decodeResult(t, output)
+	matches := result["matches"].([]interface{})
+	if result["stalledCount"] != 2.0 || len(matches) != 2 {
+		t.Fatalf("matches = %v, want the two called games", matches)
+	}
+
+	longest, shorter := matches[0].(map[string]interface{}), matches[1].(map[string]interface{})
+	if longest["gameID"] != "Q1-1" || longest["cage"] != "Cage 1" || shorter["gameID"] != "Q1-2" || shorter["cage"] != "Cage 2" {
+		t.Errorf("order = %v then %v, want Q1-1 in Cage 1 before Q1-2 in Cage 2", longest, shorter)
+	}
+	if wait := longest["waitMinutes"].(float64); wait < 11.9 || wait > 12.2 {
+		t.Errorf("Q1-1 waitMinutes = %v, want about 12", wait)
+	}
+	if wait := shorter["waitMinutes"].(float64); wait < 2.9 || wait > 3.2 {
+		t.Errorf("Q1-2 waitMinutes = %v, want about 3", wait)
+	}
+	if missing := longest["notCheckedIn"].([]interface{}); len(missing) != 1 || missing[0] != "Zeus" {
+		t.Errorf("Q1-1 notCheckedIn = %v, want [Zeus]", missing)
+	}
+	if missing := shorter["notCheckedIn"].([]interface{}); len(missing) != 0 {
+		t.Errorf("Q1-2 notCheckedIn = %v, want none", missing)
+	}
+}
+
+func TestClutchRecordBestOfThreeDeciders(t *testing.T) {
+	stub := newUpstreamStub(t)
+	scored := func(id, state string, scoreToWin int, player1 string, score1 float64, player2 string, score2 float64) Game {
+		game := tfGame(id, state, player1, player2)
+		game.ScoreToWin = scoreToWin
+		game.Slots[0].Score, game.Slots[1].Score = score1, score2
+		return game
+	}
+	players := tfPlayers("Lynx", "Zeus", "Bolt", "Mole", "Kite", "BYE")
+	players[5].IsBye = true
+	stub.json(trueFinalsHost+"/api/v1/tournaments/bo3", Tournament{
+		ID:      "bo3",
+		Title:   "NHRL June 2025 3lb",
+		Players: players,
+		Games: []Game{
+			scored("g1", "done", 2, "Lynx", 2, "Zeus", 1),
+			scored("g2", "done", 2, "Bolt", 2, "Lynx", 1),
+			scored("g3", "done", 2, "Lynx", 2, "Mole", 0),
+			scored("g4", "done", 2, "Kite", 1, "Lynx", 2),
+			scored("g5", "active", 2, "Lynx", 1, "Zeus", 1),
+			scored("g6", "done", 2, "Zeus", 2, "Bolt", 1),
+			scored("g7", "done", 2, "Lynx", 0, "BYE", 0),
+		},
+	})
+	stub.json(trueFinalsHost+"/api/v1/tournaments/single", Tournament{
+		ID:      "single",
+		Title:   "NHRL July 2025 3lb",
+		Players: players,
+		Games:   []Game{scored("h1", "done", 1, "Lynx", 1, "Zeus", 0)},
+	})
+
+	output, err := getClutchRecord(map[string]interface{}{"tournament_id": "bo3", "bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_clutch_record: %v", err)
+	}
+	result := decodeResult(t, output)
+	// The 2-0 sweep and the unfinished game are not deciders, and the bye is not a best-of-3 game
+	if result["applicable"] != true || result["bestOfGames"] != 4.0 || result["clutchGames"] != 3.0 ||
+		result["clutchWins"] != 2.0 || result["clutchLosses"] != 1.0 || result["clutchWinRate"] != 0.67 {
+		t.Errorf("result = %v, want 2-1 in 3 deciders out of 4 best-of-3 games", result)
+	}
+	var deciders []string
+	for _, g := range result["games"].([]interface{}) {
+		game := g.(map[string]interface{})
+		deciders = append(deciders, game["opponent"].(string)+" "+game["score"].(string)+" "+game["outcome"].(string))
+	}
+	if got := strings.Join(deciders, ", "); got != "Zeus 2-1 win, Bolt 1-2 loss, Kite 2-1 win" {
+		t.Errorf("deciders = %s", got)
+	}
+
+	output, err = getClutchRecord(map[string]interface{}{"tournament_id": "single", "bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_clutch_record: %v", err)
+	}
+	result = decodeResult(t, output)
+	if result["applicable"] != false || result["clutchGames"] != 0.0 || !strings.Contains(result["note"].(string), "Not applicable") {
+		t.Errorf("single elimination result = %v, want it flagged as not applicable", result)
+	}
+}
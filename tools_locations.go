@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // handleLocationsTool handles all location operations
@@ -22,6 +23,10 @@ func handleLocationsTool(args map[string]interface{}) (string, error) {
 		return listLocations(args)
 	case "get":
 		return getLocation(args)
+	case "get_active_overlay":
+		return getActiveLocationOverlay(args)
+	case "get_all_queues":
+		return getAllLocationQueues(args)
 	case "add":
 		return addLocation(args)
 	case "update":
@@ -69,6 +74,8 @@ Note: NHRL typically uses Cage 1-4 as location identifiers.`,
 QUERY OPERATIONS:
 - list: Get all locations for a tournament
 - get: Get specific location details and match queue
+- get_all_queues: Get the ordered "up next" queue for every location, resolved to bot names and scheduled times
+- get_active_overlay: Get stream overlay data (player names, scores, round name) for the game currently active at a location (requires location_id)
 
 LOCATION MANAGEMENT (require write access):
 - create: Add a new location/cage to tournament
@@ -80,7 +87,7 @@ MATCH CONTROL AT LOCATIONS:
 - update_queue: Reorder matches in location queue
 - clear_queue: Remove all matches from location queue`,
 					"enum": []string{
-						"list", "get", "create", "update", "delete",
+						"list", "get", "get_all_queues", "get_active_overlay", "create", "update", "delete",
 						"activate_next", "update_queue", "clear_queue",
 					},
 				},
@@ -192,6 +199,232 @@ func getLocation(args map[string]interface{}) (string, error) {
 	return string(jsonData), nil
 }
 
+// Get the ordered queue of upcoming games at every location
+func getAllLocationQueues(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	// The full tournament carries locations, games, and players in one request
+	data, err := makeAPIRequest("GET", fmt.Sprintf("/v1/tournaments/%s", tournamentID), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+	locations, games, players := tournament.Locations, tournament.Games, tournament.Players
+
+	// Index games and player names so each queue entry resolves without extra requests
+	gameMap := make(map[string]Game, len(games))
+	for _, game := range games {
+		gameMap[game.ID] = game
+	}
+	playerNames := make(map[string]string, len(players))
+	for _, player := range players {
+		playerNames[player.ID] = player.Name
+	}
+
+	queues := make([]map[string]interface{}, len(locations))
+	for i, location := range locations {
+		queue := make([]map[string]interface{}, 0, len(location.Queue))
+		for position, gameID := range location.Queue {
+			entry := map[string]interface{}{
+				"position": position + 1,
+				"gameID":   gameID,
+			}
+
+			if game, ok := gameMap[gameID]; ok {
+				var names []string
+				for _, slot := range game.Slots {
+					if slot.PlayerID != nil && playerNames[*slot.PlayerID] != "" {
+						names = append(names, playerNames[*slot.PlayerID])
+					}
+				}
+
+				entry["gameName"] = game.Name
+				entry["playerNames"] = names
+				entry["state"] = game.State
+				if game.ScheduledTime != nil && *game.ScheduledTime > 0 {
+					entry["scheduledTime"] = *game.ScheduledTime
+					entry["scheduledAt"] = trueFinalsTime(*game.ScheduledTime).UTC().Format(time.RFC3339)
+				}
+
+				roundInfo := getRoundInfo(game.Name)
+				if roundInfo.Code == game.Name && roundInfo.Name != "" {
+					entry["roundName"] = roundInfo.Name
+				}
+			}
+
+			queue = append(queue, entry)
+		}
+
+		queueInfo := map[string]interface{}{
+			"locationID":   location.ID,
+			"locationName": location.Name,
+			"queueLength":  len(queue),
+			"queue":        queue,
+		}
+		if location.ActiveGameID != nil {
+			queueInfo["activeGameID"] = *location.ActiveGameID
+		}
+		queues[i] = queueInfo
+	}
+
+	result := map[string]interface{}{
+		"tournament_id": tournamentID,
+		"locations":     queues,
+		"count":         len(queues),
+	}
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get overlay data for the game currently active at a location
+func getActiveLocationOverlay(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	locationID, ok := args["location_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("location_id is required")
+	}
+
+	// The full tournament carries locations, games, and players in one request
+	data, err := makeAPIRequest("GET", fmt.Sprintf("/v1/tournaments/%s", tournamentID), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	var location *Location
+	for i := range tournament.Locations {
+		if tournament.Locations[i].ID == locationID {
+			location = &tournament.Locations[i]
+			break
+		}
+	}
+	if location == nil {
+		return "", fmt.Errorf("location %s not found in tournament %s", locationID, tournamentID)
+	}
+
+	result := map[string]interface{}{
+		"tournament_id": tournamentID,
+		"locationID":    location.ID,
+		"locationName":  location.Name,
+	}
+
+	if location.ActiveGameID == nil || *location.ActiveGameID == "" {
+		result["active"] = false
+		result["note"] = "No game is currently active at this location"
+	} else {
+		var game *Game
+		for i := range tournament.Games {
+			if tournament.Games[i].ID == *location.ActiveGameID {
+				game = &tournament.Games[i]
+				break
+			}
+		}
+		if game == nil {
+			return "", fmt.Errorf("active game %s not found in tournament %s", *location.ActiveGameID, tournamentID)
+		}
+
+		playerMap := make(map[string]Player, len(tournament.Players))
+		for _, player := range tournament.Players {
+			playerMap[player.ID] = player
+		}
+
+		// Qualification games are named by round code (e.g. Q2W); bracket games by round number
+		bracketType := "main"
+		if tournament.Format.Type == "double_elimination" {
+			bracketType = getBracketTypeFromRound(game.Round)
+		}
+		roundName := getRoundName(abs(game.Round), bracketType, tournament.Format.Type)
+		shortRoundName := game.Name
+		if roundInfo := getRoundInfo(game.Name); roundInfo.Code == game.Name && roundInfo.Name != "" {
+			roundName = roundInfo.Name
+		}
+
+		overlay := OverlayData{
+			TournamentName: tournament.Title,
+			LogoURL:        tournament.LogoURL,
+			BracketName:    game.BracketID,
+			RoundName:      roundName,
+			ShortRoundName: shortRoundName,
+			ScoreToWin:     game.ScoreToWin,
+			Players:        make([]OverlayPlayer, 0, len(game.Slots)),
+		}
+
+		for _, slot := range game.Slots {
+			overlay.Players = append(overlay.Players, overlayPlayerForSlot(slot, playerMap))
+		}
+
+		result["active"] = true
+		result["gameID"] = game.ID
+		result["gameName"] = game.Name
+		result["state"] = game.State
+		result["overlayData"] = overlay
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// overlayPlayerForSlot builds the overlay entry for one game slot from the
+// tournament's players
+func overlayPlayerForSlot(slot GameSlot, playerMap map[string]Player) OverlayPlayer {
+	overlayPlayer := OverlayPlayer{ScoreText: fmt.Sprintf("%d", int(slot.Score))}
+	if slot.Score < 0 {
+		// Negative scores mark a slot without a result (e.g. DQ)
+		overlayPlayer.ScoreText = "-"
+	}
+
+	if slot.PlayerID != nil {
+		if player, ok := playerMap[*slot.PlayerID]; ok {
+			overlayPlayer.Name = player.Name
+			overlayPlayer.PhotoURL = player.PhotoURL
+			overlayPlayer.Wins = player.Wins
+			overlayPlayer.Losses = player.Losses
+			overlayPlayer.Ties = player.Ties
+			overlayPlayer.Seed = player.Seed
+			if player.ProfileInfo != nil {
+				if player.ProfileInfo.Tag != "" {
+					tag := player.ProfileInfo.Tag
+					overlayPlayer.Tag = &tag
+				}
+				if player.ProfileInfo.Pronouns != "" {
+					pronouns := player.ProfileInfo.Pronouns
+					overlayPlayer.Pronouns = &pronouns
+				}
+				overlayPlayer.TwitterHandle = player.ProfileInfo.TwitterHandle
+			}
+		}
+	}
+
+	return overlayPlayer
+}
+
 // Add a new location to a tournament
 func addLocation(args map[string]interface{}) (string, error) {
 	tournamentID, ok := args["tournament_id"].(string)
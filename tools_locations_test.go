@@ -0,0 +1,108 @@
+package main
+
+import "testing"
+
+func TestAllQueuesForTwoCages(t *testing.T) {
+	stub := newUpstreamStub(t)
+
+	next := tfGame("W-1", "available", "Lynx", "Zeus")
+	next.ScheduledTime = int64Ptr(1750000000)
+	tournamentPath := trueFinalsHost + "/api/v1/tournaments/t1"
+	stub.json(tournamentPath, Tournament{
+		ID:    "t1",
+		Title: "NHRL June 2025 3lb",
+		Locations: []Location{
+			{ID: "l1", Name: "Cage 1", ActiveGameID: strPtr("Q1-1"), Queue: []string{"W-1", "W-2"}},
+			{ID: "l2", Name: "Cage 2", Queue: []string{"L-1"}},
+		},
+		Games:   []Game{tfGame("Q1-1", "active", "Bolt", "Hydra"), next, tfGame("W-2", "unavailable", "Bolt"), tfGame("L-1", "available", "Hydra", "Mole")},
+		Players: tfPlayers("Lynx", "Zeus", "Bolt", "Hydra", "Mole"),
+	})
+
+	output, err := getAllLocationQueues(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_all_queues: %v", err)
+	}
+	queues := decodeResult(t, output)["locations"].([]interface{})
+	if len(queues) != 2 {
+		t.Fatalf("queues = %v, want one per cage", queues)
+	}
+
+	cage1 := queues[0].(map[string]interface{})
+	if cage1["locationName"] != "Cage 1" || cage1["queueLength"].(float64) != 2 || cage1["activeGameID"] != "Q1-1" {
+		t.Errorf("cage 1 = %v, want two queued games behind Q1-1", cage1)
+	}
+	first := cage1["queue"].([]interface{})[0].(map[string]interface{})
+	names := first["playerNames"].([]interface{})
+	if first["position"].(float64) != 1 || first["gameID"] != "W-1" || len(names) != 2 || names[0] != "Lynx" || names[1] != "Zeus" {
+		t.Errorf("cage 1 up next = %v, want W-1 Lynx vs Zeus", first)
+	}
+	if first["scheduledAt"] != "2025-06-15T15:06:40Z" {
+		t.Errorf("scheduledAt = %v, want 2025-06-15T15:06:40Z", first["scheduledAt"])
+	}
+	if second := cage1["queue"].([]interface{})[1].(map[string]interface{}); second["gameID"] != "W-2" || second["position"].(float64) != 2 {
+		t.Errorf("cage 1 second = %v, want W-2 at position 2", second)
+	}
+
+	if calls := stub.count(tournamentPath); calls != 1 {
+		t.Errorf("tournament requested %d times, want one request for the whole tournament", calls)
+	}
+
+	cage2 := queues[1].(map[string]interface{})
+	entry := cage2["queue"].([]interface{})[0].(map[string]interface{})
+	if cage2["queueLength"].(float64) != 1 || entry["gameID"] != "L-1" {
+		t.Errorf("cage 2 = %v, want L-1 queued", cage2)
+	}
+	if _, ok := cage2["activeGameID"]; ok {
+		t.Errorf("cage 2 has an activeGameID but nothing is running there")
+	}
+}
+
+func TestActiveOverlayForCage(t *testing.T) {
+	stub := newUpstreamStub(t)
+
+	active := tfGame("Q2W-3", "active", "Lynx", "Zeus")
+	active.Name, active.ScoreToWin = "Q2W", 1
+	active.Slots[0].Score = 1
+	players := tfPlayers("Lynx", "Zeus")
+	players[0].ProfileInfo = &ProfileInfo{Tag: "LYNX", Pronouns: "it/its"}
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{
+		ID:    "t1",
+		Title: "NHRL June 2025 3lb",
+		Locations: []Location{
+			{ID: "l1", Name: "Cage 1", ActiveGameID: strPtr("Q2W-3")},
+			{ID: "l2", Name: "Cage 2"},
+		},
+		Games:   []Game{tfGame("Q1-1", "done", "Lynx", "Zeus"), active},
+		Players: players,
+	})
+
+	output, err := getActiveLocationOverlay(map[string]interface{}{"tournament_id": "t1", "location_id": "l1"})
+	if err != nil {
+		t.Fatalf("get_active_overlay: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["active"] != true || result["gameID"] != "Q2W-3" || result["locationName"] != "Cage 1" {
+		t.Fatalf("result = %v, want Q2W-3 active in Cage 1", result)
+	}
+	overlay := result["overlayData"].(map[string]interface{})
+	if overlay["tournamentName"] != "NHRL June 2025 3lb" || overlay["roundName"] != "The Cusp" || overlay["shortRoundName"] != "Q2W" {
+		t.Errorf("overlay = %v, want the Q2W round names", overlay)
+	}
+	overlayPlayers := overlay["players"].([]interface{})
+	lynx, zeus := overlayPlayers[0].(map[string]interface{}), overlayPlayers[1].(map[string]interface{})
+	if lynx["name"] != "Lynx" || lynx["scoreText"] != "1" || lynx["tag"] != "LYNX" {
+		t.Errorf("player 1 = %v, want Lynx scoring 1 with tag LYNX", lynx)
+	}
+	if zeus["name"] != "Zeus" || zeus["scoreText"] != "0" {
+		t.Errorf("player 2 = %v, want Zeus scoring 0", zeus)
+	}
+
+	output, err = getActiveLocationOverlay(map[string]interface{}{"tournament_id": "t1", "location_id": "l2"})
+	if err != nil {
+		t.Fatalf("get_active_overlay: %v", err)
+	}
+	if idle := decodeResult(t, output); idle["active"] != false {
+		t.Errorf("idle cage = %v, want active false", idle)
+	}
+}
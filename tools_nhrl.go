@@ -3,7 +3,13 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 // paginateSlice applies pagination to any slice and returns the paginated slice along with metadata
@@ -50,6 +56,92 @@ func paginateSlice[T any](items []T, limit, offset int) ([]T, map[string]interfa
 	return paginatedItems, metadata
 }
 
+// statSortKey describes one sortable stat summary field. Derived keys are
+// computed from the raw fields before sorting.
+type statSortKey struct {
+	derived   bool
+	ascending bool
+	value     func(NHRLStatSummary) float64
+}
+
+// ratio returns num/den, or 0 when den is 0
+func ratio(num, den int) float64 {
+	if den == 0 {
+		return 0
+	}
+	return float64(num) / float64(den)
+}
+
+// statSummarySortKeys lists the sort_by keys accepted by the weight class stat summary operations
+var statSummarySortKeys = map[string]statSortKey{
+	"ranking": {ascending: true, value: func(s NHRLStatSummary) float64 { return float64(s.Ranking) }},
+	"points": {value: func(s NHRLStatSummary) float64 {
+		points, _ := strconv.ParseFloat(strings.TrimSpace(s.Points), 64)
+		return points
+	}},
+	"events": {value: func(s NHRLStatSummary) float64 { return float64(s.Events) }},
+	"fights": {value: func(s NHRLStatSummary) float64 { return float64(s.Fights) }},
+	"wins":   {value: func(s NHRLStatSummary) float64 { return float64(s.W) }},
+	"losses": {value: func(s NHRLStatSummary) float64 { return float64(s.L) }},
+	"kos":    {value: func(s NHRLStatSummary) float64 { return float64(s.KOs) }},
+	// Derived keys
+	"win_pct":      {derived: true, value: func(s NHRLStatSummary) float64 { return ratio(s.W, s.W+s.L) }},
+	"ko_rate":      {derived: true, value: func(s NHRLStatSummary) float64 { return ratio(s.KOs, s.Fights) }},
+	"kod_rate":     {derived: true, ascending: true, value: func(s NHRLStatSummary) float64 { return ratio(s.KOd, s.Fights) }},
+	"ko_win_share": {derived: true, value: func(s NHRLStatSummary) float64 { return ratio(s.KOs, s.W) }},
+	"avg_fight_time": {derived: true, ascending: true, value: func(s NHRLStatSummary) float64 {
+		secs, _ := strconv.ParseFloat(strings.TrimSpace(s.AvgFightTimeSecs), 64)
+		return secs
+	}},
+}
+
+// sortedStatSummary is a stat summary row annotated with the value it was sorted by
+type sortedStatSummary struct {
+	NHRLStatSummary
+	SortValue float64 `json:"sort_value"`
+}
+
+// sortStatSummary computes the sort_by value for every row, then sorts on it
+// (ties broken by ranking, then bot name). Unranked bots sort last by ranking.
+func sortStatSummary(stats []NHRLStatSummary, sortBy string) ([]sortedStatSummary, error) {
+	key, ok := statSummarySortKeys[sortBy]
+	if !ok {
+		names := make([]string, 0, len(statSummarySortKeys))
+		for name := range statSummarySortKeys {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("invalid sort_by: %s (must be one of %s)", sortBy, strings.Join(names, ", "))
+	}
+
+	rows := make([]sortedStatSummary, len(stats))
+	for i, stat := range stats {
+		rows[i] = sortedStatSummary{NHRLStatSummary: stat, SortValue: key.value(stat)}
+		if sortBy == "ranking" && stat.Ranking <= 0 {
+			rows[i].SortValue = math.MaxInt32
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].SortValue != rows[j].SortValue {
+			if key.ascending {
+				return rows[i].SortValue < rows[j].SortValue
+			}
+			return rows[i].SortValue > rows[j].SortValue
+		}
+		rankI, rankJ := rows[i].Ranking, rows[j].Ranking
+		if rankI != rankJ && rankI > 0 && rankJ > 0 {
+			return rankI < rankJ
+		}
+		if (rankI > 0) != (rankJ > 0) {
+			return rankI > 0
+		}
+		return strings.ToLower(rows[i].Bot) < strings.ToLower(rows[j].Bot)
+	})
+
+	return rows, nil
+}
+
 // handleNHRLStatsTool handles all NHRL stats operations
 func handleNHRLStatsTool(args map[string]interface{}) (string, error) {
 	operation, ok := args["operation"].(string)
@@ -67,10 +159,16 @@ func handleNHRLStatsTool(args map[string]interface{}) (string, error) {
 		return getNHRLBotRankTool(args)
 	case "get_bot_fights":
 		return getNHRLBotFightsTool(args)
+	case "export_fights":
+		return getNHRLExportFightsTool(args)
 	case "get_bot_head_to_head":
 		return getNHRLBotHeadToHeadTool(args)
+	case "list_opponents":
+		return getNHRLListOpponentsTool(args)
 	case "get_bot_stats_by_season":
 		return getNHRLBotStatsBySeasonTool(args)
+	case "get_bot_career_vs_season":
+		return getNHRLBotCareerVsSeasonTool(args)
 	case "get_bot_streak_stats":
 		return getNHRLBotStreakStatsTool(args)
 	case "get_bot_event_participants":
@@ -91,14 +189,120 @@ func handleNHRLStatsTool(args map[string]interface{}) (string, error) {
 		return getNHRLRandomFightTool(args)
 	case "get_tournament_matches":
 		return getBrettZoneTournamentMatchesTool(args)
+	case "get_event_videos":
+		return getBrettZoneEventVideosTool(args)
 	case "get_match_review_url":
 		return getBrettZoneMatchReviewURLTool(args)
 	case "get_qualification_system":
 		return getNHRLQualificationSystemTool(args)
+	case "plan_bracket":
+		return getNHRLPlanBracketTool(args)
 	case "get_live_fight_stats":
 		return getNHRLLiveFightStatsTool(args)
+	case "get_intro_script":
+		return getNHRLIntroScriptTool(args)
 	case "get_bot_picture_url":
 		return getNHRLBotPictureURLTool(args)
+	case "get_recent_results":
+		return getBrettZoneRecentResultsTool(args)
+	case "get_record_vs_top_n":
+		return getNHRLRecordVsTopNTool(args)
+	case "get_rivalry_timeline":
+		return getNHRLRivalryTimelineTool(args)
+	case "get_shared_events":
+		return getNHRLSharedEventsTool(args)
+	case "get_matchup_probability":
+		return getNHRLMatchupProbabilityTool(args)
+	case "get_bot_videos":
+		return getNHRLBotVideosTool(args)
+	case "get_activity_trend":
+		return getNHRLActivityTrendTool(args)
+	case "get_opponent_quality_trend":
+		return getNHRLOpponentQualityTrendTool(args)
+	case "get_parity_index":
+		return getNHRLParityIndexTool(args)
+	case "get_bots_by_region":
+		return getNHRLBotsByRegionTool(args)
+	case "get_iron_bot":
+		return getNHRLIronBotTool(args)
+	case "get_vs_class_average":
+		return getNHRLVsClassAverageTool(args)
+	case "get_fun_facts":
+		return getNHRLFunFactsTool(args)
+	case "get_fights_to_win":
+		return getNHRLFightsToWinTool(args)
+	case "get_career_length_stats":
+		return getNHRLCareerLengthStatsTool(args)
+	case "get_championship_lineage":
+		return getNHRLChampionshipLineageTool(args)
+	case "get_global_leaderboard":
+		return getNHRLGlobalLeaderboardTool(args)
+	case "get_giant_killer":
+		return getNHRLGiantKillerTool(args)
+	case "get_roster":
+		return getNHRLRosterTool(args)
+	case "get_rivalries":
+		return getNHRLRivalriesTool(args)
+	case "get_active_rankings":
+		return getNHRLActiveRankingsTool(args)
+	case "get_record_vs_bot_type":
+		return getNHRLRecordVsBotTypeTool(args)
+	case "get_qualifier_vs_placement":
+		return getNHRLQualifierVsPlacementTool(args)
+	case "get_consistency":
+		return getNHRLConsistencyTool(args)
+	case "get_body_count":
+		return getNHRLBodyCountTool(args)
+	case "get_finals_record":
+		return getNHRLFinalsRecordTool(args)
+	case "get_first_time_winners":
+		return getNHRLFirstTimeWinnersTool(args)
+	case "get_bot_kos":
+		return getNHRLBotKOsTool(args)
+	case "get_signature_finish":
+		return getNHRLSignatureFinishTool(args)
+	case "get_streak_composition":
+		return getNHRLStreakCompositionTool(args)
+	case "get_rank_delta":
+		return getNHRLRankDeltaTool(args)
+	case "get_career_bookends":
+		return getNHRLCareerBookendsTool(args)
+	case "get_match_timeline":
+		return getBrettZoneMatchTimelineTool(args)
+	case "list_win_methods":
+		return getNHRLWinMethodsTool(args)
+	case "get_results_summary":
+		return getBrettZoneResultsSummaryTool(args)
+	case "get_event_highlights":
+		return getBrettZoneEventHighlightsTool(args)
+	case "get_comeback_runs":
+		return getBrettZoneComebackRunsTool(args)
+	case "get_bot_event_matches":
+		return getBrettZoneBotEventMatchesTool(args)
+	case "get_tournament_cameras":
+		return getBrettZoneTournamentCamerasTool(args)
+	case "find_bot_live":
+		return getBrettZoneFindBotLiveTool(args)
+	case "get_record_by_cage":
+		return getBrettZoneRecordByCageTool(args)
+	case "get_attrition":
+		return getBrettZoneAttritionTool(args)
+	case "get_weekly_digest":
+		return getBrettZoneWeeklyDigestTool(args)
+	case "search_by_duration":
+		return getBrettZoneSearchByDurationTool(args)
+	case "search_by_annotation":
+		return getBrettZoneSearchByAnnotationTool(args)
+	case "get_brettzone_bracket":
+		return getBrettZoneBracketTool(args)
+	case "get_debut_bots":
+		return getNHRLDebutBotsTool(args)
+	case "find_idle_gaps":
+		return getBrettZoneIdleGapsTool(args)
+	case "get_closest_fights":
+		return getBrettZoneClosestFightsTool(args)
+	case "get_bot_class_standing":
+		return getNHRLBotClassStandingTool(args)
 	default:
 		return "", fmt.Errorf("unknown operation: %s", operation)
 	}
@@ -129,12 +333,32 @@ Use this tool when you need historical data, performance statistics, or current
 
 BOT-SPECIFIC OPERATIONS (require bot_name):
 - get_bot_rank: Get current ranking (based on Active season - previous + current season performance)
-- get_bot_fights: Get complete fight history with dates, opponents, results, and methods
+- get_bot_fights: Get complete fight history with dates, opponents, results, and methods (optional event_type)
+- export_fights: Get the bot's full fight history as CSV (date, round, opponent, result, method, length_secs, video_link) for spreadsheets (optional event_type)
 - get_bot_head_to_head: Get win/loss records against all opponents the bot has faced
+- list_opponents: Lightweight alphabetical list of every distinct opponent the bot has faced, with meeting counts
 - get_bot_stats_by_season: Get wins, losses, KOs, and other stats for a specific season
+- get_bot_career_vs_season: All-time stats paired with one season's (default Active) and the deltas between them
 - get_bot_streak_stats: Get current and historical winning/losing streak information
-- get_bot_event_participants: List all tournaments/events the bot has participated in
+- get_streak_composition: Break down how each fight in the bot's current or longest streak was decided (KO vs JD), e.g. a KO streak vs a streak of decisions (optional streak: current, longest_win, longest_loss)
+- get_signature_finish: The bot's most common win method and median KO finish time, e.g. "usually KOs opponents around 45 seconds"
+- get_bot_event_participants: List all tournaments/events the bot has participated in (optional event_type)
 - get_bot_picture_url: Get thumbnail and full-size image URLs for the bot
+- get_bot_class_standing: Get a single bot's stat summary row (rank, points, record) within its weight class for a season (uses weight_class, season; defaults to Active)
+- get_bot_videos: List the bot's fight video links, newest first (set group_by_event=true to organize them by event)
+- get_career_bookends: Get the bot's first-ever and most-recent fights plus total career span in days
+- get_rank_delta: Compare the bot's Active and all-time standing in its weight class as a share of each ranked pool (top X%); a positive percentile_delta means it stands better now (momentum)
+- get_finals_record: Get the bot's record and fights in finals rounds (WF, LF, GF, GFR) - its "clutch factor"
+- get_body_count: Every opponent the bot knocked out of an event (a win that ended the opponent's run), split into bracket eliminations and qualifier (Q2L/Q3) eliminations
+- get_bot_kos: Get the bot's knockout wins (victim, date, round, fight length, video) separately from its knockout losses
+- get_opponent_quality_trend: Per season, the average rank of the opponents the bot faced (in that season's standings), showing whether it is climbing into tougher competition (optional weight_class, default 3lb)
+- get_consistency: Score how consistently the bot places across events: 100 * (1 - stddev/mean of placements), clamped to 0-100, with the underlying placements
+- get_qualifier_vs_placement: Per event, the bot's qualifier (Q1/Q2/Q3) record alongside whether it made the bracket and its final placement
+- get_record_vs_bot_type: Get the bot's win/loss record against each weapon archetype (vertical, horizontal, drum, control, etc.); opponents without a known type are grouped as "unknown"
+- get_matchup_probability: Estimate bot1's win probability against bot2 from their head-to-head history (requires bot1, bot2)
+- get_record_vs_top_n: The bot's aggregated head-to-head record against opponents currently ranked in the top N of its weight class (optional top_n, default 10)
+- get_shared_events: Events both bots entered, most recent first, flagging the ones where they fought each other (requires bot1, bot2)
+- get_rivalry_timeline: Every meeting between two bots, oldest first, with date, event, round, winner, method, video link, and the running series score after each (requires bot1, bot2)
 
 WEIGHT CLASS OPERATIONS (use weight_class parameter):
 - get_weight_class_dumpster_count: Get bots with most podium finishes (championship achievements)
@@ -145,22 +369,77 @@ WEIGHT CLASS OPERATIONS (use weight_class parameter):
   * Use season="Active" for CURRENT RANKINGS (recommended for ranking queries)
   * Use season="all-time" for historical all-time statistics
   * Use specific year (e.g., "2024") for that season's statistics
+- get_active_rankings: Current (Active season) rankings with movement direction/magnitude (e.g. ↑2, ↓1) and new-entry flags
+- get_rivalries: Bot pairs in the class that have met most often (ties broken by closest record); scans the class's most active bots in the season, but meetings are counted all-time
+- get_giant_killer: The bot with the most wins, fought during the season (default Active), over opponents ranked above it in that season's rankings (any ranked opponent for an unranked bot), with a leaderboard
+- get_roster: Just the bot names in the class (all-time), served from a cache for autocomplete/typeahead
+- get_championship_lineage: Chronological list of event champions in a weight class, flagging first-time winners and title defenses, with a running title count per bot
+- get_first_time_winners: Bots in a weight class that won their first-ever title most recently (breakout competitors), newest first
+- get_activity_trend: Per-season count of active bots and total fights in a weight class, with season-over-season growth
+- get_bots_by_region: Group a weight class's Active season bots by their driver's home state or country (group_by: state (default) or country)
+- get_parity_index: How evenly wins are spread across a weight class in a season (Gini coefficient of win counts) with a plain-English interpretation (season defaults to current)
+- get_vs_class_average: A bot's win %, KO rate, and fight count next to its weight class's averages, with its percentile in each (requires bot_name; optional weight_class, season - default Active)
+- get_fun_facts: Templated "did you know" facts for broadcast filler, derived from the stats (debut, fastest KO, longest streak, most-faced opponent for a bot; record KO, streak, title leader, most fights for a weight class) (requires bot_name or weight_class)
+- get_fights_to_win: Mean and median number of wins (qualifiers included) a class's champions needed to take each recent event (optional limit, default 20 events)
+- get_career_length_stats: Distribution of career spans (first to last appearance) across a weight class's bots, with mean, median, and a histogram
+- get_iron_bot: The bot with the most fights in a weight class and season, plus the top N most active bots (season defaults to current)
+- get_global_leaderboard: Cross-class "pound-for-pound" leaderboard for the Active season; bots are ranked by points percentile within their own class (ties broken by win %), labeled by class
 - get_weight_class_stat_summary_simple: All-time statistics only (not recommended for current rankings)
 
 TOURNAMENT/MATCH OPERATIONS:
 - get_tournament_matches: Get all matches from a BrettZone tournament with results and bracket info
 - get_match_review_url: Generate a video review URL for a specific match
+- get_event_videos: Review URLs for every match in a tournament, grouped by round in bracket order, for building a playlist (requires tournament_id; optional time_seconds)
 - get_live_fight_stats: Get head-to-head stats and bot info for an upcoming match (requires bot1, bot2)
+- get_intro_script: Get a short ready-to-read announcer intro for a bot (pronunciation, driver, hometown, team, record, and a fun fact), skipping anything not on file
+- get_recent_results: Get the most recently completed matches in a tournament, newest first (optional since filter)
+- get_brettzone_bracket: Reconstruct an event's bracket from BrettZone, grouped by round in order Q1 → Q2W → Q2L → Q3 → winners → losers → grand finals
+- get_match_timeline: Get a match's available → called → started → stopped timeline with wait-to-call, call-to-start, and fight-length durations (requires tournament_id, game_id)
+- find_idle_gaps: Find windows where a cage had a match ready but nothing running, per cage with durations (optional min_gap_seconds, default 60)
+- get_debut_bots: List tournament participants making their first-ever NHRL appearance (rookie watch)
+- search_by_annotation: Filter a tournament's matches by win annotation text, e.g. "split decision" (case-insensitive substring, or regex with use_regex=true)
+- search_by_duration: Find a tournament's decided matches lasting between min_secs and max_secs, shortest first (optional weight_class filter)
+- get_event_highlights: Get structured recap highlights for a tournament: fastest KO, biggest upset (by Active season rank), longest match, undefeated bots, and the champion
+- get_results_summary: Get a short shareable recap of a tournament (champion, finalists, notable KOs, match count) for posting to social media or Discord (output_format: markdown (default), text, or json)
+- get_bot_event_matches: Get one bot's matches in a single tournament with results, opponents, and review URLs, in bracket order (requires tournament_id, bot_name)
+- get_comeback_runs: Bots that lost their opening qualifier (Q1) but still made the main bracket, deepest runs first, with each bot's path (requires tournament_id)
+- get_tournament_cameras: List every distinct camera across a tournament's matches, grouped by cage, for building a stream switcher config
+- find_bot_live: Check whether a bot is fighting, called, or up next in any of several live tournaments, with cage and review URL (requires bot_name, tournament_ids)
+- get_record_by_cage: Tally a bot's wins and losses per cage across tournament_ids (max 10), or across its most recent BrettZone events when no tournaments are given (requires bot_name)
+- get_attrition: Average funnel of how many competitors remain at each stage (entered, survived Redemption, made bracket, reached finals, champion) across tournament_ids (max 10), optionally filtered by weight_class
+- get_weekly_digest: One digest across several events (champions, notable upsets, fastest KOs, unbeaten bots) from tournament_ids (max 10), or from the TrueFinals tournaments scheduled between from and to (default: the last 7 days)
+- list_win_methods: List the distinct win-method strings actually recorded, with counts, for exact-value filtering (tournament_id for BrettZone annotations, optionally filtered by weight_class; or weight_class alone for statsbook result_by values)
+- get_closest_fights: Get a weight class's fights that went the distance to a judges' decision (JD), longest first (uses weight_class, default 3lb; optional tournament_id to read one BrettZone tournament instead, narrowed to weight_class only when it is given)
 
 GENERAL OPERATIONS:
 - get_random_fight: Get a random fight from NHRL history (fun/demo purposes)
-- get_qualification_system: Explain NHRL's tournament qualification rounds and progression`,
+- get_qualification_system: Explain NHRL's tournament qualification rounds and progression
+- plan_bracket: For an entrant_count, compute qualifier rounds (Q1/Q2W/Q2L/Q3), how many advance, bracket size, and byes (format: qualifiers or direct)`,
 					"enum": []string{
 						"get_bot_rank", "get_bot_fights", "get_bot_head_to_head", "get_bot_stats_by_season",
 						"get_bot_streak_stats", "get_bot_event_participants", "get_weight_class_dumpster_count",
 						"get_weight_class_event_winners", "get_weight_class_fastest_kos", "get_weight_class_longest_streaks",
 						"get_weight_class_stat_summary", "get_weight_class_stat_summary_simple", "get_random_fight", "get_tournament_matches", "get_match_review_url",
-						"get_qualification_system", "get_live_fight_stats", "get_bot_picture_url",
+						"get_qualification_system", "get_live_fight_stats", "get_bot_picture_url", "get_recent_results",
+						"get_matchup_probability", "get_bot_class_standing", "get_bot_videos", "get_closest_fights",
+						"get_career_bookends", "get_active_rankings", "get_rivalries",
+						"get_roster", "get_match_timeline", "get_global_leaderboard",
+						"find_idle_gaps", "get_debut_bots", "get_record_vs_bot_type",
+						"get_brettzone_bracket", "get_giant_killer", "search_by_annotation",
+						"get_event_highlights", "find_bot_live", "get_qualifier_vs_placement",
+						"get_activity_trend", "get_bot_event_matches",
+						"get_consistency", "get_tournament_cameras",
+						"get_bot_kos", "get_rank_delta", "search_by_duration",
+						"get_championship_lineage", "get_finals_record", "plan_bracket",
+						"get_record_by_cage", "export_fights", "get_parity_index",
+						"get_results_summary", "get_bots_by_region", "get_streak_composition",
+						"list_win_methods", "get_opponent_quality_trend", "get_first_time_winners",
+						"get_intro_script", "get_career_length_stats", "list_opponents",
+						"get_comeback_runs", "get_shared_events", "get_attrition",
+						"get_signature_finish", "get_iron_bot", "get_bot_career_vs_season",
+						"get_record_vs_top_n", "get_weekly_digest", "get_event_videos", "get_fights_to_win",
+						"get_fun_facts", "get_vs_class_average", "get_body_count",
+						"get_rivalry_timeline",
 					},
 				},
 				"bot_name": map[string]interface{}{
@@ -169,17 +448,24 @@ GENERAL OPERATIONS:
 				},
 				"bot1": map[string]interface{}{
 					"type":        "string",
-					"description": "First bot name for head-to-head comparison (used with get_live_fight_stats). This is typically the opponent.",
+					"description": "First bot name for head-to-head comparison (used with get_live_fight_stats, get_matchup_probability, get_shared_events, and get_rivalry_timeline). For get_live_fight_stats this is typically the opponent; for get_matchup_probability the probability is reported for this bot.",
 				},
 				"bot2": map[string]interface{}{
 					"type":        "string",
-					"description": "Second bot name for head-to-head comparison (used with get_live_fight_stats). Stats returned will be for this bot, including head-to-head record against bot1.",
+					"description": "Second bot name for head-to-head comparison (used with get_live_fight_stats, get_matchup_probability, get_shared_events, and get_rivalry_timeline). For get_live_fight_stats, stats returned will be for this bot, including head-to-head record against bot1.",
 				},
 				"weight_class": map[string]interface{}{
 					"type":        "string",
 					"description": "Weight class for the query. Use '3lb' (Beetleweight), '12lb' (Antweight), or '30lb' (Hobbyweight). Alternative names like 'beetleweight', 'antweight', 'hobbyweight' are also accepted.",
 					"enum":        []string{"3lb", "12lb", "30lb", "beetleweight", "antweight", "hobbyweight"},
 				},
+				"sort_by": map[string]interface{}{
+					"type": "string",
+					"description": `Sort order for get_weight_class_stat_summary and get_weight_class_stat_summary_simple (applied before pagination; each row gains a sort_value):
+- Raw fields: ranking (ascending), points, events, fights, wins, losses, kos
+- Derived fields: win_pct (W / (W+L)), ko_rate (KOs / fights), ko_win_share (KOs / wins), kod_rate (KOd / fights, ascending), avg_fight_time (ascending)
+Ties are broken by ranking, then bot name.`,
+				},
 				"season": map[string]interface{}{
 					"type": "string",
 					"description": `Season for stats query:
@@ -194,11 +480,16 @@ IMPORTANT: Use "Active" when you want current rankings, not "all-time"!`,
 				},
 				"tournament_id": map[string]interface{}{
 					"type":        "string",
-					"description": "BrettZone tournament identifier for tournament operations. Format is typically 'nhrl_month##_weightclass' (e.g., 'nhrl_june25_30lb' for June 2025 30lb tournament). Required for get_tournament_matches and get_match_review_url.",
+					"description": "BrettZone tournament identifier for tournament operations. Format is typically 'nhrl_month##_weightclass' (e.g., 'nhrl_june25_30lb' for June 2025 30lb tournament). Required by all tournament-scoped operations (see each operation's description); optional for get_closest_fights, list_win_methods, and get_record_vs_bot_type, and accepted in place of tournament_ids by the multi-event operations.",
+				},
+				"tournament_ids": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "BrettZone tournament identifiers to scan for find_bot_live, get_record_by_cage, get_attrition, or get_weekly_digest (max 10), e.g. all events running this weekend",
 				},
 				"game_id": map[string]interface{}{
 					"type":        "string",
-					"description": "Match/Game identifier within a tournament for video review. Examples: 'W-5' (winners bracket match 5), 'Q1-12' (qualifying round 1 match 12), 'GF' (grand finals). Required for get_match_review_url.",
+					"description": "Match/Game identifier within a tournament for video review. Examples: 'W-5' (winners bracket match 5), 'Q1-12' (qualifying round 1 match 12), 'GF' (grand finals). Required for get_match_review_url and get_match_timeline.",
 				},
 				"cage_number": map[string]interface{}{
 					"type":        "number",
@@ -212,6 +503,75 @@ IMPORTANT: Use "Active" when you want current rankings, not "all-time"!`,
 					"type":        "string",
 					"description": "NHRL qualification round code to get detailed information about. Options: 'Q1' (Opening round), 'Q2W' (The Cusp - for Q1 winners), 'Q2L' (Redemption - for Q1 losers), 'Q3' (Bubble - final qualifying round).",
 				},
+				"entrant_count": map[string]interface{}{
+					"type":        "number",
+					"description": "Number of entrants to plan for (required for plan_bracket)",
+				},
+				"format": map[string]interface{}{
+					"type":        "string",
+					"description": "Event format for plan_bracket: 'qualifiers' (NHRL Q1/Q2/Q3 qualifying into the bracket, default) or 'direct' (every entrant goes straight into the bracket)",
+					"enum":        []string{"qualifiers", "direct"},
+				},
+				"annotation": map[string]interface{}{
+					"type":        "string",
+					"description": "Win annotation text to search for (required for search_by_annotation). Matched as a case-insensitive substring unless use_regex is true.",
+				},
+				"use_regex": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Treat annotation as a case-insensitive regular expression (max 200 characters). Defaults to false.",
+				},
+				"min_secs": map[string]interface{}{
+					"type":        "number",
+					"description": "Minimum match length in seconds for search_by_duration (inclusive)",
+				},
+				"max_secs": map[string]interface{}{
+					"type":        "number",
+					"description": "Maximum match length in seconds for search_by_duration (inclusive)",
+				},
+				"streak": map[string]interface{}{
+					"type":        "string",
+					"description": "Which streak get_streak_composition breaks down: 'current' (default), 'longest_win', or 'longest_loss'",
+					"enum":        []string{"current", "longest_win", "longest_loss"},
+				},
+				"top_n": map[string]interface{}{
+					"type":        "integer",
+					"description": "Rank cutoff for get_record_vs_top_n: only opponents currently ranked within the top N of the weight class count. Defaults to 10.",
+				},
+				"from": map[string]interface{}{
+					"type":        "string",
+					"description": "Start of the get_weekly_digest window when no tournament_ids are given (YYYY-MM-DD, RFC 3339, or Unix seconds). Defaults to 7 days before to.",
+				},
+				"to": map[string]interface{}{
+					"type":        "string",
+					"description": "End of the get_weekly_digest window (YYYY-MM-DD, RFC 3339, or Unix seconds). Defaults to now.",
+				},
+				"event_type": map[string]interface{}{
+					"type":        "string",
+					"description": "Filter for get_bot_fights, export_fights and get_bot_event_participants. Events are classified by name: 'Championship', 'World' or 'Finals' = championship; 'Qualifier' = qualifier; 'Invitational', 'Exhibition', 'Showcase' or 'Special' = special; anything else = monthly. A fight's event is the BrettZone match its video links to; fights without one cannot be classified and are left out (get_bot_fights and get_bot_event_participants report them as unresolved_fights).",
+					"enum":        eventTypes,
+				},
+				"group_by": map[string]interface{}{
+					"type":        "string",
+					"description": "Grouping for get_bots_by_region: 'state' (default) or 'country'",
+					"enum":        []string{"state", "country"},
+				},
+				"output_format": map[string]interface{}{
+					"type":        "string",
+					"description": "Output format for get_results_summary: markdown (default), text (plain, tweet-sized), or json",
+					"enum":        []string{"markdown", "text", "json"},
+				},
+				"min_gap_seconds": map[string]interface{}{
+					"type":        "number",
+					"description": "Ignore idle gaps shorter than this many seconds (used with find_idle_gaps). Defaults to 60.",
+				},
+				"since": map[string]interface{}{
+					"type":        "string",
+					"description": "Only include matches that ended at or after this time (used with get_recent_results). Accepts Unix seconds or 'YYYY-MM-DD HH:MM:SS'.",
+				},
+				"group_by_event": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Group results by tournament/event with event names and dates (used with get_bot_videos). Defaults to false.",
+				},
 				"limit": map[string]interface{}{
 					"type":        "number",
 					"description": "Maximum number of results to return. Defaults to 25. Use with offset for pagination. Applicable to operations that return lists of data (weight class stats, fight history, etc.).",
@@ -273,10 +633,24 @@ func getNHRLBotFightsTool(args map[string]interface{}) (string, error) {
 		offset = int(o)
 	}
 
-	fights, err := getNHRLFights(botName)
+	eventType, err := eventTypeArg(args)
+	if err != nil {
+		return "", err
+	}
+
+	// Only the event_type filter needs each fight's BrettZone event
+	fetchFights := getNHRLFights
+	if eventType != "" {
+		fetchFights = getNHRLFightsResolved
+	}
+	fights, err := fetchFights(botName)
 	if err != nil {
 		return "", fmt.Errorf("failed to get bot fights: %w", err)
 	}
+	unresolved := 0
+	if eventType != "" {
+		fights, unresolved = filterFightsByEventType(fights, eventType)
+	}
 
 	// Apply pagination
 	paginatedFights, metadata := paginateSlice(fights, limit, offset)
@@ -287,6 +661,10 @@ func getNHRLBotFightsTool(args map[string]interface{}) (string, error) {
 		"fights":      paginatedFights,
 		"pagination":  metadata,
 	}
+	if eventType != "" {
+		result["event_type"] = eventType
+		result["unresolved_fights"] = unresolved
+	}
 
 	jsonData, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
@@ -296,6 +674,51 @@ func getNHRLBotFightsTool(args map[string]interface{}) (string, error) {
 	return string(jsonData), nil
 }
 
+// Export a bot's full fight history as CSV
+func getNHRLExportFightsTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok {
+		return "", fmt.Errorf("bot_name is required for export_fights operation")
+	}
+
+	eventType, err := eventTypeArg(args)
+	if err != nil {
+		return "", err
+	}
+
+	fights, err := getNHRLFightsResolved(botName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot fights: %w", err)
+	}
+	if eventType != "" {
+		// CSV has nowhere to report them; get_bot_fights counts the fights left out
+		fights, _ = filterFightsByEventType(fights, eventType)
+	}
+
+	headers := []string{"date", "round", "opponent", "result", "method", "length_secs", "video_link"}
+	rows := make([][]string, 0, len(fights))
+	for _, fight := range fights {
+		length, videoLink := "", ""
+		if fight.FightLengthSecs != nil {
+			length = *fight.FightLengthSecs
+		}
+		if fight.VideoLink != nil {
+			videoLink = *fight.VideoLink
+		}
+		rows = append(rows, []string{
+			fight.Date,
+			fight.Round,
+			fight.OpponentName,
+			fightOutcome(fight),
+			fight.ResultBy,
+			length,
+			videoLink,
+		})
+	}
+
+	return csvTable(headers, rows)
+}
+
 // Get bot head-to-head records
 func getNHRLBotHeadToHeadTool(args map[string]interface{}) (string, error) {
 	botName, ok := args["bot_name"].(string)
@@ -337,6 +760,64 @@ func getNHRLBotHeadToHeadTool(args map[string]interface{}) (string, error) {
 	return string(jsonData), nil
 }
 
+// List every distinct opponent a bot has faced, alphabetically, with meeting counts
+func getNHRLListOpponentsTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok {
+		return "", fmt.Errorf("bot_name is required for list_opponents operation")
+	}
+
+	headToHead, err := getNHRLHeadToHeadCached(botName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot head-to-head: %w", err)
+	}
+
+	type opponent struct {
+		Name     string `json:"name"`
+		Meetings int    `json:"meetings"`
+	}
+
+	// Merge entries that differ only in case or spacing
+	byKey := make(map[string]*opponent)
+	var opponents []*opponent
+	for _, record := range headToHead {
+		if record.OpponentUniqueName == "" {
+			continue
+		}
+		key := strings.ToLower(normalizeBotName(record.OpponentUniqueName))
+		if existing, ok := byKey[key]; ok {
+			existing.Meetings += record.NumFights
+			continue
+		}
+		entry := &opponent{Name: record.OpponentUniqueName, Meetings: record.NumFights}
+		byKey[key] = entry
+		opponents = append(opponents, entry)
+	}
+
+	sort.SliceStable(opponents, func(i, j int) bool {
+		return strings.ToLower(opponents[i].Name) < strings.ToLower(opponents[j].Name)
+	})
+
+	totalMeetings := 0
+	for _, entry := range opponents {
+		totalMeetings += entry.Meetings
+	}
+
+	result := map[string]interface{}{
+		"bot_name":       botName,
+		"opponent_count": len(opponents),
+		"total_meetings": totalMeetings,
+		"opponents":      opponents,
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
 // Get bot stats by season
 func getNHRLBotStatsBySeasonTool(args map[string]interface{}) (string, error) {
 	botName, ok := args["bot_name"].(string)
@@ -373,6 +854,87 @@ func getNHRLBotStatsBySeasonTool(args map[string]interface{}) (string, error) {
 	return string(jsonData), nil
 }
 
+// Get a bot's all-time stats side by side with one season's, with deltas
+func getNHRLBotCareerVsSeasonTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok {
+		return "", fmt.Errorf("bot_name is required for get_bot_career_vs_season operation")
+	}
+
+	season := "Active"
+	if s, ok := args["season"].(string); ok && s != "" {
+		season = s
+	}
+
+	career, err := getNHRLStatsBySeason(botName, getSeasonID("all-time"))
+	if err != nil {
+		return "", fmt.Errorf("failed to get all-time stats: %w", err)
+	}
+	if career == nil {
+		return "", fmt.Errorf("no stats found for %s", botName)
+	}
+
+	seasonStats, err := getNHRLStatsBySeason(botName, getSeasonID(season))
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s season stats: %w", season, err)
+	}
+
+	// Rates are derived from the counts so both sides are computed the same way
+	rates := func(stats *NHRLBotStatsBySeason) map[string]interface{} {
+		avgFightSecs, _ := strconv.ParseFloat(strings.TrimSpace(stats.AvgFightTimeSecs), 64)
+		return map[string]interface{}{
+			"events":          stats.Events,
+			"fights":          stats.Fights,
+			"wins":            stats.W,
+			"losses":          stats.L,
+			"kos":             stats.KOs,
+			"kod":             stats.KOd,
+			"win_pct":         math.Round(ratio(stats.W, stats.W+stats.L)*1000) / 10,
+			"ko_pct_of_wins":  math.Round(ratio(stats.KOs, stats.W)*1000) / 10,
+			"kod_pct_of_loss": math.Round(ratio(stats.KOd, stats.L)*1000) / 10,
+			"avg_fight_secs":  math.Round(avgFightSecs*10) / 10,
+		}
+	}
+
+	careerRates := rates(career)
+	result := map[string]interface{}{
+		"bot_name": botName,
+		"season":   season,
+		"all_time": careerRates,
+	}
+
+	if seasonStats == nil || seasonStats.Fights == 0 {
+		result["season_stats"] = nil
+		result["message"] = fmt.Sprintf("No %s season fights found for this bot", season)
+	} else {
+		seasonRates := rates(seasonStats)
+		deltas := make(map[string]interface{})
+		for _, key := range []string{"win_pct", "ko_pct_of_wins", "kod_pct_of_loss", "avg_fight_secs"} {
+			deltas[key] = math.Round((seasonRates[key].(float64)-careerRates[key].(float64))*10) / 10
+		}
+		result["season_stats"] = seasonRates
+		result["deltas"] = deltas
+		result["season_share_of_career_fights"] = math.Round(ratio(seasonStats.Fights, career.Fights)*1000) / 10
+
+		trend := "in line with"
+		switch delta := deltas["win_pct"].(float64); {
+		case delta >= 5:
+			trend = "ahead of"
+		case delta <= -5:
+			trend = "behind"
+		}
+		result["summary"] = fmt.Sprintf("%s is %d-%d in the %s season (%.1f%%), %s its %.1f%% career win rate", botName, seasonStats.W, seasonStats.L, season, seasonRates["win_pct"], trend, careerRates["win_pct"])
+	}
+	result["note"] = "Deltas are season minus all-time; percentages are in points"
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
 // Get bot streak stats
 func getNHRLBotStreakStatsTool(args map[string]interface{}) (string, error) {
 	botName, ok := args["bot_name"].(string)
@@ -420,20 +982,53 @@ func getNHRLBotEventParticipantsTool(args map[string]interface{}) (string, error
 		offset = int(o)
 	}
 
-	participants, err := getNHRLEventParticipants(botName)
+	eventType, err := eventTypeArg(args)
 	if err != nil {
-		return "", fmt.Errorf("failed to get bot event participants: %w", err)
+		return "", err
 	}
 
-	// Apply pagination
-	paginatedParticipants, metadata := paginateSlice(participants, limit, offset)
+	var paginatedParticipants interface{}
+	var eventCount, unresolved int
+	var metadata map[string]interface{}
+	if eventType == "" {
+		participants, err := getNHRLEventParticipants(botName)
+		if err != nil {
+			return "", fmt.Errorf("failed to get bot event participants: %w", err)
+		}
+		page, pageMetadata := paginateSlice(participants, limit, offset)
+		paginatedParticipants, eventCount, metadata = page, len(page), pageMetadata
+	} else {
+		// The participation rows carry no event name, so filtering works from
+		// the BrettZone events in the bot's fight history instead
+		events, unlinked, err := getNHRLBotEvents(botName)
+		if err != nil {
+			return "", fmt.Errorf("failed to get bot events: %w", err)
+		}
+		unresolved = unlinked
+		filtered := []NHRLBotEvent{}
+		for _, event := range events {
+			if event.EventName == "" {
+				unresolved += len(event.Fights)
+				continue
+			}
+			if classifyEventType(event.EventName) == eventType {
+				filtered = append(filtered, event)
+			}
+		}
+		page, pageMetadata := paginateSlice(filtered, limit, offset)
+		paginatedParticipants, eventCount, metadata = page, len(page), pageMetadata
+	}
 
 	result := map[string]interface{}{
 		"bot_name":    botName,
-		"event_count": len(paginatedParticipants),
+		"event_count": eventCount,
 		"events":      paginatedParticipants,
 		"pagination":  metadata,
 	}
+	if eventType != "" {
+		result["event_type"] = eventType
+		result["unresolved_fights"] = unresolved
+	}
 
 	jsonData, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
@@ -485,6 +1080,143 @@ func getNHRLWeightClassDumpsterCountTool(args map[string]interface{}) (string, e
 	return string(jsonData), nil
 }
 
+// Get the chronological list of event champions in a weight class, flagging
+// first-time winners and keeping a running title count per bot
+func getNHRLChampionshipLineageTool(args map[string]interface{}) (string, error) {
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+
+	eventWinners, err := getNHRLEventWinners(weightClass)
+	if err != nil {
+		return "", fmt.Errorf("failed to get weight class event winners: %w", err)
+	}
+
+	lineage, titles, names := buildChampionshipLineage(eventWinners)
+
+	// Title leaders, most titles first
+	leaders := make([]map[string]interface{}, 0, len(titles))
+	for key, count := range titles {
+		leaders = append(leaders, map[string]interface{}{
+			"bot":    names[key],
+			"titles": count,
+		})
+	}
+	sort.SliceStable(leaders, func(i, j int) bool {
+		if leaders[i]["titles"].(int) != leaders[j]["titles"].(int) {
+			return leaders[i]["titles"].(int) > leaders[j]["titles"].(int)
+		}
+		return strings.ToLower(leaders[i]["bot"].(string)) < strings.ToLower(leaders[j]["bot"].(string))
+	})
+
+	result := map[string]interface{}{
+		"weight_class":       weightClass,
+		"event_count":        len(lineage),
+		"distinct_champions": len(titles),
+		"lineage":            lineage,
+		"title_leaders":      leaders,
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// buildChampionshipLineage orders event winners oldest first and annotates each
+// title with the champion's running title count. It also returns each
+// champion's total titles and display name, keyed by normalized bot name.
+func buildChampionshipLineage(eventWinners []NHRLEventWinner) ([]map[string]interface{}, map[string]int, map[string]string) {
+	// Oldest first; undated events keep their original relative order at the end
+	sort.SliceStable(eventWinners, func(i, j int) bool {
+		dateI, okI := parseStatsbookDate(eventWinners[i].EventDate)
+		dateJ, okJ := parseStatsbookDate(eventWinners[j].EventDate)
+		if okI != okJ {
+			return okI
+		}
+		return dateI.Before(dateJ)
+	})
+
+	titles := make(map[string]int)
+	names := make(map[string]string)
+	lineage := make([]map[string]interface{}, 0, len(eventWinners))
+	for _, event := range eventWinners {
+		champion := strings.TrimSpace(event.FirstPlaceName)
+		if champion == "" {
+			continue
+		}
+		key := strings.ToLower(normalizeBotName(champion))
+		titles[key]++
+		names[key] = champion
+
+		lineage = append(lineage, map[string]interface{}{
+			"event_date":     event.EventDate,
+			"champion":       champion,
+			"runner_up":      event.SecondPlaceName,
+			"first_title":    titles[key] == 1,
+			"title_count":    titles[key],
+			"defended_title": len(lineage) > 0 && botNamesMatch(lineage[len(lineage)-1]["champion"].(string), champion),
+		})
+	}
+
+	return lineage, titles, names
+}
+
+// Get the bots that won their first-ever title most recently
+func getNHRLFirstTimeWinnersTool(args map[string]interface{}) (string, error) {
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+
+	limit := 25
+	if l, ok := args["limit"].(float64); ok && l > 0 {
+		limit = int(l)
+	}
+
+	eventWinners, err := getNHRLEventWinners(weightClass)
+	if err != nil {
+		return "", fmt.Errorf("failed to get weight class event winners: %w", err)
+	}
+
+	lineage, titles, _ := buildChampionshipLineage(eventWinners)
+
+	// Walk newest first so the most recent breakouts come first
+	breakouts := make([]map[string]interface{}, 0, limit)
+	for i := len(lineage) - 1; i >= 0 && len(breakouts) < limit; i-- {
+		entry := lineage[i]
+		if !entry["first_title"].(bool) {
+			continue
+		}
+		champion := entry["champion"].(string)
+		breakouts = append(breakouts, map[string]interface{}{
+			"bot":              champion,
+			"first_title_date": entry["event_date"],
+			"runner_up":        entry["runner_up"],
+			"events_since":     len(lineage) - 1 - i,
+			"titles_to_date":   titles[strings.ToLower(normalizeBotName(champion))],
+		})
+	}
+
+	result := map[string]interface{}{
+		"weight_class":       weightClass,
+		"event_count":        len(lineage),
+		"distinct_champions": len(titles),
+		"first_time_winners": breakouts,
+		"note":               "events_since counts later events in this class; titles_to_date includes titles won after the first",
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
 // Get weight class event winners
 func getNHRLWeightClassEventWinnersTool(args map[string]interface{}) (string, error) {
 	weightClass := "3lb"
@@ -640,16 +1372,33 @@ func getNHRLWeightClassStatSummaryTool(args map[string]interface{}) (string, err
 		return "", fmt.Errorf("failed to get weight class stat summary: %w", err)
 	}
 
-	// Apply pagination
-	paginatedStats, metadata := paginateSlice(statSummary, limit, offset)
+	// Apply pagination, to the rows sorted on a raw or derived field when sort_by is given
+	sortBy, _ := args["sort_by"].(string)
+	var paginatedStats interface{}
+	var botCount int
+	var metadata map[string]interface{}
+	if sortBy != "" {
+		sorted, err := sortStatSummary(statSummary, sortBy)
+		if err != nil {
+			return "", err
+		}
+		page, pageMetadata := paginateSlice(sorted, limit, offset)
+		paginatedStats, botCount, metadata = page, len(page), pageMetadata
+	} else {
+		page, pageMetadata := paginateSlice(statSummary, limit, offset)
+		paginatedStats, botCount, metadata = page, len(page), pageMetadata
+	}
 
 	result := map[string]interface{}{
 		"weight_class": weightClass,
 		"season":       season,
-		"bot_count":    len(paginatedStats),
+		"bot_count":    botCount,
 		"stats":        paginatedStats,
 		"pagination":   metadata,
 	}
+	if sortBy != "" {
+		result["sort_by"] = sortBy
+	}
 
 	jsonData, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
@@ -659,40 +1408,48 @@ func getNHRLWeightClassStatSummaryTool(args map[string]interface{}) (string, err
 	return string(jsonData), nil
 }
 
-// Get weight class stat summary simple (all-time stats with correct ranking)
-func getNHRLWeightClassStatSummarySimpleTool(args map[string]interface{}) (string, error) {
+// Get a single bot's row from the weight class stat summary
+func getNHRLBotClassStandingTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok || botName == "" {
+		return "", fmt.Errorf("bot_name is required for get_bot_class_standing operation")
+	}
+
 	weightClass := "3lb"
 	if wc, ok := args["weight_class"].(string); ok {
 		weightClass = wc
 	}
 	categoryID := getWeightClassCategoryID(weightClass)
 
-	// Get pagination parameters
-	limit := 25
-	if l, ok := args["limit"].(float64); ok {
-		limit = int(l)
-	}
-
-	offset := 0
-	if o, ok := args["offset"].(float64); ok {
-		offset = int(o)
+	season := "Active"
+	if s, ok := args["season"].(string); ok {
+		season = s
 	}
+	seasonID := getSeasonID(season)
 
-	statSummary, err := getNHRLStatSummarySimple(categoryID)
+	statSummary, err := getNHRLStatSummary(categoryID, seasonID)
 	if err != nil {
-		return "", fmt.Errorf("failed to get weight class stat summary simple: %w", err)
+		return "", fmt.Errorf("failed to get weight class stat summary: %w", err)
 	}
 
-	// Apply pagination
-	paginatedStats, metadata := paginateSlice(statSummary, limit, offset)
-
 	result := map[string]interface{}{
+		"bot_name":     botName,
 		"weight_class": weightClass,
-		"season":       "all-time",
-		"bot_count":    len(paginatedStats),
-		"stats":        paginatedStats,
-		"pagination":   metadata,
-		"note":         "This endpoint provides all-time stats with correct ranking for the weight class",
+		"season":       season,
+		"class_size":   len(statSummary),
+	}
+
+	var standing *NHRLStatSummary
+	for i := range statSummary {
+		if botNamesMatch(statSummary[i].Bot, botName) {
+			standing = &statSummary[i]
+			break
+		}
+	}
+
+	result["standing"] = standing
+	if standing == nil {
+		result["message"] = fmt.Sprintf("Bot not found in the %s %s stat summary", weightClass, season)
 	}
 
 	jsonData, err := json.MarshalIndent(result, "", "  ")
@@ -703,15 +1460,5218 @@ func getNHRLWeightClassStatSummarySimpleTool(args map[string]interface{}) (strin
 	return string(jsonData), nil
 }
 
-// Get random fight
-func getNHRLRandomFightTool(args map[string]interface{}) (string, error) {
+// Metrics compared by get_vs_class_average, drawn from the stat summary sort keys
+var classAverageMetrics = []string{"win_pct", "ko_rate", "fights"}
+
+// Compare a bot's win %, KO rate, and fight count with its weight class's averages
+func getNHRLVsClassAverageTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok || botName == "" {
+		return "", fmt.Errorf("bot_name is required for get_vs_class_average operation")
+	}
+
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+
+	season := "Active"
+	if s, ok := args["season"].(string); ok {
+		season = s
+	}
+
+	statSummary, err := getNHRLStatSummary(getWeightClassCategoryID(weightClass), getSeasonID(season))
+	if err != nil {
+		return "", fmt.Errorf("failed to get weight class stat summary: %w", err)
+	}
+
+	// Bots that never fought would drag every average toward zero
+	var class []NHRLStatSummary
+	var bot *NHRLStatSummary
+	for i, stat := range statSummary {
+		if stat.Fights == 0 {
+			continue
+		}
+		class = append(class, stat)
+		if bot == nil && botNamesMatch(stat.Bot, botName) {
+			bot = &statSummary[i]
+		}
+	}
+	if bot == nil {
+		return "", fmt.Errorf("bot %s has no fights in the %s %s stat summary", botName, weightClass, season)
+	}
+
+	comparison := make(map[string]interface{}, len(classAverageMetrics))
+	for _, metric := range classAverageMetrics {
+		value := statSummarySortKeys[metric].value
+		botValue := value(*bot)
+
+		// Percentile is the share of the rest of the class the bot beats:
+		// 100 for the class leader, 0 for the bottom bot
+		total, below := 0.0, 0
+		for _, stat := range class {
+			v := value(stat)
+			total += v
+			if v < botValue {
+				below++
+			}
+		}
+		percentile := 100.0
+		if len(class) > 1 {
+			percentile = math.Round(float64(below)/float64(len(class)-1)*1000) / 10
+		}
+
+		average := total / float64(len(class))
+		scale := 1.0
+		if metric != "fights" {
+			// Rates are reported as percentages
+			scale = 100
+		}
+		comparison[metric] = map[string]interface{}{
+			"bot":           math.Round(botValue*scale*10) / 10,
+			"class_average": math.Round(average*scale*10) / 10,
+			"difference":    math.Round((botValue-average)*scale*10) / 10,
+			"percentile":    percentile,
+		}
+	}
+
+	winPct := comparison["win_pct"].(map[string]interface{})
+	result := map[string]interface{}{
+		"bot_name":     bot.Bot,
+		"weight_class": weightClass,
+		"season":       season,
+		"class_size":   len(class),
+		"comparison":   comparison,
+		"summary": fmt.Sprintf("%s's %.1f%% win rate is better than %.0f%% of %s bots (class average %.1f%%)",
+			bot.Bot, winPct["bot"], winPct["percentile"], weightClass, winPct["class_average"]),
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get a bot's head-to-head record against opponents currently ranked in the top N of its class
+func getNHRLRecordVsTopNTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok || botName == "" {
+		return "", fmt.Errorf("bot_name is required for get_record_vs_top_n operation")
+	}
+
+	topN := 10
+	if n, ok := args["top_n"].(float64); ok {
+		if n < 1 {
+			return "", fmt.Errorf("top_n must be at least 1")
+		}
+		topN = int(n)
+	}
+
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+
+	statSummary, err := getNHRLStatSummary(getWeightClassCategoryID(weightClass), getSeasonID("Active"))
+	if err != nil {
+		return "", fmt.Errorf("failed to get weight class stat summary: %w", err)
+	}
+
+	eliteRanks := make(map[string]int)
+	for _, stat := range statSummary {
+		if stat.Ranking > 0 && stat.Ranking <= topN {
+			eliteRanks[strings.ToLower(normalizeBotName(stat.Bot))] = stat.Ranking
+		}
+	}
+
+	headToHead, err := getNHRLHeadToHeadCached(botName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot head-to-head: %w", err)
+	}
+
+	type eliteRecord struct {
+		Opponent    string `json:"opponent"`
+		Rank        int    `json:"rank"`
+		Wins        int    `json:"wins"`
+		Losses      int    `json:"losses"`
+		KOs         int    `json:"kos"`
+		KOd         int    `json:"kod"`
+		LastMeeting string `json:"last_meeting"`
+	}
+
+	var records []eliteRecord
+	wins, losses, kos, kod := 0, 0, 0, 0
+	for _, record := range headToHead {
+		rank, ok := eliteRanks[strings.ToLower(normalizeBotName(record.OpponentUniqueName))]
+		if !ok {
+			continue
+		}
+		records = append(records, eliteRecord{
+			Opponent:    record.OpponentUniqueName,
+			Rank:        rank,
+			Wins:        record.Wins,
+			Losses:      record.Losses,
+			KOs:         record.KOs,
+			KOd:         record.KOd,
+			LastMeeting: record.LastMeeting,
+		})
+		wins += record.Wins
+		losses += record.Losses
+		kos += record.KOs
+		kod += record.KOd
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Rank < records[j].Rank
+	})
+
+	result := map[string]interface{}{
+		"bot_name":        botName,
+		"weight_class":    weightClass,
+		"top_n":           topN,
+		"opponents_faced": len(records),
+		"record": map[string]interface{}{
+			"wins":    wins,
+			"losses":  losses,
+			"kos":     kos,
+			"kod":     kod,
+			"win_pct": math.Round(ratio(wins, wins+losses)*1000) / 10,
+		},
+		"opponents": records,
+		"summary":   fmt.Sprintf("%s is %d-%d all-time against bots currently ranked in the %s top %d", botName, wins, losses, weightClass, topN),
+		"note":      "Opponent ranks are current Active season rankings, so the tier reflects who is elite now rather than when the fights happened",
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get the difference between a bot's Active and all-time rank in its weight class
+func getNHRLRankDeltaTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok || botName == "" {
+		return "", fmt.Errorf("bot_name is required for get_rank_delta operation")
+	}
+
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+	categoryID := getWeightClassCategoryID(weightClass)
+
+	// Find the bot's rank in a season's stat summary (0 if unranked or absent)
+	// and the number of ranked bots it is measured against
+	rankIn := func(season string) (int, int, error) {
+		statSummary, err := getNHRLStatSummary(categoryID, getSeasonID(season))
+		if err != nil {
+			return 0, 0, err
+		}
+		rank, ranked := 0, 0
+		for _, stat := range statSummary {
+			if stat.Ranking <= 0 {
+				continue
+			}
+			ranked++
+			if botNamesMatch(stat.Bot, botName) {
+				rank = stat.Ranking
+			}
+		}
+		return rank, ranked, nil
+	}
+
+	activeRank, activeSize, err := rankIn("Active")
+	if err != nil {
+		return "", fmt.Errorf("failed to get Active stat summary: %w", err)
+	}
+	allTimeRank, allTimeSize, err := rankIn("all-time")
+	if err != nil {
+		return "", fmt.Errorf("failed to get all-time stat summary: %w", err)
+	}
+
+	result := map[string]interface{}{
+		"bot_name":        botName,
+		"weight_class":    weightClass,
+		"active_rank":     activeRank,
+		"active_ranked":   activeSize,
+		"all_time_rank":   allTimeRank,
+		"all_time_ranked": allTimeSize,
+		"metric":          "Ranks are compared as the share of the ranked pool at or above the bot (top X%), since the all-time pool is much larger than the Active one; percentile_delta is in percentage points",
+	}
+
+	// The all-time pool holds every bot that ever ranked, so raw ranks aren't
+	// comparable across the two summaries; compare top-X% standings instead
+	topPercent := func(rank, size int) float64 {
+		return math.Round(1000*float64(rank)/float64(size)) / 10
+	}
+
+	// A positive delta means the bot stands better now than over its whole history
+	switch {
+	case activeRank == 0 && allTimeRank == 0:
+		result["percentile_delta"] = nil
+		result["interpretation"] = "Bot is not ranked in either the Active or all-time summary"
+	case activeRank == 0:
+		result["percentile_delta"] = nil
+		result["all_time_top_percent"] = topPercent(allTimeRank, allTimeSize)
+		result["interpretation"] = "Bot has an all-time rank but is not ranked in the Active season (inactive recently)"
+	case allTimeRank == 0:
+		result["percentile_delta"] = nil
+		result["active_top_percent"] = topPercent(activeRank, activeSize)
+		result["interpretation"] = "Bot is ranked in the Active season but has no all-time rank yet (newcomer)"
+	default:
+		activeTop := topPercent(activeRank, activeSize)
+		allTimeTop := topPercent(allTimeRank, allTimeSize)
+		delta := math.Round(10*(allTimeTop-activeTop)) / 10
+		result["active_top_percent"] = activeTop
+		result["all_time_top_percent"] = allTimeTop
+		result["percentile_delta"] = delta
+		switch {
+		case delta >= 10:
+			result["interpretation"] = fmt.Sprintf("Surging: top %.1f%% in the Active season versus top %.1f%% all-time", activeTop, allTimeTop)
+		case delta > 0:
+			result["interpretation"] = fmt.Sprintf("Improving: top %.1f%% in the Active season versus top %.1f%% all-time", activeTop, allTimeTop)
+		case delta == 0:
+			result["interpretation"] = fmt.Sprintf("Steady: top %.1f%% in both the Active season and all-time", activeTop)
+		case delta > -10:
+			result["interpretation"] = fmt.Sprintf("Slipping: top %.1f%% in the Active season versus top %.1f%% all-time", activeTop, allTimeTop)
+		default:
+			result["interpretation"] = fmt.Sprintf("Fading: top %.1f%% in the Active season versus top %.1f%% all-time", activeTop, allTimeTop)
+		}
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get Active-season rankings with parsed movement indicators
+func getNHRLActiveRankingsTool(args map[string]interface{}) (string, error) {
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+	categoryID := getWeightClassCategoryID(weightClass)
+
+	// Get pagination parameters
+	limit := 25
+	if l, ok := args["limit"].(float64); ok {
+		limit = int(l)
+	}
+
+	offset := 0
+	if o, ok := args["offset"].(float64); ok {
+		offset = int(o)
+	}
+
+	statSummary, err := getNHRLStatSummary(categoryID, getSeasonID("Active"))
+	if err != nil {
+		return "", fmt.Errorf("failed to get weight class stat summary: %w", err)
+	}
+
+	rankings := make([]map[string]interface{}, len(statSummary))
+	for i, stat := range statSummary {
+		rankings[i] = map[string]interface{}{
+			"ranking":     stat.Ranking,
+			"bot":         stat.Bot,
+			"points":      stat.Points,
+			"w":           stat.W,
+			"l":           stat.L,
+			"rank_change": stat.RankChange,
+			"movement":    parseRankChange(stat.RankChange),
+		}
+	}
+
+	// Apply pagination
+	paginatedRankings, metadata := paginateSlice(rankings, limit, offset)
+
+	result := map[string]interface{}{
+		"weight_class": weightClass,
+		"season":       "Active",
+		"bot_count":    len(paginatedRankings),
+		"total_bots":   len(rankings),
+		"rankings":     paginatedRankings,
+		"pagination":   metadata,
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Maximum number of bots whose head-to-head records are scanned for rivalries
+const maxRivalryScanBots = 30
+
+// Maximum number of concurrent head-to-head lookups get_rivalries makes
+const rivalryLookupConcurrency = 5
+
+// Get the most frequent (and closest) matchups within a weight class
+func getNHRLRivalriesTool(args map[string]interface{}) (string, error) {
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+	categoryID := getWeightClassCategoryID(weightClass)
+
+	season := "all-time"
+	if s, ok := args["season"].(string); ok {
+		season = s
+	}
+
+	limit := 25
+	if l, ok := args["limit"].(float64); ok && l > 0 {
+		limit = int(l)
+	}
+
+	statSummary, err := getNHRLStatSummary(categoryID, getSeasonID(season))
+	if err != nil {
+		return "", fmt.Errorf("failed to get weight class stat summary: %w", err)
+	}
+
+	// Cap the fan-out to the most active bots in the class
+	sort.SliceStable(statSummary, func(i, j int) bool {
+		return statSummary[i].Fights > statSummary[j].Fights
+	})
+	scanned := statSummary
+	if len(scanned) > maxRivalryScanBots {
+		scanned = scanned[:maxRivalryScanBots]
+	}
+
+	type rivalry struct {
+		bot1, bot2  string
+		bot1Wins    int
+		bot2Wins    int
+		meetings    int
+		lastMeeting string
+	}
+
+	// Head-to-head records are cached, so repeat calls for the same class are cheap
+	headToHeads := make([][]NHRLHeadToHead, len(scanned))
+	sem := make(chan struct{}, rivalryLookupConcurrency)
+	var wg sync.WaitGroup
+	for i, stat := range scanned {
+		wg.Add(1)
+		go func(i int, botName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			// A failed lookup leaves the bot's slot empty and it is skipped
+			headToHeads[i], _ = getNHRLHeadToHeadCached(botName)
+		}(i, stat.Bot)
+	}
+	wg.Wait()
+
+	rivalries := make(map[string]*rivalry)
+	for i, stat := range scanned {
+		for _, record := range headToHeads[i] {
+			meetings := record.Wins + record.Losses
+			if meetings < 2 {
+				continue
+			}
+
+			// Each pairing is seen from both sides; key it independent of order
+			a := strings.ToLower(normalizeBotName(stat.Bot))
+			b := strings.ToLower(normalizeBotName(record.OpponentUniqueName))
+			key := a + "|" + b
+			if b < a {
+				key = b + "|" + a
+			}
+			if _, exists := rivalries[key]; exists {
+				continue
+			}
+
+			rivalries[key] = &rivalry{
+				bot1:        stat.Bot,
+				bot2:        record.OpponentUniqueName,
+				bot1Wins:    record.Wins,
+				bot2Wins:    record.Losses,
+				meetings:    meetings,
+				lastMeeting: record.LastMeeting,
+			}
+		}
+	}
+
+	ranked := make([]*rivalry, 0, len(rivalries))
+	for _, r := range rivalries {
+		ranked = append(ranked, r)
+	}
+
+	// Most meetings first, then the closest records
+	abs := func(n int) int {
+		if n < 0 {
+			return -n
+		}
+		return n
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].meetings != ranked[j].meetings {
+			return ranked[i].meetings > ranked[j].meetings
+		}
+		mi := abs(ranked[i].bot1Wins - ranked[i].bot2Wins)
+		mj := abs(ranked[j].bot1Wins - ranked[j].bot2Wins)
+		if mi != mj {
+			return mi < mj
+		}
+		return ranked[i].bot1+ranked[i].bot2 < ranked[j].bot1+ranked[j].bot2
+	})
+
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	results := make([]map[string]interface{}, len(ranked))
+	for i, r := range ranked {
+		results[i] = map[string]interface{}{
+			"bot1":         r.bot1,
+			"bot2":         r.bot2,
+			"meetings":     r.meetings,
+			"bot1_wins":    r.bot1Wins,
+			"bot2_wins":    r.bot2Wins,
+			"record":       fmt.Sprintf("%d-%d", r.bot1Wins, r.bot2Wins),
+			"last_meeting": r.lastMeeting,
+		}
+	}
+
+	result := map[string]interface{}{
+		"weight_class":  weightClass,
+		"scan_season":   season,
+		"bots_scanned":  len(scanned),
+		"rivalry_count": len(results),
+		"rivalries":     results,
+		"note":          "Meetings and records are all-time head-to-head totals; season only picks which bots are scanned",
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Maximum number of concurrent statsbook fight history requests get_giant_killer makes
+const giantKillerConcurrency = 5
+
+// giantKillerRankKey orders ranks for the giant killer leaderboard, placing
+// unranked bots (rank 0) below every ranked one
+func giantKillerRankKey(rank int) int {
+	if rank <= 0 {
+		return math.MaxInt32
+	}
+	return rank
+}
+
+// Get the bot with the most wins over higher-ranked opponents in a class/season.
+// The ranking snapshot is the class stat summary for the requested season
+// (default Active, i.e. current rankings); a win counts as an upset when the
+// opponent is ranked better than the winner in that snapshot, and any win over
+// a ranked opponent counts for an unranked bot. Wins come from the scanned bots'
+// statsbook fight histories, limited to fights dated within the season.
+func getNHRLGiantKillerTool(args map[string]interface{}) (string, error) {
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+	categoryID := getWeightClassCategoryID(weightClass)
+
+	season := "Active"
+	if s, ok := args["season"].(string); ok {
+		season = s
+	}
+
+	limit := 25
+	if l, ok := args["limit"].(float64); ok && l > 0 {
+		limit = int(l)
+	}
+
+	statSummary, err := getNHRLStatSummary(categoryID, getSeasonID(season))
+	if err != nil {
+		return "", fmt.Errorf("failed to get weight class stat summary: %w", err)
+	}
+
+	rankings := make(map[string]int, len(statSummary))
+	for _, stat := range statSummary {
+		if stat.Ranking > 0 {
+			rankings[strings.ToLower(normalizeBotName(stat.Bot))] = stat.Ranking
+		}
+	}
+
+	// Only bots with wins can score upsets; scan the most active ones
+	var candidates []NHRLStatSummary
+	for _, stat := range statSummary {
+		if stat.W > 0 {
+			candidates = append(candidates, stat)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Fights > candidates[j].Fights
+	})
+	if len(candidates) > maxRivalryScanBots {
+		candidates = candidates[:maxRivalryScanBots]
+	}
+
+	// Upsets are counted from each candidate's fights dated within the season,
+	// so wins from earlier seasons over bots that only rank highly now don't count
+	histories := make([][]NHRLFight, len(candidates))
+	sem := make(chan struct{}, giantKillerConcurrency)
+	var wg sync.WaitGroup
+	for i, stat := range candidates {
+		wg.Add(1)
+		go func(i int, botName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if fights, err := getNHRLFightsResolved(botName); err == nil {
+				histories[i] = fights
+			}
+		}(i, stat.Bot)
+	}
+	wg.Wait()
+
+	killers := make([]map[string]interface{}, 0)
+	for i, stat := range candidates {
+		upsetWins := 0
+		winsByOpponent := make(map[string]int)
+		var opponents []string
+		for _, fight := range histories[i] {
+			if fightOutcome(fight) != "win" {
+				continue
+			}
+			date, ok := parseStatsbookDate(fight.Date)
+			if !ok || !seasonIncludesDate(season, date) {
+				continue
+			}
+			opponentKey := strings.ToLower(normalizeBotName(fight.OpponentName))
+			opponentRank, ok := rankings[opponentKey]
+			if !ok || (stat.Ranking > 0 && opponentRank >= stat.Ranking) {
+				continue
+			}
+			upsetWins++
+			if winsByOpponent[fight.OpponentName] == 0 {
+				opponents = append(opponents, fight.OpponentName)
+			}
+			winsByOpponent[fight.OpponentName]++
+		}
+
+		var victims []map[string]interface{}
+		for _, opponent := range opponents {
+			victims = append(victims, map[string]interface{}{
+				"opponent":      opponent,
+				"opponent_rank": rankings[strings.ToLower(normalizeBotName(opponent))],
+				"wins":          winsByOpponent[opponent],
+			})
+		}
+
+		if upsetWins > 0 {
+			killers = append(killers, map[string]interface{}{
+				"bot":        stat.Bot,
+				"rank":       stat.Ranking,
+				"upset_wins": upsetWins,
+				"victims":    victims,
+			})
+		}
+	}
+
+	sort.SliceStable(killers, func(i, j int) bool {
+		if killers[i]["upset_wins"].(int) != killers[j]["upset_wins"].(int) {
+			return killers[i]["upset_wins"].(int) > killers[j]["upset_wins"].(int)
+		}
+		// A lower-ranked giant killer is the bigger story, an unranked one most of all
+		return giantKillerRankKey(killers[i]["rank"].(int)) > giantKillerRankKey(killers[j]["rank"].(int))
+	})
+
+	if len(killers) > limit {
+		killers = killers[:limit]
+	}
+
+	result := map[string]interface{}{
+		"weight_class":     weightClass,
+		"season":           season,
+		"ranking_snapshot": fmt.Sprintf("%s season stat summary rankings", season),
+		"bots_scanned":     len(candidates),
+		"leaderboard":      killers,
+	}
+	if len(killers) > 0 {
+		result["giant_killer"] = killers[0]
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get the cached list of bot names in a weight class for autocomplete
+func getNHRLRosterTool(args map[string]interface{}) (string, error) {
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+	categoryID := getWeightClassCategoryID(weightClass)
+
+	roster, cached, err := getNHRLRoster(categoryID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get weight class roster: %w", err)
+	}
+
+	result := map[string]interface{}{
+		"weight_class": weightClass,
+		"bot_count":    len(roster),
+		"bots":         roster,
+		"cached":       cached,
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Maximum number of bots whose hometown is looked up for get_bots_by_region
+const maxRegionLookups = 60
+
+// Group a weight class's active bots by their driver's home state or country
+func getNHRLBotsByRegionTool(args map[string]interface{}) (string, error) {
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+
+	groupBy := "state"
+	if g, ok := args["group_by"].(string); ok && g != "" {
+		groupBy = strings.ToLower(g)
+	}
+	if groupBy != "state" && groupBy != "country" {
+		return "", fmt.Errorf("unsupported group_by: %s (supported: state, country)", groupBy)
+	}
+
+	statSummary, err := getNHRLStatSummary(getWeightClassCategoryID(weightClass), getSeasonID("Active"))
+	if err != nil {
+		return "", fmt.Errorf("failed to get weight class stat summary: %w", err)
+	}
+
+	// Look up the best-ranked bots first so the cap drops the least relevant
+	sort.SliceStable(statSummary, func(i, j int) bool {
+		a, b := statSummary[i].Ranking, statSummary[j].Ranking
+		if (a > 0) != (b > 0) {
+			return a > 0
+		}
+		return a < b
+	})
+
+	botNames := make([]string, 0, len(statSummary))
+	for _, stat := range statSummary {
+		botNames = append(botNames, stat.Bot)
+	}
+	if len(botNames) > maxRegionLookups {
+		botNames = botNames[:maxRegionLookups]
+	}
+
+	profiles := getNHRLBotProfilesCached(botNames)
+
+	type regionBot struct {
+		Bot    string `json:"bot"`
+		Driver string `json:"driver,omitempty"`
+		City   string `json:"city,omitempty"`
+	}
+	regions := make(map[string][]regionBot)
+	for _, botName := range botNames {
+		profile, ok := profiles[botName]
+		region := "Unknown"
+		if ok {
+			state, country := strings.TrimSpace(profile.StateProvince), strings.TrimSpace(profile.Country)
+			switch {
+			case groupBy == "country" && country != "":
+				region = country
+			case groupBy == "state" && state != "" && country != "":
+				region = state + ", " + country
+			case groupBy == "state" && state != "":
+				region = state
+			case groupBy == "state" && country != "":
+				region = country
+			}
+		}
+		regions[region] = append(regions[region], regionBot{
+			Bot:    botName,
+			Driver: profile.DriverName,
+			City:   strings.TrimSpace(profile.City),
+		})
+	}
+
+	// Largest regions first, with Unknown always last
+	groups := make([]map[string]interface{}, 0, len(regions))
+	for region, bots := range regions {
+		groups = append(groups, map[string]interface{}{
+			"region":    region,
+			"bot_count": len(bots),
+			"bots":      bots,
+		})
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		ri, rj := groups[i]["region"].(string), groups[j]["region"].(string)
+		if (ri == "Unknown") != (rj == "Unknown") {
+			return rj == "Unknown"
+		}
+		if groups[i]["bot_count"].(int) != groups[j]["bot_count"].(int) {
+			return groups[i]["bot_count"].(int) > groups[j]["bot_count"].(int)
+		}
+		return ri < rj
+	})
+
+	result := map[string]interface{}{
+		"weight_class": weightClass,
+		"group_by":     groupBy,
+		"bots_checked": len(botNames),
+		"region_count": len(groups),
+		"regions":      groups,
+		"note":         "Hometowns come from driver profiles in NHRL live stats and are cached for 24 hours; bots without a profile are listed under Unknown",
+	}
+	if len(statSummary) > maxRegionLookups {
+		result["truncated"] = true
+		result["note"] = fmt.Sprintf("Only the top %d Active season bots were looked up. %s", maxRegionLookups, result["note"])
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// First season tracked by the statsbook; seasons after it are calendar years
+const firstNHRLSeason = "2018-2019"
+
+// seasonForDate maps a fight date to its statsbook season: calendar years from
+// 2020, with 2018 and 2019 forming the first season
+func seasonForDate(date time.Time) string {
+	if date.Year() < 2020 {
+		return firstNHRLSeason
+	}
+	return strconv.Itoa(date.Year())
+}
+
+// seasonIncludesDate reports whether a fight date falls in a statsbook season
+// as named by the season argument: the Active season spans the previous and
+// current calendar years, and All-time covers every date
+func seasonIncludesDate(season string, date time.Time) bool {
+	switch mapped := getSeasonID(season); mapped {
+	case "All-time":
+		return true
+	case "Active":
+		year := time.Now().Year()
+		return date.Year() == year || date.Year() == year-1
+	case "2018-19":
+		return seasonForDate(date) == firstNHRLSeason
+	default:
+		return seasonForDate(date) == mapped
+	}
+}
+
+// Get the average rank of a bot's opponents in each season it fought
+func getNHRLOpponentQualityTrendTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok {
+		return "", fmt.Errorf("bot_name is required for get_opponent_quality_trend operation")
+	}
+
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+	categoryID := getWeightClassCategoryID(weightClass)
+
+	fights, err := getNHRLFightsResolved(botName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot fights: %w", err)
+	}
+
+	fightsBySeason := make(map[string][]NHRLFight)
+	for _, fight := range fights {
+		date, ok := parseStatsbookDate(fight.Date)
+		if !ok || fight.OpponentName == "" {
+			continue
+		}
+		season := seasonForDate(date)
+		fightsBySeason[season] = append(fightsBySeason[season], fight)
+	}
+
+	seasons := make([]string, 0, len(fightsBySeason))
+	for season := range fightsBySeason {
+		seasons = append(seasons, season)
+	}
+	// "2018-2019" sorts before the calendar years
+	sort.Strings(seasons)
+
+	trend := make([]map[string]interface{}, 0, len(seasons))
+	var previousAvg float64
+	for _, season := range seasons {
+		entry := map[string]interface{}{
+			"season": season,
+			"fights": len(fightsBySeason[season]),
+		}
+
+		// Opponents are ranked against that season's standings
+		statSummary, err := getNHRLStatSummary(categoryID, getSeasonID(season))
+		if err != nil {
+			entry["error"] = err.Error()
+			trend = append(trend, entry)
+			continue
+		}
+		ranks := make(map[string]int, len(statSummary))
+		for _, stat := range statSummary {
+			if stat.Ranking > 0 {
+				ranks[strings.ToLower(normalizeBotName(stat.Bot))] = stat.Ranking
+			}
+		}
+
+		rankSum, ranked, wins := 0, 0, 0
+		for _, fight := range fightsBySeason[season] {
+			if fightOutcome(fight) == "win" {
+				wins++
+			}
+			if rank, ok := ranks[strings.ToLower(normalizeBotName(fight.OpponentName))]; ok {
+				rankSum += rank
+				ranked++
+			}
+		}
+
+		entry["wins"] = wins
+		entry["ranked_opponents"] = ranked
+		if ranked > 0 {
+			avg := math.Round(float64(rankSum)/float64(ranked)*10) / 10
+			entry["avg_opponent_rank"] = avg
+			if previousAvg > 0 {
+				// A falling average rank means tougher opponents
+				entry["change"] = math.Round((avg-previousAvg)*10) / 10
+			}
+			previousAvg = avg
+		}
+
+		trend = append(trend, entry)
+	}
+
+	// Compare the first and last seasons with ranked opponents
+	var first, last float64
+	for _, entry := range trend {
+		if avg, ok := entry["avg_opponent_rank"].(float64); ok {
+			if first == 0 {
+				first = avg
+			}
+			last = avg
+		}
+	}
+	var direction string
+	switch {
+	case first == 0:
+		direction = "not enough ranked opponents to compare seasons"
+	case last < first:
+		direction = "climbing: the bot is facing higher-ranked opponents than in its early seasons"
+	case last > first:
+		direction = "easing: the bot is facing lower-ranked opponents than in its early seasons"
+	default:
+		direction = "steady: opponent quality has not changed"
+	}
+
+	result := map[string]interface{}{
+		"bot_name":     botName,
+		"weight_class": weightClass,
+		"season_count": len(trend),
+		"trend":        trend,
+		"direction":    direction,
+		"note":         "Opponent ranks come from each season's weight class standings; lower average rank means tougher opposition. Unranked opponents are left out of the average.",
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get the number of active bots and fights in a weight class for each season
+func getNHRLActivityTrendTool(args map[string]interface{}) (string, error) {
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+	categoryID := getWeightClassCategoryID(weightClass)
+
+	seasons := []string{firstNHRLSeason}
+	for year := 2020; year <= time.Now().Year(); year++ {
+		seasons = append(seasons, strconv.Itoa(year))
+	}
+
+	trend := make([]map[string]interface{}, 0, len(seasons))
+	var previousBots int
+	for _, season := range seasons {
+		entry := map[string]interface{}{
+			"season": season,
+		}
+
+		statSummary, err := getNHRLStatSummary(categoryID, getSeasonID(season))
+		if err != nil {
+			entry["error"] = err.Error()
+			trend = append(trend, entry)
+			continue
+		}
+
+		// Every fight appears once for each of its two bots
+		activeBots, botFights := 0, 0
+		for _, stat := range statSummary {
+			if stat.Fights > 0 {
+				activeBots++
+				botFights += stat.Fights
+			}
+		}
+
+		entry["active_bots"] = activeBots
+		entry["total_fights"] = botFights / 2
+		if previousBots > 0 {
+			entry["bot_growth_pct"] = math.Round(float64(activeBots-previousBots)/float64(previousBots)*1000) / 10
+		}
+		if activeBots > 0 {
+			previousBots = activeBots
+		}
+
+		trend = append(trend, entry)
+	}
+
+	result := map[string]interface{}{
+		"weight_class": weightClass,
+		"season_count": len(trend),
+		"trend":        trend,
+		"note":         "active_bots counts bots with at least one fight in the season; total_fights halves the summed per-bot fight counts since each fight involves two bots",
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// giniCoefficient measures how unevenly values are spread, from 0 (everyone
+// equal) to nearly 1 (one holder has everything)
+func giniCoefficient(values []int) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := append([]int(nil), values...)
+	sort.Ints(sorted)
+
+	// G = sum((2i - n - 1) * x_i) / (n * sum(x)) over ascending x, 1-based i
+	n := len(sorted)
+	var weighted, total float64
+	for i, v := range sorted {
+		weighted += float64(2*(i+1)-n-1) * float64(v)
+		total += float64(v)
+	}
+	if total == 0 {
+		return 0
+	}
+	return weighted / (float64(n) * total)
+}
+
+// Get how evenly wins are spread across a weight class in a season
+func getNHRLParityIndexTool(args map[string]interface{}) (string, error) {
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+	categoryID := getWeightClassCategoryID(weightClass)
+
+	season := "current"
+	if s, ok := args["season"].(string); ok {
+		season = s
+	}
+
+	statSummary, err := getNHRLStatSummary(categoryID, getSeasonID(season))
+	if err != nil {
+		return "", fmt.Errorf("failed to get weight class stat summary: %w", err)
+	}
+
+	// Only bots that fought this season; idle entries would inflate inequality
+	var wins []int
+	totalWins := 0
+	for _, stat := range statSummary {
+		if stat.Fights > 0 {
+			wins = append(wins, stat.W)
+			totalWins += stat.W
+		}
+	}
+	if len(wins) == 0 {
+		return "", fmt.Errorf("no fights recorded for %s in season %s", weightClass, season)
+	}
+
+	gini := giniCoefficient(wins)
+
+	// Share of all wins held by the top 10% of bots (at least one bot)
+	sort.Sort(sort.Reverse(sort.IntSlice(wins)))
+	topCount := (len(wins) + 9) / 10
+	topWins := 0
+	for _, w := range wins[:topCount] {
+		topWins += w
+	}
+
+	var interpretation string
+	switch {
+	case gini < 0.3:
+		interpretation = "Balanced: wins are spread evenly across the field"
+	case gini < 0.45:
+		interpretation = "Competitive: a group of contenders wins more often, but the field is open"
+	case gini < 0.6:
+		interpretation = "Top-heavy: a small set of bots takes a large share of the wins"
+	default:
+		interpretation = "Dominated: a few bots win most of the fights"
+	}
+
+	result := map[string]interface{}{
+		"weight_class":        weightClass,
+		"season":              season,
+		"active_bots":         len(wins),
+		"total_wins":          totalWins,
+		"parity_index":        math.Round(gini*1000) / 1000,
+		"top_10pct_bots":      topCount,
+		"top_10pct_win_share": math.Round(ratio(topWins, totalWins)*1000) / 10,
+		"interpretation":      interpretation,
+		"note":                "parity_index is the Gini coefficient of per-bot win counts among bots with at least one fight: 0 means perfectly even, values near 1 mean a few bots win almost everything",
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get the bots with the most fights in a weight class and season
+func getNHRLIronBotTool(args map[string]interface{}) (string, error) {
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+
+	season := "current"
+	if s, ok := args["season"].(string); ok {
+		season = s
+	}
+
+	limit := 25
+	if l, ok := args["limit"].(float64); ok && l > 0 {
+		limit = int(l)
+	}
+
+	statSummary, err := getNHRLStatSummary(getWeightClassCategoryID(weightClass), getSeasonID(season))
+	if err != nil {
+		return "", fmt.Errorf("failed to get weight class stat summary: %w", err)
+	}
+
+	var active []NHRLStatSummary
+	for _, stat := range statSummary {
+		if stat.Fights > 0 {
+			active = append(active, stat)
+		}
+	}
+	if len(active) == 0 {
+		return "", fmt.Errorf("no fights recorded for %s in season %s", weightClass, season)
+	}
+
+	// Most fights first; more events, then better rank, break ties
+	sort.SliceStable(active, func(i, j int) bool {
+		if active[i].Fights != active[j].Fights {
+			return active[i].Fights > active[j].Fights
+		}
+		if active[i].Events != active[j].Events {
+			return active[i].Events > active[j].Events
+		}
+		ri, rj := active[i].Ranking, active[j].Ranking
+		if (ri > 0) != (rj > 0) {
+			return ri > 0
+		}
+		return ri < rj
+	})
+
+	// Every bot sharing the top fight count shares the award, even past the limit
+	var ironBots []string
+	for _, stat := range active {
+		if stat.Fights == active[0].Fights {
+			ironBots = append(ironBots, stat.Bot)
+		}
+	}
+
+	if len(active) > limit {
+		active = active[:limit]
+	}
+	leaders := make([]map[string]interface{}, len(active))
+	for i, stat := range active {
+		leaders[i] = map[string]interface{}{
+			"position": i + 1,
+			"bot":      stat.Bot,
+			"fights":   stat.Fights,
+			"events":   stat.Events,
+			"wins":     stat.W,
+			"losses":   stat.L,
+			"ranking":  stat.Ranking,
+		}
+	}
+
+	result := map[string]interface{}{
+		"weight_class": weightClass,
+		"season":       season,
+		"iron_bot":     ironBots[0],
+		"most_fights":  active[0].Fights,
+		"top_bots":     leaders,
+	}
+	if len(ironBots) > 1 {
+		result["tied_iron_bots"] = ironBots
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Maximum number of bots whose fight history is scanned for get_career_length_stats
+const maxCareerLookups = 60
+
+// careerSpan is a bot's first and last dated statsbook fight
+type careerSpan struct {
+	First time.Time
+	Last  time.Time
+}
+
+// Get a bot's career span, served from the NHRL cache when fresh. ok is false
+// when the bot has no dated fights.
+func getNHRLCareerSpanCached(botName string) (careerSpan, bool, error) {
+	key := "career_span:" + strings.ToLower(normalizeBotName(botName))
+	if cached, ok := nhrlCache.get(key); ok {
+		span := cached.(careerSpan)
+		return span, !span.First.IsZero(), nil
+	}
+
+	fights, err := getNHRLFights(botName)
+	if err != nil {
+		return careerSpan{}, false, err
+	}
+
+	var span careerSpan
+	for _, fight := range fights {
+		date, ok := parseStatsbookDate(fight.Date)
+		if !ok {
+			continue
+		}
+		if span.First.IsZero() || date.Before(span.First) {
+			span.First = date
+		}
+		if span.Last.IsZero() || date.After(span.Last) {
+			span.Last = date
+		}
+	}
+
+	nhrlCache.set(key, span)
+	return span, !span.First.IsZero(), nil
+}
+
+// Build templated "did you know" facts about a bot or a weight class for broadcast filler
+func getNHRLFunFactsTool(args map[string]interface{}) (string, error) {
+	botName, _ := args["bot_name"].(string)
+	weightClass, _ := args["weight_class"].(string)
+	botName, weightClass = strings.TrimSpace(botName), strings.TrimSpace(weightClass)
+	if botName == "" && weightClass == "" {
+		return "", fmt.Errorf("bot_name or weight_class is required for get_fun_facts operation")
+	}
+
+	// Each fact comes from one stat helper; a helper that fails just drops its fact
+	type funFact struct {
+		Fact   string `json:"fact"`
+		Source string `json:"source"`
+	}
+	var facts []funFact
+	var unavailable []string
+	add := func(source, format string, a ...interface{}) {
+		facts = append(facts, funFact{Fact: fmt.Sprintf(format, a...), Source: source})
+	}
+
+	result := map[string]interface{}{}
+	if botName != "" {
+		result["bot_name"] = botName
+
+		if fights, err := getNHRLFightsResolved(botName); err != nil {
+			unavailable = append(unavailable, "get_bot_fights")
+		} else {
+			var debut *NHRLFight
+			var debutDate time.Time
+			var fastest *NHRLFight
+			fastestSecs := 0.0
+			for i, fight := range fights {
+				if date, ok := parseStatsbookDate(fight.Date); ok && (debut == nil || date.Before(debutDate)) {
+					debut, debutDate = &fights[i], date
+				}
+				if fightOutcome(fight) != "win" || fightMethod(fight.ResultBy) != "ko" || fight.FightLengthSecs == nil {
+					continue
+				}
+				if secs, err := strconv.ParseFloat(strings.TrimSpace(*fight.FightLengthSecs), 64); err == nil && secs > 0 && (fastest == nil || secs < fastestSecs) {
+					fastest, fastestSecs = &fights[i], secs
+				}
+			}
+			if debut != nil {
+				// The opponent is only known when the fight resolved to a BrettZone match
+				against := ""
+				if debut.OpponentName != "" {
+					against = " against " + debut.OpponentName
+				}
+				add("get_career_bookends", "%s made its NHRL debut on %s%s and has fought %d times since.",
+					botName, debutDate.Format("January 2, 2006"), against, len(fights)-1)
+			}
+			if fastest != nil {
+				add("get_bot_kos", "%s's fastest KO came in just %g seconds, against %s on %s.",
+					botName, fastestSecs, fastest.OpponentName, fastest.Date)
+			}
+		}
+
+		if streaks, err := getNHRLStreakStats(botName); err != nil {
+			unavailable = append(unavailable, "get_bot_streak_stats")
+		} else if streaks != nil && streaks.LongestWinStreak > 1 {
+			add("get_bot_streak_stats", "%s's longest winning streak is %d fights in a row.", botName, streaks.LongestWinStreak)
+		}
+
+		if headToHead, err := getNHRLHeadToHeadCached(botName); err != nil {
+			unavailable = append(unavailable, "get_bot_head_to_head")
+		} else {
+			var most *NHRLHeadToHead
+			for i, h2h := range headToHead {
+				if h2h.NumFights > 1 && (most == nil || h2h.NumFights > most.NumFights) {
+					most = &headToHead[i]
+				}
+			}
+			if most != nil {
+				add("get_bot_head_to_head", "%s has met %s %d times, more than any other opponent, going %d-%d.",
+					botName, most.OpponentUniqueName, most.NumFights, most.Wins, most.Losses)
+			}
+		}
+	} else {
+		result["weight_class"] = weightClass
+		categoryID := getWeightClassCategoryID(weightClass)
+
+		if fastestKOs, err := getNHRLFastestKOs(categoryID); err != nil {
+			unavailable = append(unavailable, "get_weight_class_fastest_kos")
+		} else if len(fastestKOs) > 0 {
+			ko := fastestKOs[0]
+			add("get_weight_class_fastest_kos", "The fastest %s KO on record took %d seconds: %s over %s on %s.",
+				weightClass, ko.FightLengthSecs, ko.BotName, ko.OpponentName, ko.Date)
+		}
+
+		if streaks, err := getNHRLLongestWinningStreak(categoryID); err != nil {
+			unavailable = append(unavailable, "get_weight_class_longest_streaks")
+		} else if len(streaks) > 0 {
+			add("get_weight_class_longest_streaks", "The longest %s winning streak belongs to %s, at %d fights in a row.",
+				weightClass, streaks[0].BotName, streaks[0].StreakLength)
+		}
+
+		if eventWinners, err := getNHRLEventWinners(weightClass); err != nil {
+			unavailable = append(unavailable, "get_weight_class_event_winners")
+		} else if lineage, titles, names := buildChampionshipLineage(eventWinners); len(lineage) > 0 {
+			// Most titles, alphabetical on ties so the fact is stable
+			leader, leaderTitles := "", 0
+			for key, count := range titles {
+				if count > leaderTitles || (count == leaderTitles && strings.ToLower(names[key]) < strings.ToLower(leader)) {
+					leader, leaderTitles = names[key], count
+				}
+			}
+			add("get_championship_lineage", "%s has won %d of the %d %s events on record, more than any other bot.",
+				leader, leaderTitles, len(lineage), weightClass)
+		}
+
+		if summary, err := getNHRLStatSummary(categoryID, getSeasonID("all-time")); err != nil {
+			unavailable = append(unavailable, "get_weight_class_stat_summary")
+		} else {
+			var busiest *NHRLStatSummary
+			for i, stat := range summary {
+				if busiest == nil || stat.Fights > busiest.Fights {
+					busiest = &summary[i]
+				}
+			}
+			if busiest != nil && busiest.Fights > 0 {
+				add("get_weight_class_stat_summary", "%s has fought more %s matches than any other bot: %d across %d events.",
+					busiest.Bot, weightClass, busiest.Fights, busiest.Events)
+			}
+		}
+	}
+
+	if len(facts) == 0 {
+		return "", fmt.Errorf("no fun facts could be generated")
+	}
+	result["fact_count"] = len(facts)
+	result["facts"] = facts
+	if len(unavailable) > 0 {
+		result["unavailable_sources"] = unavailable
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Default number of recent events scanned by get_fights_to_win
+const defaultFightsToWinEvents = 20
+
+// Get the average number of fights a champion had to win to take an event in a class
+func getNHRLFightsToWinTool(args map[string]interface{}) (string, error) {
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+
+	limit := defaultFightsToWinEvents
+	if l, ok := args["limit"].(float64); ok && l > 0 {
+		limit = int(l)
+	}
+
+	eventWinners, err := getNHRLEventWinners(weightClass)
+	if err != nil {
+		return "", fmt.Errorf("failed to get weight class event winners: %w", err)
+	}
+
+	type datedWinner struct {
+		date   time.Time
+		winner NHRLEventWinner
+	}
+	var winners []datedWinner
+	for _, winner := range eventWinners {
+		date, ok := parseStatsbookDate(winner.EventDate)
+		if !ok || strings.TrimSpace(winner.FirstPlaceName) == "" {
+			continue
+		}
+		winners = append(winners, datedWinner{date: date, winner: winner})
+	}
+	sort.SliceStable(winners, func(i, j int) bool {
+		return winners[i].date.After(winners[j].date)
+	})
+	if len(winners) > limit {
+		winners = winners[:limit]
+	}
+
+	type championPath struct {
+		EventDate     string `json:"event_date"`
+		TournamentID  string `json:"tournament_id"`
+		EventName     string `json:"event_name,omitempty"`
+		Champion      string `json:"champion"`
+		QualifierWins int    `json:"qualifier_wins"`
+		BracketWins   int    `json:"bracket_wins"`
+		TotalWins     int    `json:"total_wins"`
+		Losses        int    `json:"losses"`
+	}
+
+	// Each champion's events are fetched once, however many titles they hold
+	eventsByBot := make(map[string][]NHRLBotEvent)
+	var paths []championPath
+	var unavailable []string
+	for _, w := range winners {
+		champion := w.winner.FirstPlaceName
+		key := strings.ToLower(normalizeBotName(champion))
+		events, ok := eventsByBot[key]
+		if !ok {
+			events, _, err = getNHRLBotEvents(champion)
+			if err != nil {
+				unavailable = append(unavailable, champion)
+				continue
+			}
+			eventsByBot[key] = events
+		}
+
+		event, ok := championEvent(events, w.date)
+		if !ok {
+			unavailable = append(unavailable, champion)
+			continue
+		}
+
+		path := championPath{EventDate: w.winner.EventDate, TournamentID: event.TournamentID, EventName: event.EventName, Champion: champion}
+		for _, fight := range event.Fights {
+			switch fightOutcome(fight) {
+			case "win":
+				if strings.HasPrefix(strings.ToUpper(strings.TrimSpace(fight.Round)), "Q") {
+					path.QualifierWins++
+				} else {
+					path.BracketWins++
+				}
+			case "loss":
+				path.Losses++
+			}
+		}
+		path.TotalWins = path.QualifierWins + path.BracketWins
+		if path.TotalWins == 0 {
+			unavailable = append(unavailable, champion)
+			continue
+		}
+		paths = append(paths, path)
+	}
+	if len(paths) == 0 {
+		return "", fmt.Errorf("no champion fight paths found for %s", weightClass)
+	}
+
+	wins := make([]int, len(paths))
+	total := 0
+	for i, path := range paths {
+		wins[i] = path.TotalWins
+		total += path.TotalWins
+	}
+	sort.Ints(wins)
+	median := float64(wins[len(wins)/2])
+	if len(wins)%2 == 0 {
+		median = float64(wins[len(wins)/2-1]+wins[len(wins)/2]) / 2
+	}
+	mean := float64(total) / float64(len(wins))
+
+	result := map[string]interface{}{
+		"weight_class": weightClass,
+		"event_count":  len(paths),
+		"mean_wins":    math.Round(mean*100) / 100,
+		"median_wins":  median,
+		"min_wins":     wins[0],
+		"max_wins":     wins[len(wins)-1],
+		"events":       paths,
+		"summary":      fmt.Sprintf("It takes about %.0f wins to win a %s event", math.Round(mean), weightClass),
+		"note":         "Wins include qualifiers; each title is matched to the champion's BrettZone event nearest its date (within 3 days), and only that event's fights are counted",
+	}
+	if len(unavailable) > 0 {
+		result["champions_without_fight_data"] = unavailable
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// championEvent picks the event a title was won at from the champion's events:
+// the one dated nearest the title within eventDateWindow, preferring events the
+// champion's BrettZone bracket shows it won
+func championEvent(events []NHRLBotEvent, titleDate time.Time) (NHRLBotEvent, bool) {
+	best := -1
+	var bestGap time.Duration
+	bestWon := false
+	for i, event := range events {
+		gap := event.Date.Sub(titleDate)
+		if gap < 0 {
+			gap = -gap
+		}
+		if gap > eventDateWindow {
+			continue
+		}
+		won := event.Placement != nil && *event.Placement == 1
+		if best == -1 || (won && !bestWon) || (won == bestWon && gap < bestGap) {
+			best, bestGap, bestWon = i, gap, won
+		}
+	}
+	if best == -1 {
+		return NHRLBotEvent{}, false
+	}
+	return events[best], true
+}
+
+// Career length histogram buckets, in years of first-to-last appearance
+var careerLengthBuckets = []struct {
+	label    string
+	maxYears float64
+}{
+	{"under 1 year", 1},
+	{"1-2 years", 2},
+	{"2-3 years", 3},
+	{"3-5 years", 5},
+	{"5+ years", math.Inf(1)},
+}
+
+// Get the distribution of career spans (first to last appearance) across a weight class
+func getNHRLCareerLengthStatsTool(args map[string]interface{}) (string, error) {
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+
+	statSummary, err := getNHRLStatSummary(getWeightClassCategoryID(weightClass), getSeasonID("all-time"))
+	if err != nil {
+		return "", fmt.Errorf("failed to get weight class stat summary: %w", err)
+	}
+
+	// Scan the bots with the most events first so the cap drops one-off entrants
+	sort.SliceStable(statSummary, func(i, j int) bool {
+		return statSummary[i].Events > statSummary[j].Events
+	})
+	var botNames []string
+	for _, stat := range statSummary {
+		if stat.Fights > 0 {
+			botNames = append(botNames, stat.Bot)
+		}
+	}
+	classSize := len(botNames)
+	if len(botNames) > maxCareerLookups {
+		botNames = botNames[:maxCareerLookups]
+	}
+
+	type botCareer struct {
+		Bot   string `json:"bot"`
+		First string `json:"first_appearance"`
+		Last  string `json:"last_appearance"`
+		Days  int    `json:"career_days"`
+	}
+	// Career spans are cached, so repeat calls for the same class are cheap
+	type spanLookup struct {
+		span      careerSpan
+		hasFights bool
+		err       error
+	}
+	lookups := make([]spanLookup, len(botNames))
+	sem := make(chan struct{}, debutLookupConcurrency)
+	var wg sync.WaitGroup
+	for i, botName := range botNames {
+		wg.Add(1)
+		go func(i int, botName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			span, hasFights, err := getNHRLCareerSpanCached(botName)
+			lookups[i] = spanLookup{span: span, hasFights: hasFights, err: err}
+		}(i, botName)
+	}
+	wg.Wait()
+
+	var careers []botCareer
+	var unavailable []string
+	for i, botName := range botNames {
+		span := lookups[i].span
+		if lookups[i].err != nil || !lookups[i].hasFights {
+			unavailable = append(unavailable, botName)
+			continue
+		}
+		careers = append(careers, botCareer{
+			Bot:   botName,
+			First: span.First.Format("2006-01-02"),
+			Last:  span.Last.Format("2006-01-02"),
+			Days:  int(span.Last.Sub(span.First).Hours() / 24),
+		})
+	}
+	if len(careers) == 0 {
+		return "", fmt.Errorf("no dated fight history found for %s bots", weightClass)
+	}
+
+	sort.SliceStable(careers, func(i, j int) bool {
+		return careers[i].Days > careers[j].Days
+	})
+
+	days := make([]int, len(careers))
+	total := 0
+	for i, career := range careers {
+		days[i] = career.Days
+		total += career.Days
+	}
+	sort.Ints(days)
+	median := float64(days[len(days)/2])
+	if len(days)%2 == 0 {
+		median = float64(days[len(days)/2-1]+days[len(days)/2]) / 2
+	}
+	mean := float64(total) / float64(len(days))
+
+	histogram := make([]map[string]interface{}, len(careerLengthBuckets))
+	for i, bucket := range careerLengthBuckets {
+		histogram[i] = map[string]interface{}{"range": bucket.label, "bot_count": 0}
+	}
+	for _, d := range days {
+		years := float64(d) / 365.25
+		for i, bucket := range careerLengthBuckets {
+			if years < bucket.maxYears {
+				histogram[i]["bot_count"] = histogram[i]["bot_count"].(int) + 1
+				break
+			}
+		}
+	}
+
+	longest := careers
+	if len(longest) > 10 {
+		longest = longest[:10]
+	}
+
+	result := map[string]interface{}{
+		"weight_class":        weightClass,
+		"bots_in_class":       classSize,
+		"bots_measured":       len(careers),
+		"mean_career_days":    math.Round(mean*10) / 10,
+		"median_career_days":  median,
+		"mean_career_years":   math.Round(mean/365.25*100) / 100,
+		"median_career_years": math.Round(median/365.25*100) / 100,
+		"histogram":           histogram,
+		"longest_careers":     longest,
+	}
+	if classSize > len(botNames) {
+		result["note"] = fmt.Sprintf("Only the %d bots with the most events were measured; one-off entrants beyond that are excluded, so figures skew toward longer careers", maxCareerLookups)
+	}
+	if len(unavailable) > 0 {
+		result["history_unavailable"] = unavailable
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get a cross-class "pound-for-pound" leaderboard from the Active season.
+// Bots are compared by their points percentile within their own class so that
+// classes of different sizes and point scales are on equal footing:
+// percentile = 100 * (bots in class with fewer points) / (class size - 1).
+// Ties are broken by win percentage, then by fights.
+func getNHRLGlobalLeaderboardTool(args map[string]interface{}) (string, error) {
+	// Get pagination parameters
+	limit := 25
+	if l, ok := args["limit"].(float64); ok {
+		limit = int(l)
+	}
+
+	offset := 0
+	if o, ok := args["offset"].(float64); ok {
+		offset = int(o)
+	}
+
+	type leaderboardEntry struct {
+		bot         string
+		weightClass string
+		classRank   int
+		points      float64
+		percentile  float64
+		winPct      float64
+		fights      int
+	}
+
+	var entries []leaderboardEntry
+	classSizes := make(map[string]int)
+
+	for _, weightClass := range []string{"3lb", "12lb", "30lb"} {
+		statSummary, err := getNHRLStatSummary(getWeightClassCategoryID(weightClass), getSeasonID("Active"))
+		if err != nil {
+			return "", fmt.Errorf("failed to get %s stat summary: %w", weightClass, err)
+		}
+		classSizes[weightClass] = len(statSummary)
+
+		points := make([]float64, len(statSummary))
+		for i, stat := range statSummary {
+			points[i], _ = strconv.ParseFloat(strings.TrimSpace(stat.Points), 64)
+		}
+
+		for i, stat := range statSummary {
+			percentile := 100.0
+			if len(statSummary) > 1 {
+				below := 0
+				for _, p := range points {
+					if p < points[i] {
+						below++
+					}
+				}
+				percentile = 100 * float64(below) / float64(len(statSummary)-1)
+			}
+
+			winPct := 0.0
+			if stat.W+stat.L > 0 {
+				winPct = float64(stat.W) / float64(stat.W+stat.L)
+			}
+
+			entries = append(entries, leaderboardEntry{
+				bot:         stat.Bot,
+				weightClass: weightClass,
+				classRank:   stat.Ranking,
+				points:      points[i],
+				percentile:  percentile,
+				winPct:      winPct,
+				fights:      stat.Fights,
+			})
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].percentile != entries[j].percentile {
+			return entries[i].percentile > entries[j].percentile
+		}
+		if entries[i].winPct != entries[j].winPct {
+			return entries[i].winPct > entries[j].winPct
+		}
+		return entries[i].fights > entries[j].fights
+	})
+
+	leaderboard := make([]map[string]interface{}, len(entries))
+	for i, entry := range entries {
+		leaderboard[i] = map[string]interface{}{
+			"global_rank":       i + 1,
+			"bot":               entry.bot,
+			"weight_class":      entry.weightClass,
+			"class_rank":        entry.classRank,
+			"points":            entry.points,
+			"points_percentile": entry.percentile,
+			"win_pct":           entry.winPct,
+			"fights":            entry.fights,
+		}
+	}
+
+	// Apply pagination
+	paginatedLeaderboard, metadata := paginateSlice(leaderboard, limit, offset)
+
+	result := map[string]interface{}{
+		"season":        "Active",
+		"class_sizes":   classSizes,
+		"normalization": "Points percentile within each weight class (100 = most points in class); ties broken by win percentage, then fights",
+		"bot_count":     len(paginatedLeaderboard),
+		"total_bots":    len(leaderboard),
+		"leaderboard":   paginatedLeaderboard,
+		"pagination":    metadata,
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get weight class stat summary simple (all-time stats with correct ranking)
+func getNHRLWeightClassStatSummarySimpleTool(args map[string]interface{}) (string, error) {
+	weightClass := "3lb"
+	if wc, ok := args["weight_class"].(string); ok {
+		weightClass = wc
+	}
+	categoryID := getWeightClassCategoryID(weightClass)
+
+	// Get pagination parameters
+	limit := 25
+	if l, ok := args["limit"].(float64); ok {
+		limit = int(l)
+	}
+
+	offset := 0
+	if o, ok := args["offset"].(float64); ok {
+		offset = int(o)
+	}
+
+	statSummary, err := getNHRLStatSummarySimple(categoryID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get weight class stat summary simple: %w", err)
+	}
+
+	// Apply pagination, to the rows sorted on a raw or derived field when sort_by is given
+	sortBy, _ := args["sort_by"].(string)
+	var paginatedStats interface{}
+	var botCount int
+	var metadata map[string]interface{}
+	if sortBy != "" {
+		sorted, err := sortStatSummary(statSummary, sortBy)
+		if err != nil {
+			return "", err
+		}
+		page, pageMetadata := paginateSlice(sorted, limit, offset)
+		paginatedStats, botCount, metadata = page, len(page), pageMetadata
+	} else {
+		page, pageMetadata := paginateSlice(statSummary, limit, offset)
+		paginatedStats, botCount, metadata = page, len(page), pageMetadata
+	}
+
+	result := map[string]interface{}{
+		"weight_class": weightClass,
+		"season":       "all-time",
+		"bot_count":    botCount,
+		"stats":        paginatedStats,
+		"pagination":   metadata,
+		"note":         "This endpoint provides all-time stats with correct ranking for the weight class",
+	}
+	if sortBy != "" {
+		result["sort_by"] = sortBy
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get random fight
+func getNHRLRandomFightTool(args map[string]interface{}) (string, error) {
 	randomFight, err := getNHRLRandomFight()
 	if err != nil {
-		return "", fmt.Errorf("failed to get random fight: %w", err)
+		return "", fmt.Errorf("failed to get random fight: %w", err)
+	}
+
+	result := map[string]interface{}{
+		"random_fight": randomFight,
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// getBrettZoneTournamentMatchesTool handles getting tournament matches from BrettZone
+func getBrettZoneTournamentMatchesTool(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	// Get pagination parameters
+	limit := 25
+	if l, ok := args["limit"].(float64); ok {
+		limit = int(l)
+	}
+
+	offset := 0
+	if o, ok := args["offset"].(float64); ok {
+		offset = int(o)
+	}
+
+	matches, truncated, err := getBrettZoneLatestMatchesLimited(tournamentID, maxBrettZoneMatches)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+
+	// Paginate first so only the returned page is enriched with round information
+	pageMatches, metadata := paginateSlice(matches, limit, offset)
+	paginatedMatches := enrichBrettZoneMatches(pageMatches)
+
+	// Convert to response format with additional information
+	enrichedMatches := make([]map[string]interface{}, len(paginatedMatches))
+	for i, match := range paginatedMatches {
+		enrichedMatch := map[string]interface{}{
+			"tournamentID":     match.TournamentID,
+			"matchID":          match.ID,
+			"matchName":        match.Name,
+			"round":            match.Round,
+			"roundName":        match.RoundName,
+			"roundDescription": match.RoundDescription,
+			"winImplication":   match.WinImplication,
+			"loseImplication":  match.LoseImplication,
+			"cage":             match.Cage,
+			"player1":          match.Player1,
+			"player2":          match.Player2,
+			"winner":           getMatchWinner(match.BrettZoneMatch),
+			"winMethod":        match.WinAnnotation,
+			"matchLengthSecs":  match.MatchLength,
+			"weightClass":      match.WeightClass + "lb",
+			"tournamentName":   match.TournamentName,
+			"cameras":          match.Cams,
+			"isTest":           match.IsTest == "1",
+			"isFreestyle":      match.IsFreestyle == "1",
+		}
+
+		// Add timing information if available
+		if match.StartTime != "" && match.StopTime != "" {
+			enrichedMatch["startTime"] = match.StartTime
+			enrichedMatch["stopTime"] = match.StopTime
+		}
+
+		// Add review URL
+		cageNum := extractCageNumber(match.Cage)
+		reviewURL := generateBrettZoneReviewURL(match.ID, match.TournamentID, cageNum, 3.0)
+		enrichedMatch["reviewURL"] = reviewURL
+
+		enrichedMatches[i] = enrichedMatch
+	}
+
+	// Sort matches by round and match name for better organization
+	result := map[string]interface{}{
+		"tournamentID":   tournamentID,
+		"tournamentName": "",
+		"totalMatches":   len(paginatedMatches),
+		"matches":        enrichedMatches,
+		"pagination":     metadata,
+	}
+
+	// Set tournament name if we have at least one match
+	if len(matches) > 0 {
+		result["tournamentName"] = matches[0].TournamentName
+	}
+
+	if truncated {
+		result["note"] = fmt.Sprintf("Tournament has more than %d matches; only the first %d were processed", maxBrettZoneMatches, maxBrettZoneMatches)
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal tournament matches data: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// getBrettZoneMatchReviewURLTool handles generating match review URLs
+func getBrettZoneMatchReviewURLTool(args map[string]interface{}) (string, error) {
+	gameID, ok := args["game_id"].(string)
+	if !ok || gameID == "" {
+		return "", fmt.Errorf("game_id parameter is required")
+	}
+
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	// Optional parameters with defaults
+	cageNum := 1
+	if cage, ok := args["cage_number"].(float64); ok {
+		cageNum = int(cage)
+	}
+
+	timeSeconds := 3.0
+	if time, ok := args["time_seconds"].(float64); ok {
+		timeSeconds = time
+	}
+
+	reviewURL := generateBrettZoneReviewURL(gameID, tournamentID, cageNum, timeSeconds)
+
+	result := map[string]interface{}{
+		"gameID":       gameID,
+		"tournamentID": tournamentID,
+		"cageNumber":   cageNum,
+		"timeSeconds":  timeSeconds,
+		"reviewURL":    reviewURL,
+		"description":  fmt.Sprintf("Watch match %s from tournament %s starting at %.1f seconds", gameID, tournamentID, timeSeconds),
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal review URL data: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// getBrettZoneEventVideosTool returns a review URL for every match in a tournament,
+// grouped by round in bracket order, for building a playlist
+func getBrettZoneEventVideosTool(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	timeSeconds := 3.0
+	if t, ok := args["time_seconds"].(float64); ok {
+		timeSeconds = t
+	}
+
+	matches, err := getBrettZoneLatestMatches(tournamentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+
+	type roundVideos struct {
+		Round     string                   `json:"round"`
+		RoundName string                   `json:"roundName"`
+		Matches   []map[string]interface{} `json:"matches"`
+		sortKey   int
+	}
+
+	rounds := make(map[string]*roundVideos)
+	played := 0
+	for _, match := range matches {
+		round := strings.ToUpper(strings.TrimSpace(match.Round))
+		group, ok := rounds[round]
+		if !ok {
+			_, _, sortKey := getBrettZoneRoundOrder(round)
+			group = &roundVideos{Round: match.Round, RoundName: getQualificationRoundName(match.Round), sortKey: sortKey}
+			rounds[round] = group
+		}
+
+		winner := getMatchWinner(match)
+		if winner != "undecided" {
+			played++
+		}
+		group.Matches = append(group.Matches, map[string]interface{}{
+			"matchID":   match.ID,
+			"matchName": match.Name,
+			"cage":      match.Cage,
+			"matchup":   fmt.Sprintf("%s vs %s", match.Player1, match.Player2),
+			"winner":    winner,
+			"reviewURL": generateBrettZoneReviewURL(match.ID, match.TournamentID, extractCageNumber(match.Cage), timeSeconds),
+		})
+	}
+
+	grouped := make([]*roundVideos, 0, len(rounds))
+	for _, group := range rounds {
+		sort.SliceStable(group.Matches, func(i, j int) bool {
+			return group.Matches[i]["matchID"].(string) < group.Matches[j]["matchID"].(string)
+		})
+		grouped = append(grouped, group)
+	}
+	sort.SliceStable(grouped, func(i, j int) bool {
+		if grouped[i].sortKey != grouped[j].sortKey {
+			return grouped[i].sortKey < grouped[j].sortKey
+		}
+		return grouped[i].Round < grouped[j].Round
+	})
+
+	result := map[string]interface{}{
+		"tournamentID": tournamentID,
+		"matchCount":   len(matches),
+		"decidedCount": played,
+		"timeSeconds":  timeSeconds,
+		"rounds":       grouped,
+		"note":         "Undecided matches are included; their review URLs only show video once the match has been fought",
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// getBrettZoneRecentResultsTool returns the most recently completed matches in a tournament
+func getBrettZoneRecentResultsTool(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	limit := 25
+	if l, ok := args["limit"].(float64); ok && l > 0 {
+		limit = int(l)
+	}
+
+	var since time.Time
+	if s, ok := args["since"].(string); ok && s != "" {
+		parsed, ok := parseBrettZoneTime(s)
+		if !ok {
+			return "", fmt.Errorf("invalid since value: %s", s)
+		}
+		since = parsed
+	}
+
+	matches, err := getBrettZoneLatestMatches(tournamentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+
+	type completedMatch struct {
+		match   BrettZoneMatch
+		endTime time.Time
+	}
+
+	// Keep only decided matches with a known end time
+	var completed []completedMatch
+	for _, match := range matches {
+		if getMatchWinner(match) == "undecided" {
+			continue
+		}
+		endTime, ok := parseBrettZoneTime(match.EndTime)
+		if !ok {
+			endTime, ok = parseBrettZoneTime(match.StopTime)
+		}
+		if !ok {
+			continue
+		}
+		if !since.IsZero() && endTime.Before(since) {
+			continue
+		}
+		completed = append(completed, completedMatch{match: match, endTime: endTime})
+	}
+
+	// Newest results first
+	sort.SliceStable(completed, func(i, j int) bool {
+		return completed[i].endTime.After(completed[j].endTime)
+	})
+
+	if len(completed) > limit {
+		completed = completed[:limit]
+	}
+
+	results := make([]map[string]interface{}, len(completed))
+	for i, c := range completed {
+		match := c.match
+		winner := getMatchWinner(match)
+		loser := match.Player2
+		if winner == match.Player2 {
+			loser = match.Player1
+		}
+
+		results[i] = map[string]interface{}{
+			"matchID":         match.ID,
+			"matchName":       match.Name,
+			"round":           match.Round,
+			"roundName":       getQualificationRoundName(match.Round),
+			"cage":            match.Cage,
+			"winner":          winner,
+			"loser":           loser,
+			"winMethod":       match.WinAnnotation,
+			"matchLengthSecs": match.MatchLength,
+			"endTime":         c.endTime.UTC().Format(time.RFC3339),
+			"reviewURL":       generateBrettZoneReviewURL(match.ID, match.TournamentID, extractCageNumber(match.Cage), 3.0),
+		}
+	}
+
+	result := map[string]interface{}{
+		"tournamentID": tournamentID,
+		"resultCount":  len(results),
+		"results":      results,
+	}
+	if !since.IsZero() {
+		result["since"] = since.UTC().Format(time.RFC3339)
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal recent results: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get smoothed head-to-head win probability between two bots
+func getNHRLMatchupProbabilityTool(args map[string]interface{}) (string, error) {
+	bot1, ok := args["bot1"].(string)
+	if !ok || bot1 == "" {
+		return "", fmt.Errorf("bot1 is required for get_matchup_probability operation")
+	}
+
+	bot2, ok := args["bot2"].(string)
+	if !ok || bot2 == "" {
+		return "", fmt.Errorf("bot2 is required for get_matchup_probability operation")
+	}
+
+	headToHead, err := getNHRLHeadToHead(bot1)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot head-to-head: %w", err)
+	}
+
+	var record *NHRLHeadToHead
+	for i := range headToHead {
+		if botNamesMatch(headToHead[i].OpponentUniqueName, bot2) {
+			record = &headToHead[i]
+			break
+		}
+	}
+
+	wins, losses := 0, 0
+	if record != nil {
+		wins = record.Wins
+		losses = record.Losses
+	}
+	meetings := wins + losses
+
+	// Laplace (add-one) smoothing keeps the estimate away from 0%/100% on small samples
+	probability := float64(wins+1) / float64(meetings+2)
+
+	confidence := "high"
+	switch {
+	case meetings == 0:
+		confidence = "none"
+	case meetings < 3:
+		confidence = "low"
+	case meetings < 5:
+		confidence = "medium"
+	}
+
+	result := map[string]interface{}{
+		"bot1":                 bot1,
+		"bot2":                 bot2,
+		"bot1_wins":            wins,
+		"bot2_wins":            losses,
+		"meetings":             meetings,
+		"bot1_win_probability": probability,
+		"bot2_win_probability": 1 - probability,
+		"confidence":           confidence,
+		"method":               "Laplace-smoothed head-to-head record: (bot1 wins + 1) / (meetings + 2)",
+	}
+
+	if record != nil && record.LastMeeting != "" {
+		result["last_meeting"] = record.LastMeeting
+		if lastMeeting, ok := parseStatsbookDate(record.LastMeeting); ok {
+			result["days_since_last_meeting"] = int(time.Since(lastMeeting).Hours() / 24)
+		}
+	}
+
+	switch confidence {
+	case "none":
+		result["message"] = "These bots have never met; probability defaults to 50%"
+	case "low", "medium":
+		result["message"] = fmt.Sprintf("Limited-confidence estimate based on only %d meeting(s)", meetings)
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// List the events two bots both entered, flagging the ones where they fought each other
+func getNHRLSharedEventsTool(args map[string]interface{}) (string, error) {
+	bot1, ok := args["bot1"].(string)
+	if !ok || bot1 == "" {
+		return "", fmt.Errorf("bot1 is required for get_shared_events operation")
+	}
+
+	bot2, ok := args["bot2"].(string)
+	if !ok || bot2 == "" {
+		return "", fmt.Errorf("bot2 is required for get_shared_events operation")
+	}
+
+	// Events are the BrettZone tournaments in each bot's fight history, which
+	// gives the two bots a shared tournament ID to match on
+	events1, _, err := getNHRLBotEvents(bot1)
+	if err != nil {
+		return "", fmt.Errorf("failed to get events for %s: %w", bot1, err)
+	}
+	events2, _, err := getNHRLBotEvents(bot2)
+	if err != nil {
+		return "", fmt.Errorf("failed to get events for %s: %w", bot2, err)
+	}
+
+	entered := make(map[string]bool, len(events2))
+	for _, event := range events2 {
+		entered[event.TournamentID] = true
+	}
+
+	type sharedEvent struct {
+		date         time.Time
+		TournamentID string `json:"tournament_id"`
+		EventDate    string `json:"event_date"`
+		EventName    string `json:"event_name"`
+		Met          bool   `json:"fought_each_other"`
+	}
+
+	var shared []sharedEvent
+	for _, event := range events1 {
+		if !entered[event.TournamentID] {
+			continue
+		}
+		met := false
+		for _, fight := range event.Fights {
+			if botNamesMatch(fight.OpponentName, bot2) {
+				met = true
+				break
+			}
+		}
+		shared = append(shared, sharedEvent{
+			date:         event.Date,
+			TournamentID: event.TournamentID,
+			EventDate:    event.EventDate,
+			EventName:    event.EventName,
+			Met:          met,
+		})
+	}
+
+	// Most recent first
+	sort.SliceStable(shared, func(i, j int) bool {
+		return shared[i].date.After(shared[j].date)
+	})
+
+	metCount := 0
+	for _, event := range shared {
+		if event.Met {
+			metCount++
+		}
+	}
+
+	result := map[string]interface{}{
+		"bot1":               bot1,
+		"bot2":               bot2,
+		"shared_event_count": len(shared),
+		"events_met_in":      metCount,
+		"shared_events":      shared,
+	}
+
+	// All-time head-to-head from bot1's side
+	if headToHead, err := getNHRLHeadToHeadCached(bot1); err == nil {
+		for _, record := range headToHead {
+			if botNamesMatch(record.OpponentUniqueName, bot2) {
+				result["head_to_head"] = map[string]interface{}{
+					"meetings":     record.NumFights,
+					"bot1_wins":    record.Wins,
+					"bot2_wins":    record.Losses,
+					"last_meeting": record.LastMeeting,
+				}
+				break
+			}
+		}
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get every meeting between two bots in order, with the running series score
+func getNHRLRivalryTimelineTool(args map[string]interface{}) (string, error) {
+	bot1, ok := args["bot1"].(string)
+	if !ok || bot1 == "" {
+		return "", fmt.Errorf("bot1 is required for get_rivalry_timeline operation")
+	}
+
+	bot2, ok := args["bot2"].(string)
+	if !ok || bot2 == "" {
+		return "", fmt.Errorf("bot2 is required for get_rivalry_timeline operation")
+	}
+
+	fights, err := getNHRLFightsResolved(bot1)
+	if err != nil {
+		return "", fmt.Errorf("failed to get fights for %s: %w", bot1, err)
+	}
+
+	type meeting struct {
+		date        time.Time
+		roundKey    int
+		matchNum    int
+		Date        string  `json:"date"`
+		EventName   string  `json:"event_name,omitempty"`
+		Round       string  `json:"round"`
+		Winner      string  `json:"winner"`
+		ResultBy    string  `json:"result_by"`
+		LengthSecs  *string `json:"fight_length_secs"`
+		VideoLink   *string `json:"video_link"`
+		SeriesScore string  `json:"series_score"`
+	}
+
+	var meetings []meeting
+	for _, fight := range fights {
+		if !botNamesMatch(fight.OpponentName, bot2) {
+			continue
+		}
+		date, ok := parseStatsbookDate(fight.Date)
+		if !ok {
+			continue
+		}
+		_, _, roundKey := getBrettZoneRoundOrder(fight.Round)
+
+		winner := "undecided"
+		switch fightOutcome(fight) {
+		case "win":
+			winner = bot1
+		case "loss":
+			winner = bot2
+		}
+		meetings = append(meetings, meeting{
+			date:       date,
+			roundKey:   roundKey,
+			matchNum:   fight.MatchNum,
+			Date:       fight.Date,
+			EventName:  fight.EventName,
+			Round:      strings.ToUpper(strings.TrimSpace(fight.Round)),
+			Winner:     winner,
+			ResultBy:   fight.ResultBy,
+			LengthSecs: fight.FightLengthSecs,
+			VideoLink:  fight.VideoLink,
+		})
+	}
+
+	// Oldest first; meetings at the same event follow bracket order
+	sort.SliceStable(meetings, func(i, j int) bool {
+		if !meetings[i].date.Equal(meetings[j].date) {
+			return meetings[i].date.Before(meetings[j].date)
+		}
+		if meetings[i].roundKey != meetings[j].roundKey {
+			return meetings[i].roundKey < meetings[j].roundKey
+		}
+		return meetings[i].matchNum < meetings[j].matchNum
+	})
+
+	wins1, wins2 := 0, 0
+	for i := range meetings {
+		switch meetings[i].Winner {
+		case bot1:
+			wins1++
+		case bot2:
+			wins2++
+		}
+		meetings[i].SeriesScore = fmt.Sprintf("%s %d-%d %s", bot1, wins1, wins2, bot2)
+	}
+
+	result := map[string]interface{}{
+		"bot1":          bot1,
+		"bot2":          bot2,
+		"meeting_count": len(meetings),
+		"bot1_wins":     wins1,
+		"bot2_wins":     wins2,
+		"series_score":  fmt.Sprintf("%s %d-%d %s", bot1, wins1, wins2, bot2),
+		"meetings":      meetings,
+	}
+	if len(meetings) == 0 {
+		result["message"] = fmt.Sprintf("%s and %s have never fought each other", bot1, bot2)
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// getBrettZoneMatchTimelineTool returns a normalized called → started → stopped timeline for one match
+func getBrettZoneMatchTimelineTool(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	gameID, ok := args["game_id"].(string)
+	if !ok || gameID == "" {
+		return "", fmt.Errorf("game_id parameter is required")
+	}
+
+	matches, err := getBrettZoneLatestMatches(tournamentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+
+	var match *BrettZoneMatch
+	for i := range matches {
+		if strings.EqualFold(matches[i].ID, gameID) || strings.EqualFold(matches[i].Name, gameID) {
+			match = &matches[i]
+			break
+		}
+	}
+	if match == nil {
+		return "", fmt.Errorf("match %s not found in tournament %s", gameID, tournamentID)
+	}
+
+	phases := []struct {
+		name  string
+		value string
+	}{
+		{"available", match.AvailableSince},
+		{"called", match.CalledSince},
+		{"started", match.StartTime},
+		{"stopped", match.StopTime},
+		{"ended", match.EndTime},
+	}
+
+	times := make(map[string]time.Time)
+	timeline := make([]map[string]interface{}, 0, len(phases))
+	for _, phase := range phases {
+		entry := map[string]interface{}{
+			"phase": phase.name,
+			"raw":   phase.value,
+		}
+		if parsed, ok := parseBrettZoneTime(phase.value); ok {
+			times[phase.name] = parsed
+			entry["timestamp"] = parsed.UTC().Format(time.RFC3339)
+		} else {
+			entry["timestamp"] = nil
+		}
+		timeline = append(timeline, entry)
+	}
+
+	// Durations are only reported when both ends of the span are known
+	duration := func(from, to string) interface{} {
+		start, ok1 := times[from]
+		end, ok2 := times[to]
+		if !ok1 || !ok2 || end.Before(start) {
+			return nil
+		}
+		return end.Sub(start).Seconds()
+	}
+
+	durations := map[string]interface{}{
+		"wait_to_call_secs":  duration("available", "called"),
+		"call_to_start_secs": duration("called", "started"),
+		"fight_length_secs":  duration("started", "stopped"),
+	}
+	if durations["fight_length_secs"] == nil {
+		if length, err := strconv.ParseFloat(match.MatchLength, 64); err == nil && length > 0 {
+			durations["fight_length_secs"] = length
+		}
+	}
+
+	result := map[string]interface{}{
+		"tournamentID": tournamentID,
+		"matchID":      match.ID,
+		"matchName":    match.Name,
+		"round":        match.Round,
+		"cage":         match.Cage,
+		"player1":      match.Player1,
+		"player2":      match.Player2,
+		"winner":       getMatchWinner(*match),
+		"timeline":     timeline,
+		"durations":    durations,
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// getBrettZoneIdleGapsTool finds windows where a cage had a match ready but nothing running
+func getBrettZoneIdleGapsTool(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	minGapSecs := 60.0
+	if m, ok := args["min_gap_seconds"].(float64); ok && m >= 0 {
+		minGapSecs = m
+	}
+
+	matches, err := getBrettZoneLatestMatches(tournamentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+
+	type timedMatch struct {
+		match     BrettZoneMatch
+		available time.Time
+		start     time.Time
+		stop      time.Time
+	}
+
+	// Group fought matches by cage
+	byCage := make(map[string][]timedMatch)
+	for _, match := range matches {
+		start, ok := parseBrettZoneTime(match.StartTime)
+		if !ok {
+			continue
+		}
+		stop, ok := parseBrettZoneTime(match.StopTime)
+		if !ok {
+			stop, ok = parseBrettZoneTime(match.EndTime)
+		}
+		if !ok || stop.Before(start) {
+			continue
+		}
+		available, _ := parseBrettZoneTime(match.AvailableSince)
+		byCage[match.Cage] = append(byCage[match.Cage], timedMatch{match: match, available: available, start: start, stop: stop})
+	}
+
+	cages := make([]string, 0, len(byCage))
+	for cage := range byCage {
+		cages = append(cages, cage)
+	}
+	sort.Strings(cages)
+
+	totalIdle := 0.0
+	cageResults := make([]map[string]interface{}, 0, len(cages))
+	for _, cage := range cages {
+		cageMatches := byCage[cage]
+		sort.Slice(cageMatches, func(i, j int) bool {
+			return cageMatches[i].start.Before(cageMatches[j].start)
+		})
+
+		gaps := make([]map[string]interface{}, 0)
+		cageIdle := 0.0
+		for i := 1; i < len(cageMatches); i++ {
+			prev, next := cageMatches[i-1], cageMatches[i]
+
+			// The cage is only idle once the next match was ready to fight
+			gapStart := prev.stop
+			if next.available.IsZero() {
+				continue
+			}
+			if next.available.After(gapStart) {
+				gapStart = next.available
+			}
+
+			gapSecs := next.start.Sub(gapStart).Seconds()
+			if gapSecs < minGapSecs {
+				continue
+			}
+
+			cageIdle += gapSecs
+			gaps = append(gaps, map[string]interface{}{
+				"start":         gapStart.UTC().Format(time.RFC3339),
+				"end":           next.start.UTC().Format(time.RFC3339),
+				"duration_secs": gapSecs,
+				"after_match":   prev.match.ID,
+				"before_match":  next.match.ID,
+			})
+		}
+
+		totalIdle += cageIdle
+		cageResults = append(cageResults, map[string]interface{}{
+			"cage":            cage,
+			"matches":         len(cageMatches),
+			"gap_count":       len(gaps),
+			"idle_total_secs": cageIdle,
+			"gaps":            gaps,
+		})
+	}
+
+	result := map[string]interface{}{
+		"tournamentID":    tournamentID,
+		"min_gap_seconds": minGapSecs,
+		"idle_total_secs": totalIdle,
+		"cages":           cageResults,
+		"definition":      "An idle gap runs from when a cage's previous match stopped (or the next match became available, if later) until the next match started",
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Maximum number of participants whose career history get_debut_bots looks up
+const maxDebutLookups = 96
+
+// Maximum number of concurrent career history lookups get_debut_bots and
+// get_career_length_stats make
+const debutLookupConcurrency = 5
+
+// getNHRLDebutBotsTool lists participants of a BrettZone tournament making their NHRL debut
+func getNHRLDebutBotsTool(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	matches, err := getBrettZoneMatchesCached(tournamentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+
+	// Collect participants and the event's start date (earliest known match time)
+	var eventStart time.Time
+	seen := make(map[string]bool)
+	var participants []string
+	for _, match := range matches {
+		for _, name := range []string{match.Player1, match.Player2} {
+			key := strings.ToLower(normalizeBotName(name))
+			if name == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			participants = append(participants, name)
+		}
+		for _, value := range []string{match.AvailableSince, match.StartTime} {
+			if t, ok := parseBrettZoneTime(value); ok && (eventStart.IsZero() || t.Before(eventStart)) {
+				eventStart = t
+			}
+		}
+	}
+	sort.Strings(participants)
+
+	lookedUp := participants
+	if len(lookedUp) > maxDebutLookups {
+		lookedUp = lookedUp[:maxDebutLookups]
+	}
+
+	// Career spans are cached, so repeat calls for the same event are cheap
+	type spanLookup struct {
+		span      careerSpan
+		hasFights bool
+		err       error
+	}
+	lookups := make([]spanLookup, len(lookedUp))
+	sem := make(chan struct{}, debutLookupConcurrency)
+	var wg sync.WaitGroup
+	for i, botName := range lookedUp {
+		wg.Add(1)
+		go func(i int, botName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			span, hasFights, err := getNHRLCareerSpanCached(botName)
+			lookups[i] = spanLookup{span: span, hasFights: hasFights, err: err}
+		}(i, botName)
+	}
+	wg.Wait()
+
+	rookies := make([]map[string]interface{}, 0)
+	unknown := make([]string, 0)
+	for i, botName := range lookedUp {
+		span, hasFights := lookups[i].span, lookups[i].hasFights
+		if lookups[i].err != nil {
+			unknown = append(unknown, botName)
+			continue
+		}
+
+		// A debut bot has no statsbook fight dated before this event started;
+		// without a start time only bots with no fights at all can be called debuts
+		if !hasFights || (!eventStart.IsZero() && !span.First.Before(eventStart.Truncate(24*time.Hour))) {
+			rookie := map[string]interface{}{"bot_name": botName}
+			if hasFights {
+				rookie["first_fight"] = span.First.Format("2006-01-02")
+			}
+			rookies = append(rookies, rookie)
+		}
+	}
+
+	result := map[string]interface{}{
+		"tournamentID":      tournamentID,
+		"participant_count": len(participants),
+		"debut_count":       len(rookies),
+		"debut_bots":        rookies,
+	}
+	if !eventStart.IsZero() {
+		result["event_start"] = eventStart.UTC().Format("2006-01-02")
+	}
+	if len(lookedUp) < len(participants) {
+		result["note"] = fmt.Sprintf("Only the first %d of %d participants (alphabetically) were checked", len(lookedUp), len(participants))
+	}
+	if len(unknown) > 0 {
+		result["history_unavailable"] = unknown
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// getBrettZoneBracketTool reconstructs an event bracket from BrettZone matches
+func getBrettZoneBracketTool(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	matches, err := getBrettZoneLatestMatches(tournamentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+
+	type roundGroup struct {
+		round   BracketRound
+		sortKey int
+	}
+
+	groups := make(map[string]*roundGroup)
+	completed := 0
+	for _, match := range enrichBrettZoneMatches(matches) {
+		bracketType, roundNum, sortKey := getBrettZoneRoundOrder(match.Round)
+
+		winner := getMatchWinner(match.BrettZoneMatch)
+		if winner != "undecided" {
+			completed++
+		}
+
+		game := map[string]interface{}{
+			"matchID":   match.ID,
+			"name":      match.Name,
+			"cage":      match.Cage,
+			"player1":   match.Player1,
+			"player2":   match.Player2,
+			"winner":    winner,
+			"winMethod": match.WinAnnotation,
+			"reviewURL": generateBrettZoneReviewURL(match.ID, match.TournamentID, extractCageNumber(match.Cage), 3.0),
+		}
+		if match.WinImplication != "" {
+			game["winImplication"] = match.WinImplication
+			game["loseImplication"] = match.LoseImplication
+		}
+
+		group, ok := groups[match.Round]
+		if !ok {
+			roundName := match.RoundName
+			if roundName == "" {
+				roundName = match.Round
+			}
+			group = &roundGroup{
+				round: BracketRound{
+					Round:       roundNum,
+					RoundName:   roundName,
+					BracketType: bracketType,
+				},
+				sortKey: sortKey,
+			}
+			groups[match.Round] = group
+		}
+		group.round.Games = append(group.round.Games, game)
+	}
+
+	sortedGroups := make([]*roundGroup, 0, len(groups))
+	for _, group := range groups {
+		sort.Slice(group.round.Games, func(i, j int) bool {
+			return group.round.Games[i]["name"].(string) < group.round.Games[j]["name"].(string)
+		})
+		sortedGroups = append(sortedGroups, group)
+	}
+	sort.Slice(sortedGroups, func(i, j int) bool {
+		if sortedGroups[i].sortKey != sortedGroups[j].sortKey {
+			return sortedGroups[i].sortKey < sortedGroups[j].sortKey
+		}
+		return sortedGroups[i].round.RoundName < sortedGroups[j].round.RoundName
+	})
+
+	rounds := make([]BracketRound, len(sortedGroups))
+	for i, group := range sortedGroups {
+		rounds[i] = group.round
+	}
+
+	result := map[string]interface{}{
+		"tournamentID":   tournamentID,
+		"tournamentName": "",
+		"source":         "brettzone",
+		"rounds":         rounds,
+		"totalGames":     len(matches),
+		"completedGames": completed,
+	}
+	if len(matches) > 0 {
+		result["tournamentName"] = matches[0].TournamentName
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Maximum length of a search_by_annotation regular expression
+const maxAnnotationPatternLength = 200
+
+// getBrettZoneSearchByAnnotationTool filters a tournament's matches by their win annotation text
+func getBrettZoneSearchByAnnotationTool(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	pattern, ok := args["annotation"].(string)
+	if !ok || strings.TrimSpace(pattern) == "" {
+		return "", fmt.Errorf("annotation parameter is required")
+	}
+
+	useRegex, _ := args["use_regex"].(bool)
+
+	// Case-insensitive substring match by default; optional bounded regex
+	matchesAnnotation := func(annotation string) bool {
+		return strings.Contains(strings.ToLower(annotation), strings.ToLower(pattern))
+	}
+	if useRegex {
+		if len(pattern) > maxAnnotationPatternLength {
+			return "", fmt.Errorf("annotation pattern is too long (max %d characters)", maxAnnotationPatternLength)
+		}
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			return "", fmt.Errorf("invalid annotation pattern: %w", err)
+		}
+		matchesAnnotation = re.MatchString
+	}
+
+	matches, err := getBrettZoneLatestMatches(tournamentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+
+	results := make([]map[string]interface{}, 0)
+	for _, match := range matches {
+		if match.WinAnnotation == "" || !matchesAnnotation(match.WinAnnotation) {
+			continue
+		}
+		results = append(results, map[string]interface{}{
+			"matchID":         match.ID,
+			"matchName":       match.Name,
+			"round":           match.Round,
+			"roundName":       getQualificationRoundName(match.Round),
+			"cage":            match.Cage,
+			"player1":         match.Player1,
+			"player2":         match.Player2,
+			"winner":          getMatchWinner(match),
+			"winMethod":       match.WinAnnotation,
+			"matchLengthSecs": match.MatchLength,
+			"reviewURL":       generateBrettZoneReviewURL(match.ID, match.TournamentID, extractCageNumber(match.Cage), 3.0),
+		})
+	}
+
+	result := map[string]interface{}{
+		"tournamentID": tournamentID,
+		"annotation":   pattern,
+		"regex":        useRegex,
+		"matchCount":   len(results),
+		"matches":      results,
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// getBrettZoneSearchByDurationTool returns a tournament's decided matches whose length falls within a range, shortest first
+func getBrettZoneSearchByDurationTool(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	minSecs, hasMin := args["min_secs"].(float64)
+	maxSecs, hasMax := args["max_secs"].(float64)
+	if !hasMin && !hasMax {
+		return "", fmt.Errorf("min_secs or max_secs is required for search_by_duration operation")
+	}
+	if hasMin && hasMax && minSecs > maxSecs {
+		return "", fmt.Errorf("min_secs (%g) must not exceed max_secs (%g)", minSecs, maxSecs)
+	}
+
+	weightClassFilter, err := brettZoneWeightClassFilter(args)
+	if err != nil {
+		return "", err
+	}
+
+	matches, err := getBrettZoneLatestMatches(tournamentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+
+	type timedMatch struct {
+		match  BrettZoneMatch
+		length float64
+	}
+
+	var found []timedMatch
+	for _, match := range matches {
+		if getMatchWinner(match) == "undecided" {
+			continue
+		}
+		if weightClassFilter != "" && strings.TrimSuffix(match.WeightClass, "lb") != weightClassFilter {
+			continue
+		}
+		// Match lengths are strings and may be blank for unplayed or forfeited matches
+		length, err := strconv.ParseFloat(strings.TrimSpace(match.MatchLength), 64)
+		if err != nil || length <= 0 {
+			continue
+		}
+		if (hasMin && length < minSecs) || (hasMax && length > maxSecs) {
+			continue
+		}
+		found = append(found, timedMatch{match: match, length: length})
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].length < found[j].length
+	})
+
+	results := make([]map[string]interface{}, len(found))
+	for i, f := range found {
+		match := f.match
+		winner := getMatchWinner(match)
+		loser := match.Player2
+		if winner == match.Player2 {
+			loser = match.Player1
+		}
+		results[i] = map[string]interface{}{
+			"matchID":         match.ID,
+			"matchName":       match.Name,
+			"round":           match.Round,
+			"roundName":       getQualificationRoundName(match.Round),
+			"cage":            match.Cage,
+			"winner":          winner,
+			"loser":           loser,
+			"winMethod":       match.WinAnnotation,
+			"matchLengthSecs": f.length,
+			"weightClass":     match.WeightClass + "lb",
+			"reviewURL":       generateBrettZoneReviewURL(match.ID, match.TournamentID, extractCageNumber(match.Cage), 3.0),
+		}
+	}
+
+	result := map[string]interface{}{
+		"tournamentID": tournamentID,
+		"matchCount":   len(results),
+		"matches":      results,
+	}
+	if hasMin {
+		result["minSecs"] = minSecs
+	}
+	if hasMax {
+		result["maxSecs"] = maxSecs
+	}
+	if weightClassFilter != "" {
+		result["weightClass"] = weightClassFilter + "lb"
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// getBrettZoneEventHighlightsTool assembles structured recap highlights for a tournament:
+// fastest KO, biggest upset, longest match, undefeated bots, and the champion
+func getBrettZoneEventHighlightsTool(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	matches, err := getBrettZoneLatestMatches(tournamentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+
+	highlights, decidedCount := brettZoneEventHighlights(matches)
+
+	result := map[string]interface{}{
+		"tournamentID":      tournamentID,
+		"matchCount":        len(matches),
+		"decidedMatchCount": decidedCount,
+		"highlights":        highlights,
+		"note":              "Upsets are scored against current Active season rankings; a null highlight means no qualifying match yet",
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Maximum number of bots whose fight histories list_win_methods scans for a weight class
+const maxWinMethodBots = 25
+
+// getNHRLWinMethodsTool lists the distinct win-method strings actually used, with
+// counts. With a tournament_id it reads BrettZone win annotations (optionally
+// filtered by weight_class); otherwise it reads statsbook result_by values from
+// the fight histories of a weight class's top Active season bots.
+func getNHRLWinMethodsTool(args map[string]interface{}) (string, error) {
+	tournamentID, _ := args["tournament_id"].(string)
+	weightClass, _ := args["weight_class"].(string)
+	if tournamentID == "" && weightClass == "" {
+		return "", fmt.Errorf("tournament_id or weight_class is required for list_win_methods operation")
+	}
+
+	counts := make(map[string]int)
+	result := map[string]interface{}{}
+
+	if tournamentID != "" {
+		weightClassFilter, err := brettZoneWeightClassFilter(args)
+		if err != nil {
+			return "", err
+		}
+
+		matches, err := getBrettZoneLatestMatches(tournamentID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get tournament matches: %w", err)
+		}
+
+		for _, match := range matches {
+			if getMatchWinner(match) == "undecided" {
+				continue
+			}
+			if weightClassFilter != "" && strings.TrimSuffix(match.WeightClass, "lb") != weightClassFilter {
+				continue
+			}
+			counts[strings.TrimSpace(match.WinAnnotation)]++
+		}
+
+		result["tournamentID"] = tournamentID
+		result["source"] = "brettzone_win_annotation"
+	} else {
+		statSummary, err := getNHRLStatSummary(getWeightClassCategoryID(weightClass), getSeasonID("Active"))
+		if err != nil {
+			return "", fmt.Errorf("failed to get weight class stat summary: %w", err)
+		}
+		sort.SliceStable(statSummary, func(i, j int) bool {
+			return statSummary[i].Fights > statSummary[j].Fights
+		})
+		if len(statSummary) > maxWinMethodBots {
+			statSummary = statSummary[:maxWinMethodBots]
+		}
+
+		// A fight between two sampled bots appears in both histories; count it once
+		seen := make(map[string]bool)
+		botsScanned := 0
+		for _, stat := range statSummary {
+			fights, err := getNHRLFights(stat.Bot)
+			if err != nil {
+				continue
+			}
+			botsScanned++
+			for _, fight := range fights {
+				key := fmt.Sprintf("%s|%s|%d", fight.Date, fight.Round, fight.MatchNum)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				counts[strings.TrimSpace(fight.ResultBy)]++
+			}
+		}
+
+		result["bots_scanned"] = botsScanned
+		result["source"] = "statsbook_result_by"
+	}
+	if weightClass != "" {
+		result["weight_class"] = weightClass
+	}
+
+	type methodCount struct {
+		Method string `json:"method"`
+		Count  int    `json:"count"`
+	}
+	methods := make([]methodCount, 0, len(counts))
+	total := 0
+	for method, count := range counts {
+		if method == "" {
+			method = "(blank)"
+		}
+		methods = append(methods, methodCount{Method: method, Count: count})
+		total += count
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		if methods[i].Count != methods[j].Count {
+			return methods[i].Count > methods[j].Count
+		}
+		return methods[i].Method < methods[j].Method
+	})
+
+	result["method_count"] = len(methods)
+	result["fights_counted"] = total
+	result["methods"] = methods
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Number of KOs listed in get_results_summary
+const resultsSummaryKOCount = 3
+
+// getBrettZoneResultsSummaryTool returns a compact, shareable recap of a tournament:
+// champion, finalists, notable KOs, and match count. Defaults to markdown.
+func getBrettZoneResultsSummaryTool(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	format := outputFormatMarkdown
+	if _, ok := args["output_format"]; ok {
+		f, err := getOutputFormat(args, outputFormatMarkdown, outputFormatText)
+		if err != nil {
+			return "", err
+		}
+		format = f
+	}
+
+	matches, err := getBrettZoneLatestMatches(tournamentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+
+	highlights, decidedCount := brettZoneEventHighlights(matches)
+
+	tournamentName := tournamentID
+	for _, match := range matches {
+		if match.TournamentName != "" {
+			tournamentName = match.TournamentName
+			break
+		}
+	}
+
+	// Notable KOs: the quickest fights that didn't go to the judges
+	type koMatch struct {
+		winner string
+		loser  string
+		round  string
+		length float64
+	}
+	var kos []koMatch
+	for _, match := range matches {
+		winner := getMatchWinner(match)
+		if winner == "undecided" || isJudgesDecision(match.WinAnnotation) {
+			continue
+		}
+		length, err := strconv.ParseFloat(match.MatchLength, 64)
+		if err != nil || length <= 0 {
+			continue
+		}
+		loser := match.Player2
+		if winner == match.Player2 {
+			loser = match.Player1
+		}
+		kos = append(kos, koMatch{winner: winner, loser: loser, round: match.Round, length: length})
+	}
+	sort.SliceStable(kos, func(i, j int) bool {
+		return kos[i].length < kos[j].length
+	})
+	if len(kos) > resultsSummaryKOCount {
+		kos = kos[:resultsSummaryKOCount]
+	}
+
+	var championName, runnerUp string
+	if champion, ok := highlights["champion"].(map[string]interface{}); ok {
+		championName, _ = champion["bot"].(string)
+		runnerUp, _ = champion["runnerUp"].(string)
+	}
+
+	koLines := make([]string, 0, len(kos))
+	for _, ko := range kos {
+		koLines = append(koLines, fmt.Sprintf("%s over %s in %.0fs (%s)", ko.winner, ko.loser, ko.length, ko.round))
+	}
+
+	switch format {
+	case outputFormatText:
+		var sb strings.Builder
+		sb.WriteString(tournamentName + " results\n")
+		if championName != "" {
+			sb.WriteString(fmt.Sprintf("Champion: %s (def. %s in the final)\n", championName, runnerUp))
+		} else {
+			sb.WriteString("Champion: not decided yet\n")
+		}
+		if len(koLines) > 0 {
+			sb.WriteString("Notable KOs: " + strings.Join(koLines, "; ") + "\n")
+		}
+		sb.WriteString(fmt.Sprintf("%d matches fought", decidedCount))
+		return sb.String(), nil
+
+	case outputFormatMarkdown:
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("## %s results\n\n", tournamentName))
+		if championName != "" {
+			sb.WriteString(fmt.Sprintf("**Champion:** %s\n", championName))
+			sb.WriteString(fmt.Sprintf("**Finalists:** %s vs %s\n", championName, runnerUp))
+		} else {
+			sb.WriteString("**Champion:** not decided yet\n")
+		}
+		if len(koLines) > 0 {
+			sb.WriteString("\n**Notable KOs:**\n")
+			for _, line := range koLines {
+				sb.WriteString("- " + line + "\n")
+			}
+		}
+		sb.WriteString(fmt.Sprintf("\n**Matches fought:** %d\n", decidedCount))
+		return sb.String(), nil
+	}
+
+	notableKOs := make([]map[string]interface{}, 0, len(kos))
+	for _, ko := range kos {
+		notableKOs = append(notableKOs, map[string]interface{}{
+			"winner":          ko.winner,
+			"loser":           ko.loser,
+			"round":           ko.round,
+			"matchLengthSecs": ko.length,
+		})
+	}
+
+	result := map[string]interface{}{
+		"tournamentID":      tournamentID,
+		"tournamentName":    tournamentName,
+		"champion":          championName,
+		"finalists":         []string{},
+		"notableKOs":        notableKOs,
+		"decidedMatchCount": decidedCount,
+	}
+	if championName != "" {
+		result["finalists"] = []string{championName, runnerUp}
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// brettZoneEventHighlights computes a tournament's recap highlights from its
+// matches, returning them with the number of decided matches
+func brettZoneEventHighlights(matches []BrettZoneMatch) (map[string]interface{}, int) {
+	// Active season rankings per weight class, used to score upsets
+	rankingsByClass := make(map[string]map[string]int)
+	rankFor := func(weightClass, botName string) int {
+		rankings, ok := rankingsByClass[weightClass]
+		if !ok {
+			rankings = make(map[string]int)
+			// BrettZone may report the class as "3" or "3lb"
+			pounds := strings.TrimSuffix(strings.TrimSpace(weightClass), "lb")
+			statSummary, err := getNHRLStatSummary(getWeightClassCategoryID(pounds+"lb"), getSeasonID("Active"))
+			if err == nil {
+				for _, stat := range statSummary {
+					if stat.Ranking > 0 {
+						rankings[strings.ToLower(normalizeBotName(stat.Bot))] = stat.Ranking
+					}
+				}
+			}
+			rankingsByClass[weightClass] = rankings
+		}
+		return rankings[strings.ToLower(normalizeBotName(botName))]
+	}
+
+	highlightFor := func(match BrettZoneMatch, winner, loser string, length float64) map[string]interface{} {
+		return map[string]interface{}{
+			"matchID":         match.ID,
+			"matchName":       match.Name,
+			"round":           match.Round,
+			"roundName":       getQualificationRoundName(match.Round),
+			"cage":            match.Cage,
+			"winner":          winner,
+			"loser":           loser,
+			"winMethod":       match.WinAnnotation,
+			"matchLengthSecs": length,
+			"weightClass":     match.WeightClass + "lb",
+			"reviewURL":       generateBrettZoneReviewURL(match.ID, match.TournamentID, extractCageNumber(match.Cage), 3.0),
+		}
+	}
+
+	type botRecord struct {
+		name   string
+		wins   int
+		losses int
+	}
+	records := make(map[string]*botRecord)
+	recordFor := func(botName string) *botRecord {
+		key := strings.ToLower(normalizeBotName(botName))
+		if records[key] == nil {
+			records[key] = &botRecord{name: botName}
+		}
+		return records[key]
+	}
+
+	var fastestKO, longestMatch, biggestUpset, champion map[string]interface{}
+	fastestKOLength, longestLength, biggestRankGap := 0.0, 0.0, 0
+	decidedCount := 0
+
+	for _, match := range matches {
+		winner := getMatchWinner(match)
+		if winner == "undecided" {
+			continue
+		}
+		loser := match.Player2
+		if winner == match.Player2 {
+			loser = match.Player1
+		}
+		decidedCount++
+
+		recordFor(winner).wins++
+		recordFor(loser).losses++
+
+		length, err := strconv.ParseFloat(match.MatchLength, 64)
+		hasLength := err == nil && length > 0
+
+		// Fastest KO: shortest fight that didn't go to the judges
+		if hasLength && !isJudgesDecision(match.WinAnnotation) && (fastestKO == nil || length < fastestKOLength) {
+			fastestKO = highlightFor(match, winner, loser, length)
+			fastestKOLength = length
+		}
+
+		if hasLength && (longestMatch == nil || length > longestLength) {
+			longestMatch = highlightFor(match, winner, loser, length)
+			longestLength = length
+		}
+
+		// Biggest upset: largest rank gap where the lower-ranked bot won
+		winnerRank, loserRank := rankFor(match.WeightClass, winner), rankFor(match.WeightClass, loser)
+		if winnerRank > 0 && loserRank > 0 && winnerRank-loserRank > biggestRankGap {
+			biggestUpset = highlightFor(match, winner, loser, length)
+			biggestUpset["winnerRank"] = winnerRank
+			biggestUpset["loserRank"] = loserRank
+			biggestUpset["rankGap"] = winnerRank - loserRank
+			biggestRankGap = winnerRank - loserRank
+		}
+	}
+
+	// Champion: winner of the last grand finals match played, so a bracket
+	// reset decides the title
+	if final, ok := lastGrandFinal(matches); ok && getMatchWinner(final) != "undecided" {
+		winner := getMatchWinner(final)
+		loser := final.Player2
+		if winner == final.Player2 {
+			loser = final.Player1
+		}
+		length, _ := strconv.ParseFloat(final.MatchLength, 64)
+		champion = highlightFor(final, winner, loser, length)
+	}
+
+	undefeated := make([]map[string]interface{}, 0)
+	for _, record := range records {
+		if record.losses == 0 && record.wins > 0 {
+			undefeated = append(undefeated, map[string]interface{}{
+				"bot":  record.name,
+				"wins": record.wins,
+			})
+		}
+	}
+	sort.SliceStable(undefeated, func(i, j int) bool {
+		if undefeated[i]["wins"].(int) != undefeated[j]["wins"].(int) {
+			return undefeated[i]["wins"].(int) > undefeated[j]["wins"].(int)
+		}
+		return strings.ToLower(undefeated[i]["bot"].(string)) < strings.ToLower(undefeated[j]["bot"].(string))
+	})
+
+	highlights := map[string]interface{}{
+		"fastestKO":      fastestKO,
+		"biggestUpset":   biggestUpset,
+		"longestMatch":   longestMatch,
+		"undefeatedBots": undefeated,
+		"champion":       nil,
+	}
+	if champion != nil {
+		highlights["champion"] = map[string]interface{}{
+			"bot":        champion["winner"],
+			"runnerUp":   champion["loser"],
+			"finalMatch": champion,
+		}
+	}
+
+	return highlights, decidedCount
+}
+
+// Tournaments fetched at once by get_weekly_digest
+const weeklyDigestConcurrency = 4
+
+// Default look-back window for get_weekly_digest when no tournaments are given
+const defaultDigestWindow = 7 * 24 * time.Hour
+
+// digestTournamentIDs lists TrueFinals tournaments scheduled to start in
+// [from, to], skipping test tournaments
+func digestTournamentIDs(from, to time.Time) ([]string, error) {
+	window, err := listScheduledTournaments(from, to, false)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(window.Tournaments))
+	for _, tournament := range window.Tournaments {
+		ids = append(ids, tournament.ID)
+	}
+	return ids, nil
+}
+
+// getBrettZoneWeeklyDigestTool summarizes several events in one digest:
+// champions, notable upsets, fastest KOs, and bots that went unbeaten
+func getBrettZoneWeeklyDigestTool(args map[string]interface{}) (string, error) {
+	tournamentIDs := getTournamentIDsArg(args)
+
+	result := map[string]interface{}{}
+	if len(tournamentIDs) == 0 {
+		to := time.Now().UTC()
+		if v, ok := args["to"]; ok {
+			t, err := parseWindowTime(v)
+			if err != nil {
+				return "", fmt.Errorf("to: %w", err)
+			}
+			// A bare date includes the whole day
+			if s, ok := v.(string); ok && len(strings.TrimSpace(s)) == len("2006-01-02") {
+				t = t.Add(24*time.Hour - time.Second)
+			}
+			to = t
+		}
+		from := to.Add(-defaultDigestWindow)
+		if v, ok := args["from"]; ok {
+			t, err := parseWindowTime(v)
+			if err != nil {
+				return "", fmt.Errorf("from: %w", err)
+			}
+			from = t
+		}
+		if to.Before(from) {
+			return "", fmt.Errorf("to must not be before from")
+		}
+
+		ids, err := digestTournamentIDs(from, to)
+		if err != nil {
+			return "", err
+		}
+		tournamentIDs = ids
+		result["from"] = from.Format(time.RFC3339)
+		result["to"] = to.Format(time.RFC3339)
+	}
+	if len(tournamentIDs) == 0 {
+		return "", fmt.Errorf("no tournaments found for the digest")
+	}
+	if len(tournamentIDs) > maxLiveScanTournaments {
+		result["note"] = fmt.Sprintf("Only the first %d of %d tournaments were included", maxLiveScanTournaments, len(tournamentIDs))
+		tournamentIDs = tournamentIDs[:maxLiveScanTournaments]
+	}
+
+	type eventDigest struct {
+		tournamentID string
+		highlights   map[string]interface{}
+		matchCount   int
+		err          error
+	}
+
+	// Fetch events in parallel, at most weeklyDigestConcurrency at a time
+	digests := make([]eventDigest, len(tournamentIDs))
+	sem := make(chan struct{}, weeklyDigestConcurrency)
+	var wg sync.WaitGroup
+	for i, tournamentID := range tournamentIDs {
+		wg.Add(1)
+		go func(i int, tournamentID string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			digests[i].tournamentID = tournamentID
+			matches, err := getBrettZoneLatestMatches(tournamentID)
+			if err != nil {
+				digests[i].err = err
+				return
+			}
+			digests[i].highlights, digests[i].matchCount = brettZoneEventHighlights(matches)
+		}(i, tournamentID)
+	}
+	wg.Wait()
+
+	champions := make([]map[string]interface{}, 0)
+	upsets := make([]map[string]interface{}, 0)
+	fastestKOs := make([]map[string]interface{}, 0)
+	hotBots := make([]map[string]interface{}, 0)
+	var failed []string
+	eventCount := 0
+	for _, digest := range digests {
+		if digest.err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", digest.tournamentID, digest.err))
+			continue
+		}
+		if digest.matchCount == 0 {
+			continue
+		}
+		eventCount++
+
+		highlights := digest.highlights
+		if champion, ok := highlights["champion"].(map[string]interface{}); ok {
+			champions = append(champions, map[string]interface{}{
+				"tournamentID": digest.tournamentID,
+				"champion":     champion["bot"],
+				"runnerUp":     champion["runnerUp"],
+			})
+		}
+		for key, list := range map[string]*[]map[string]interface{}{"biggestUpset": &upsets, "fastestKO": &fastestKOs} {
+			if highlight, ok := highlights[key].(map[string]interface{}); ok && highlight != nil {
+				highlight["tournamentID"] = digest.tournamentID
+				*list = append(*list, highlight)
+			}
+		}
+		if undefeated, ok := highlights["undefeatedBots"].([]map[string]interface{}); ok {
+			for _, bot := range undefeated {
+				hotBots = append(hotBots, map[string]interface{}{
+					"tournamentID": digest.tournamentID,
+					"bot":          bot["bot"],
+					"wins":         bot["wins"],
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(upsets, func(i, j int) bool {
+		return upsets[i]["rankGap"].(int) > upsets[j]["rankGap"].(int)
+	})
+	sort.SliceStable(fastestKOs, func(i, j int) bool {
+		return fastestKOs[i]["matchLengthSecs"].(float64) < fastestKOs[j]["matchLengthSecs"].(float64)
+	})
+	sort.SliceStable(hotBots, func(i, j int) bool {
+		return hotBots[i]["wins"].(int) > hotBots[j]["wins"].(int)
+	})
+
+	result["tournamentIDs"] = tournamentIDs
+	result["eventCount"] = eventCount
+	result["champions"] = champions
+	result["notableUpsets"] = upsets
+	result["fastestKOs"] = fastestKOs
+	result["hotBots"] = hotBots
+	if len(failed) > 0 {
+		result["failedTournaments"] = failed
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// botEventPath returns a bot's matches within an event's matches, in bracket order
+func botEventPath(matches []BrettZoneMatch, botName string) []BrettZoneMatch {
+	var botMatches []BrettZoneMatch
+	for _, match := range matches {
+		if botNamesMatch(match.Player1, botName) || botNamesMatch(match.Player2, botName) {
+			botMatches = append(botMatches, match)
+		}
+	}
+
+	sort.SliceStable(botMatches, func(i, j int) bool {
+		_, _, keyI := getBrettZoneRoundOrder(botMatches[i].Round)
+		_, _, keyJ := getBrettZoneRoundOrder(botMatches[j].Round)
+		return keyI < keyJ
+	})
+
+	return botMatches
+}
+
+// Bracket wins after a Q1 loss that count as a deep comeback run
+const comebackDeepRunWins = 2
+
+// getBrettZoneComebackRunsTool finds bots that lost their opening qualifier
+// (Q1) but still made the main bracket, ranked by how far they went
+func getBrettZoneComebackRunsTool(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	matches, err := getBrettZoneLatestMatches(tournamentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+
+	// Bots whose Q1 match was decided against them
+	var q1Losers []string
+	for _, match := range matches {
+		if !strings.EqualFold(strings.TrimSpace(match.Round), "Q1") {
+			continue
+		}
+		winner := getMatchWinner(match)
+		if winner == "undecided" {
+			continue
+		}
+		loser := match.Player1
+		if botNamesMatch(winner, match.Player1) {
+			loser = match.Player2
+		}
+		if loser != "" {
+			q1Losers = append(q1Losers, loser)
+		}
+	}
+
+	type comebackRun struct {
+		Bot           string   `json:"botName"`
+		Path          []string `json:"path"`
+		BracketWins   int      `json:"bracketWins"`
+		BracketLosses int      `json:"bracketLosses"`
+		FurthestRound string   `json:"furthestRound"`
+		ReachedFinals bool     `json:"reachedFinals"`
+		DeepRun       bool     `json:"deepRun"`
+		sortKey       int
+	}
+
+	var runs []comebackRun
+	for _, botName := range q1Losers {
+		run := comebackRun{Bot: botName}
+		madeBracket := false
+		for _, match := range botEventPath(matches, botName) {
+			section, _, key := getBrettZoneRoundOrder(match.Round)
+			winner := getMatchWinner(match)
+
+			step := match.Round
+			switch {
+			case winner == "undecided":
+				step += " (pending)"
+			case botNamesMatch(winner, botName):
+				step += " W"
+			default:
+				step += " L"
+			}
+			run.Path = append(run.Path, step)
+
+			if section == "qualifying" || (section == "other" && !isFinalsRound(match.Round)) {
+				continue
+			}
+			madeBracket = true
+			if isFinalsRound(match.Round) {
+				run.ReachedFinals = true
+			}
+			if key >= run.sortKey {
+				run.sortKey = key
+				run.FurthestRound = match.Round
+			}
+			if winner != "undecided" {
+				if botNamesMatch(winner, botName) {
+					run.BracketWins++
+				} else {
+					run.BracketLosses++
+				}
+			}
+		}
+		if !madeBracket {
+			continue
+		}
+		run.DeepRun = run.ReachedFinals || run.BracketWins >= comebackDeepRunWins
+		runs = append(runs, run)
+	}
+
+	// Deepest runs first: finals appearances, then bracket wins
+	sort.SliceStable(runs, func(i, j int) bool {
+		if runs[i].ReachedFinals != runs[j].ReachedFinals {
+			return runs[i].ReachedFinals
+		}
+		if runs[i].BracketWins != runs[j].BracketWins {
+			return runs[i].BracketWins > runs[j].BracketWins
+		}
+		return strings.ToLower(runs[i].Bot) < strings.ToLower(runs[j].Bot)
+	})
+
+	deepRuns := 0
+	for _, run := range runs {
+		if run.DeepRun {
+			deepRuns++
+		}
+	}
+
+	result := map[string]interface{}{
+		"tournamentID":  tournamentID,
+		"q1LossCount":   len(q1Losers),
+		"comebackCount": len(runs),
+		"deepRunCount":  deepRuns,
+		"comebacks":     runs,
+		"note":          fmt.Sprintf("Comebacks are bots that lost Q1 yet made the main bracket via Redemption (Q2L) and Bubble (Q3); deepRun marks a finals appearance or at least %d bracket wins", comebackDeepRunWins),
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// getBrettZoneBotEventMatchesTool returns one bot's matches within a single tournament, in bracket order
+func getBrettZoneBotEventMatchesTool(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	botName, ok := args["bot_name"].(string)
+	if !ok || strings.TrimSpace(botName) == "" {
+		return "", fmt.Errorf("bot_name is required for get_bot_event_matches operation")
+	}
+
+	matches, err := getBrettZoneLatestMatches(tournamentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+
+	botMatches := botEventPath(matches, botName)
+
+	wins, losses := 0, 0
+	results := make([]map[string]interface{}, len(botMatches))
+	for i, match := range botMatches {
+		opponent := match.Player2
+		if botNamesMatch(match.Player2, botName) {
+			opponent = match.Player1
+		}
+
+		outcome := "pending"
+		if winner := getMatchWinner(match); winner != "undecided" {
+			if botNamesMatch(winner, botName) {
+				outcome = "win"
+				wins++
+			} else {
+				outcome = "loss"
+				losses++
+			}
+		}
+
+		results[i] = map[string]interface{}{
+			"matchID":         match.ID,
+			"matchName":       match.Name,
+			"round":           match.Round,
+			"roundName":       getQualificationRoundName(match.Round),
+			"cage":            match.Cage,
+			"opponent":        opponent,
+			"result":          outcome,
+			"winMethod":       match.WinAnnotation,
+			"matchLengthSecs": match.MatchLength,
+			"reviewURL":       generateBrettZoneReviewURL(match.ID, match.TournamentID, extractCageNumber(match.Cage), 3.0),
+		}
+	}
+
+	result := map[string]interface{}{
+		"tournamentID": tournamentID,
+		"botName":      botName,
+		"matchCount":   len(results),
+		"wins":         wins,
+		"losses":       losses,
+		"matches":      results,
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// getBrettZoneTournamentCamerasTool lists the distinct cameras seen across a tournament's matches, grouped by cage
+func getBrettZoneTournamentCamerasTool(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok || tournamentID == "" {
+		return "", fmt.Errorf("tournament_id parameter is required")
+	}
+
+	matches, err := getBrettZoneLatestMatches(tournamentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+
+	// cage -> camera -> number of matches it appeared in
+	cageCameras := make(map[string]map[string]int)
+	allCameras := make(map[string]bool)
+	for _, match := range matches {
+		cage := strings.TrimSpace(match.Cage)
+		if cage == "" {
+			cage = "unassigned"
+		}
+		for _, camera := range parseBrettZoneCams(match.Cams) {
+			camera = strings.TrimSpace(camera)
+			if camera == "" {
+				continue
+			}
+			if cageCameras[cage] == nil {
+				cageCameras[cage] = make(map[string]int)
+			}
+			cageCameras[cage][camera]++
+			allCameras[camera] = true
+		}
+	}
+
+	cages := make([]string, 0, len(cageCameras))
+	for cage := range cageCameras {
+		cages = append(cages, cage)
+	}
+	sort.Slice(cages, func(i, j int) bool {
+		numI, numJ := extractCageNumber(cages[i]), extractCageNumber(cages[j])
+		if numI != numJ {
+			return numI < numJ
+		}
+		return cages[i] < cages[j]
+	})
+
+	byCage := make([]map[string]interface{}, len(cages))
+	for i, cage := range cages {
+		cameras := make([]map[string]interface{}, 0, len(cageCameras[cage]))
+		for camera, count := range cageCameras[cage] {
+			cameras = append(cameras, map[string]interface{}{
+				"camera":     camera,
+				"matchCount": count,
+			})
+		}
+		sort.Slice(cameras, func(a, b int) bool {
+			return cameras[a]["camera"].(string) < cameras[b]["camera"].(string)
+		})
+		byCage[i] = map[string]interface{}{
+			"cage":        cage,
+			"cameraCount": len(cameras),
+			"cameras":     cameras,
+		}
+	}
+
+	distinct := make([]string, 0, len(allCameras))
+	for camera := range allCameras {
+		distinct = append(distinct, camera)
+	}
+	sort.Strings(distinct)
+
+	result := map[string]interface{}{
+		"tournamentID":  tournamentID,
+		"matchCount":    len(matches),
+		"cameraCount":   len(distinct),
+		"cameras":       distinct,
+		"camerasByCage": byCage,
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Maximum number of tournaments find_bot_live and get_record_by_cage will scan in one call
+const maxLiveScanTournaments = 10
+
+// getTournamentIDsArg reads tournament_ids as either a list of IDs or a
+// comma-separated string, falling back to a single tournament_id
+func getTournamentIDsArg(args map[string]interface{}) []string {
+	var tournamentIDs []string
+	switch ids := args["tournament_ids"].(type) {
+	case []interface{}:
+		for _, id := range ids {
+			if s, ok := id.(string); ok && strings.TrimSpace(s) != "" {
+				tournamentIDs = append(tournamentIDs, strings.TrimSpace(s))
+			}
+		}
+	case string:
+		for _, s := range strings.Split(ids, ",") {
+			if strings.TrimSpace(s) != "" {
+				tournamentIDs = append(tournamentIDs, strings.TrimSpace(s))
+			}
+		}
+	}
+	if len(tournamentIDs) == 0 {
+		if id, ok := args["tournament_id"].(string); ok && id != "" {
+			tournamentIDs = []string{id}
+		}
+	}
+	return tournamentIDs
+}
+
+// Attrition funnel stages, from entry to the title
+var attritionStages = []string{"entered", "survived_redemption", "made_bracket", "reached_finals", "champion"}
+
+// eventAttrition counts how many bots remain at each attrition stage in one
+// event's matches: every entrant, those not knocked out in Redemption (Q2L),
+// those who played in the main bracket, those who reached a finals round, and
+// the champion (the winner of the last decided grand final)
+func eventAttrition(matches []BrettZoneMatch) map[string]int {
+	entrants := make(map[string]bool)
+	redemptionLosers := make(map[string]bool)
+	bracket := make(map[string]bool)
+	finalists := make(map[string]bool)
+
+	for _, match := range matches {
+		players := []string{match.Player1, match.Player2}
+		for _, name := range players {
+			if name != "" {
+				entrants[strings.ToLower(normalizeBotName(name))] = true
+			}
+		}
+
+		section, _, _ := getBrettZoneRoundOrder(match.Round)
+		winner := getMatchWinner(match)
+		inBracket := section == "winners" || section == "losers" || section == "grand_finals" || isFinalsRound(match.Round)
+
+		for _, name := range players {
+			if name == "" {
+				continue
+			}
+			botKey := strings.ToLower(normalizeBotName(name))
+			if inBracket {
+				bracket[botKey] = true
+			}
+			if isFinalsRound(match.Round) {
+				finalists[botKey] = true
+			}
+		}
+
+		if winner == "undecided" {
+			continue
+		}
+		if strings.EqualFold(strings.TrimSpace(match.Round), "Q2L") {
+			loser := match.Player1
+			if botNamesMatch(winner, match.Player1) {
+				loser = match.Player2
+			}
+			redemptionLosers[strings.ToLower(normalizeBotName(loser))] = true
+		}
+	}
+
+	counts := map[string]int{
+		"entered":             len(entrants),
+		"survived_redemption": len(entrants) - len(redemptionLosers),
+		"made_bracket":        len(bracket),
+		"reached_finals":      len(finalists),
+		"champion":            0,
+	}
+	// The last grand final played decides the title, so an event waiting on a
+	// bracket reset has no champion yet
+	if final, ok := lastGrandFinal(matches); ok && getMatchWinner(final) != "undecided" {
+		counts["champion"] = 1
+	}
+	return counts
+}
+
+// getBrettZoneAttritionTool averages how many competitors remain after each
+// stage of an event (qualifying, bracket, finals) across several tournaments
+func getBrettZoneAttritionTool(args map[string]interface{}) (string, error) {
+	tournamentIDs := getTournamentIDsArg(args)
+	if len(tournamentIDs) == 0 {
+		return "", fmt.Errorf("tournament_ids or tournament_id is required for get_attrition operation")
+	}
+	if len(tournamentIDs) > maxLiveScanTournaments {
+		return "", fmt.Errorf("too many tournament_ids (max %d)", maxLiveScanTournaments)
+	}
+
+	weightClassFilter, err := brettZoneWeightClassFilter(args)
+	if err != nil {
+		return "", err
+	}
+
+	var events []map[string]interface{}
+	var failed []string
+	totals := make(map[string]int)
+	for _, tournamentID := range tournamentIDs {
+		matches, err := getBrettZoneLatestMatches(tournamentID)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", tournamentID, err))
+			continue
+		}
+
+		var filtered []BrettZoneMatch
+		for _, match := range matches {
+			if weightClassFilter == "" || strings.TrimSuffix(match.WeightClass, "lb") == weightClassFilter {
+				filtered = append(filtered, match)
+			}
+		}
+		counts := eventAttrition(filtered)
+		if counts["entered"] == 0 {
+			continue
+		}
+
+		for _, stage := range attritionStages {
+			totals[stage] += counts[stage]
+		}
+		events = append(events, map[string]interface{}{
+			"tournamentID": tournamentID,
+			"funnel":       counts,
+		})
+	}
+	if len(events) == 0 {
+		return "", fmt.Errorf("no matches found in the given tournaments")
+	}
+
+	funnel := make([]map[string]interface{}, len(attritionStages))
+	for i, stage := range attritionStages {
+		average := float64(totals[stage]) / float64(len(events))
+		funnel[i] = map[string]interface{}{
+			"stage":            stage,
+			"averageRemaining": math.Round(average*10) / 10,
+			"percentOfField":   math.Round(ratio(totals[stage], totals["entered"])*1000) / 10,
+		}
+	}
+
+	result := map[string]interface{}{
+		"eventCount":    len(events),
+		"averageFunnel": funnel,
+		"events":        events,
+		"note":          "Q1 losers are not eliminated; they drop to Redemption (Q2L). survived_redemption excludes Q2L losers, and made_bracket counts bots that played a main bracket match. Events still in progress show partial funnels.",
+	}
+	if weightClassFilter != "" {
+		result["weightClass"] = weightClassFilter + "lb"
+	}
+	if len(failed) > 0 {
+		result["failedTournaments"] = failed
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// getBrettZoneFindBotLiveTool scans several live tournaments for a bot's undecided matches
+// and reports where it is fighting, called, or up next
+func getBrettZoneFindBotLiveTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok || strings.TrimSpace(botName) == "" {
+		return "", fmt.Errorf("bot_name is required for find_bot_live operation")
+	}
+
+	tournamentIDs := getTournamentIDsArg(args)
+	if len(tournamentIDs) == 0 {
+		return "", fmt.Errorf("tournament_ids is required for find_bot_live operation")
+	}
+	if len(tournamentIDs) > maxLiveScanTournaments {
+		return "", fmt.Errorf("too many tournament_ids (max %d)", maxLiveScanTournaments)
+	}
+
+	// Most urgent status first
+	statusOrder := map[string]int{"fighting": 0, "called": 1, "available": 2, "scheduled": 3}
+
+	appearances := make([]map[string]interface{}, 0)
+	scanErrors := make(map[string]string)
+	for _, tournamentID := range tournamentIDs {
+		matches, err := getBrettZoneLatestMatches(tournamentID)
+		if err != nil {
+			scanErrors[tournamentID] = err.Error()
+			continue
+		}
+
+		for _, match := range matches {
+			if getMatchWinner(match) != "undecided" {
+				continue
+			}
+
+			var opponent string
+			switch {
+			case botNamesMatch(match.Player1, botName):
+				opponent = match.Player2
+			case botNamesMatch(match.Player2, botName):
+				opponent = match.Player1
+			default:
+				continue
+			}
+
+			_, started := parseBrettZoneTime(match.StartTime)
+			_, stopped := parseBrettZoneTime(match.StopTime)
+			_, called := parseBrettZoneTime(match.CalledSince)
+			_, available := parseBrettZoneTime(match.AvailableSince)
+
+			status := "scheduled"
+			switch {
+			case started && !stopped:
+				status = "fighting"
+			case called:
+				status = "called"
+			case available:
+				status = "available"
+			}
+
+			appearances = append(appearances, map[string]interface{}{
+				"tournamentID":   tournamentID,
+				"tournamentName": match.TournamentName,
+				"matchID":        match.ID,
+				"matchName":      match.Name,
+				"round":          match.Round,
+				"roundName":      getQualificationRoundName(match.Round),
+				"cage":           match.Cage,
+				"opponent":       opponent,
+				"status":         status,
+				"reviewURL":      generateBrettZoneReviewURL(match.ID, tournamentID, extractCageNumber(match.Cage), 3.0),
+			})
+		}
+	}
+
+	sort.SliceStable(appearances, func(i, j int) bool {
+		return statusOrder[appearances[i]["status"].(string)] < statusOrder[appearances[j]["status"].(string)]
+	})
+
+	result := map[string]interface{}{
+		"botName":            botName,
+		"tournamentsScanned": tournamentIDs,
+		"isLive":             len(appearances) > 0 && appearances[0]["status"] != "scheduled",
+		"matches":            appearances,
+	}
+	if len(scanErrors) > 0 {
+		result["errors"] = scanErrors
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// getBrettZoneRecordByCageTool tallies a bot's BrettZone wins and losses per cage.
+// Without a tournament scope, the bot's most recent tournaments are taken from
+// the BrettZone review links in its statsbook fight history.
+func getBrettZoneRecordByCageTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok || strings.TrimSpace(botName) == "" {
+		return "", fmt.Errorf("bot_name is required for get_record_by_cage operation")
+	}
+
+	tournamentIDs := getTournamentIDsArg(args)
+	scope := "requested"
+	if len(tournamentIDs) > maxLiveScanTournaments {
+		return "", fmt.Errorf("too many tournament_ids (max %d)", maxLiveScanTournaments)
+	}
+	if len(tournamentIDs) == 0 {
+		fights, err := getNHRLFights(botName)
+		if err != nil {
+			return "", fmt.Errorf("failed to get bot fights: %w", err)
+		}
+
+		// Newest first so the cap keeps the most recent events
+		sort.SliceStable(fights, func(i, j int) bool {
+			return fights[i].Date > fights[j].Date
+		})
+
+		seen := make(map[string]bool)
+		for _, fight := range fights {
+			_, id, ok := brettZoneReviewRef(fight.VideoLink)
+			if !ok || seen[id] {
+				continue
+			}
+			seen[id] = true
+			tournamentIDs = append(tournamentIDs, id)
+		}
+		if len(tournamentIDs) == 0 {
+			return "", fmt.Errorf("no BrettZone tournaments found in %s's fight history; pass tournament_ids to choose events", botName)
+		}
+		if len(tournamentIDs) > maxLiveScanTournaments {
+			tournamentIDs = tournamentIDs[:maxLiveScanTournaments]
+		}
+		scope = "recent_fight_history"
+	}
+
+	type cageRecord struct {
+		Cage       string  `json:"cage"`
+		CageNumber int     `json:"cageNumber,omitempty"`
+		Wins       int     `json:"wins"`
+		Losses     int     `json:"losses"`
+		Matches    int     `json:"matches"`
+		WinPct     float64 `json:"winPct"`
+	}
+
+	records := make(map[string]*cageRecord)
+	totalWins, totalLosses := 0, 0
+	scanErrors := make(map[string]string)
+	for _, tournamentID := range tournamentIDs {
+		matches, err := getBrettZoneLatestMatches(tournamentID)
+		if err != nil {
+			scanErrors[tournamentID] = err.Error()
+			continue
+		}
+
+		for _, match := range matches {
+			if !botNamesMatch(match.Player1, botName) && !botNamesMatch(match.Player2, botName) {
+				continue
+			}
+			winner := getMatchWinner(match)
+			if winner == "undecided" {
+				continue
+			}
+
+			cage := strings.TrimSpace(match.Cage)
+			if cage == "" {
+				cage = "Unknown"
+			}
+			record, ok := records[cage]
+			if !ok {
+				record = &cageRecord{Cage: cage}
+				if cage != "Unknown" {
+					record.CageNumber = extractCageNumber(cage)
+				}
+				records[cage] = record
+			}
+
+			record.Matches++
+			if botNamesMatch(winner, botName) {
+				record.Wins++
+				totalWins++
+			} else {
+				record.Losses++
+				totalLosses++
+			}
+		}
+	}
+
+	cages := make([]cageRecord, 0, len(records))
+	for _, record := range records {
+		record.WinPct = math.Round(ratio(record.Wins, record.Matches)*1000) / 10
+		cages = append(cages, *record)
+	}
+	sort.Slice(cages, func(i, j int) bool {
+		if cages[i].CageNumber != cages[j].CageNumber {
+			return cages[i].CageNumber < cages[j].CageNumber
+		}
+		return cages[i].Cage < cages[j].Cage
+	})
+
+	result := map[string]interface{}{
+		"botName":            botName,
+		"scope":              scope,
+		"tournamentsScanned": tournamentIDs,
+		"wins":               totalWins,
+		"losses":             totalLosses,
+		"cages":              cages,
+	}
+	if len(scanErrors) > 0 {
+		result["errors"] = scanErrors
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Maximum number of bots whose fight histories get_closest_fights scans for a weight class
+const maxClosestFightBots = 25
+
+// Maximum number of concurrent statsbook fight history requests get_closest_fights makes
+const closestFightConcurrency = 5
+
+// getBrettZoneClosestFightsTool returns a weight class's fights that went the
+// distance to a judges' decision, longest first. It scans the statsbook fight
+// histories of the weight class's most active Active season bots; with a
+// tournament_id it reads that BrettZone tournament's matches instead.
+func getBrettZoneClosestFightsTool(args map[string]interface{}) (string, error) {
+	weightClassArg, _ := args["weight_class"].(string)
+	weightClass := weightClassArg
+	if weightClass == "" {
+		weightClass = "3lb"
+	}
+	tournamentID, _ := args["tournament_id"].(string)
+
+	limit := 25
+	if l, ok := args["limit"].(float64); ok && l > 0 {
+		limit = int(l)
+	}
+
+	type decision struct {
+		length float64
+		fight  map[string]interface{}
+	}
+	var decisions []decision
+	result := map[string]interface{}{}
+
+	if tournamentID != "" {
+		// A tournament is only narrowed to one weight class when the caller asks for it
+		weightClassFilter, err := brettZoneWeightClassFilter(args)
+		if err != nil {
+			return "", err
+		}
+
+		matches, err := getBrettZoneLatestMatches(tournamentID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get tournament matches: %w", err)
+		}
+
+		for _, match := range matches {
+			if !isJudgesDecision(match.WinAnnotation) || getMatchWinner(match) == "undecided" {
+				continue
+			}
+			if weightClassFilter != "" && strings.TrimSuffix(match.WeightClass, "lb") != weightClassFilter {
+				continue
+			}
+			length, err := strconv.ParseFloat(match.MatchLength, 64)
+			if err != nil {
+				continue
+			}
+			winner := getMatchWinner(match)
+			loser := match.Player2
+			if winner == match.Player2 {
+				loser = match.Player1
+			}
+			decisions = append(decisions, decision{length: length, fight: map[string]interface{}{
+				"matchID":         match.ID,
+				"matchName":       match.Name,
+				"round":           match.Round,
+				"roundName":       getQualificationRoundName(match.Round),
+				"cage":            match.Cage,
+				"winner":          winner,
+				"loser":           loser,
+				"winMethod":       match.WinAnnotation,
+				"matchLengthSecs": length,
+				"reviewURL":       generateBrettZoneReviewURL(match.ID, match.TournamentID, extractCageNumber(match.Cage), 3.0),
+			}})
+		}
+		if weightClassArg != "" {
+			result["weightClass"] = weightClassArg
+		}
+		result["tournamentID"] = tournamentID
+		result["source"] = "brettzone"
+	} else {
+		statSummary, err := getNHRLStatSummary(getWeightClassCategoryID(weightClass), getSeasonID("Active"))
+		if err != nil {
+			return "", fmt.Errorf("failed to get weight class stat summary: %w", err)
+		}
+		sort.SliceStable(statSummary, func(i, j int) bool {
+			return statSummary[i].Fights > statSummary[j].Fights
+		})
+		if len(statSummary) > maxClosestFightBots {
+			statSummary = statSummary[:maxClosestFightBots]
+		}
+
+		histories := make([][]NHRLFight, len(statSummary))
+		sem := make(chan struct{}, closestFightConcurrency)
+		var wg sync.WaitGroup
+		for i, stat := range statSummary {
+			wg.Add(1)
+			go func(i int, botName string) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				if fights, err := getNHRLFightsResolved(botName); err == nil {
+					histories[i] = fights
+				}
+			}(i, stat.Bot)
+		}
+		wg.Wait()
+
+		// A fight between two sampled bots appears in both histories; count it once
+		seen := make(map[string]bool)
+		botsScanned := 0
+		for i, stat := range statSummary {
+			if histories[i] == nil {
+				continue
+			}
+			botsScanned++
+			for _, fight := range histories[i] {
+				if !isJudgesDecision(fight.ResultBy) || fight.FightLengthSecs == nil {
+					continue
+				}
+				length, err := strconv.ParseFloat(strings.TrimSpace(*fight.FightLengthSecs), 64)
+				if err != nil {
+					continue
+				}
+				key := fmt.Sprintf("%s|%s|%d", fight.Date, fight.Round, fight.MatchNum)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				entry := map[string]interface{}{
+					"date":            fight.Date,
+					"round":           fight.Round,
+					"roundName":       getQualificationRoundName(fight.Round),
+					"bot":             stat.Bot,
+					"opponent":        fight.OpponentName,
+					"result":          fightOutcome(fight),
+					"winMethod":       fight.ResultBy,
+					"matchLengthSecs": length,
+				}
+				if fight.EventName != "" {
+					entry["eventName"] = fight.EventName
+				}
+				if fight.VideoLink != nil {
+					entry["reviewURL"] = *fight.VideoLink
+				}
+				decisions = append(decisions, decision{length: length, fight: entry})
+			}
+		}
+		result["weightClass"] = weightClass
+		result["botsScanned"] = botsScanned
+		result["source"] = "statsbook"
+	}
+
+	// Longest fights first
+	sort.SliceStable(decisions, func(i, j int) bool {
+		return decisions[i].length > decisions[j].length
+	})
+	if len(decisions) > limit {
+		decisions = decisions[:limit]
+	}
+
+	fights := make([]map[string]interface{}, len(decisions))
+	for i, d := range decisions {
+		fights[i] = d.fight
+	}
+	result["fightCount"] = len(fights)
+	result["fights"] = fights
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// fightMethod classifies a statsbook result_by value as "ko", "jd", or "other"
+func fightMethod(resultBy string) string {
+	resultBy = strings.ToUpper(strings.TrimSpace(resultBy))
+	switch {
+	case strings.Contains(resultBy, "KO"):
+		return "ko"
+	case isJudgesDecision(resultBy):
+		return "jd"
+	}
+	return "other"
+}
+
+// isJudgesDecision reports whether a BrettZone win annotation denotes a judges' decision
+func isJudgesDecision(winAnnotation string) bool {
+	annotation := strings.ToUpper(strings.TrimSpace(winAnnotation))
+	return annotation == "JD" || strings.Contains(annotation, "JUDGE")
+}
+
+// Get a bot's first-ever and most-recent fights
+func getNHRLCareerBookendsTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok {
+		return "", fmt.Errorf("bot_name is required for get_career_bookends operation")
+	}
+
+	fights, err := getNHRLFightsResolved(botName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot fights: %w", err)
+	}
+
+	// The statsbook does not guarantee ordering, so scan for the extremes
+	var first, last *NHRLFight
+	var firstDate, lastDate time.Time
+	for i := range fights {
+		date, ok := parseStatsbookDate(fights[i].Date)
+		if !ok {
+			continue
+		}
+		if first == nil || date.Before(firstDate) {
+			first, firstDate = &fights[i], date
+		}
+		if last == nil || date.After(lastDate) {
+			last, lastDate = &fights[i], date
+		}
+	}
+
+	result := map[string]interface{}{
+		"bot_name":    botName,
+		"fight_count": len(fights),
+	}
+
+	if first == nil {
+		result["message"] = "No dated fights found for this bot"
+	} else {
+		result["first_fight"] = first
+		result["latest_fight"] = last
+		result["career_span_days"] = int(lastDate.Sub(firstDate).Hours() / 24)
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Statsbook fights and event winner records are dated by event, but a
+// multi-day event can span a few days; fights within this window of an event's
+// date are attributed to it
+const eventDateWindow = 3 * 24 * time.Hour
+
+// eventTypes are the event classes accepted by the event_type filter
+var eventTypes = []string{"monthly", "championship", "qualifier", "special"}
+
+// classifyEventType buckets an event by its name. The statsbook has no event
+// type field, so this is a name heuristic: "Championship", "World" or "Finals"
+// mark a championship, "Qualifier" a qualifier, and "Invitational",
+// "Exhibition", "Showcase" or "Special" a special event. Anything else,
+// including the numbered monthly opens, is a monthly.
+func classifyEventType(eventName string) string {
+	name := strings.ToLower(eventName)
+	switch {
+	case strings.Contains(name, "championship"), strings.Contains(name, "world"), strings.Contains(name, "finals"):
+		return "championship"
+	case strings.Contains(name, "qualifier"):
+		return "qualifier"
+	case strings.Contains(name, "invitational"), strings.Contains(name, "exhibition"),
+		strings.Contains(name, "showcase"), strings.Contains(name, "special"):
+		return "special"
+	}
+	return "monthly"
+}
+
+// eventTypeArg reads and validates the optional event_type parameter
+func eventTypeArg(args map[string]interface{}) (string, error) {
+	eventType, _ := args["event_type"].(string)
+	eventType = strings.ToLower(strings.TrimSpace(eventType))
+	if eventType == "" {
+		return "", nil
+	}
+	for _, t := range eventTypes {
+		if eventType == t {
+			return eventType, nil
+		}
+	}
+	return "", fmt.Errorf("invalid event_type %q: must be one of %s", eventType, strings.Join(eventTypes, ", "))
+}
+
+// filterFightsByEventType keeps the fights from events of the given type,
+// classified by the BrettZone event name getNHRLFightsResolved attached.
+// Fights with no resolved event cannot be classified; they are left out and
+// counted in unresolved.
+func filterFightsByEventType(fights []NHRLFight, eventType string) (filtered []NHRLFight, unresolved int) {
+	filtered = []NHRLFight{}
+	for _, fight := range fights {
+		switch {
+		case fight.EventName == "":
+			unresolved++
+		case classifyEventType(fight.EventName) == eventType:
+			filtered = append(filtered, fight)
+		}
+	}
+	return filtered, unresolved
+}
+
+// Compare a bot's qualifier record at each event with its final placement
+func getNHRLQualifierVsPlacementTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok {
+		return "", fmt.Errorf("bot_name is required for get_qualifier_vs_placement operation")
+	}
+
+	events, unmatched, err := getNHRLBotEvents(botName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot events: %w", err)
+	}
+
+	type eventSummary struct {
+		date             time.Time
+		TournamentID     string                   `json:"tournament_id"`
+		Date             string                   `json:"event_date"`
+		EventName        string                   `json:"event_name,omitempty"`
+		Placement        *int                     `json:"placement"`
+		QualifierWins    int                      `json:"qualifier_wins"`
+		QualifierLosses  int                      `json:"qualifier_losses"`
+		QualifierFights  []map[string]interface{} `json:"qualifier_fights"`
+		MadeBracket      bool                     `json:"made_bracket"`
+		BracketWins      int                      `json:"bracket_wins"`
+		BracketLosses    int                      `json:"bracket_losses"`
+		QualifierWinRate *float64                 `json:"qualifier_win_rate"`
+	}
+
+	summaries := make([]*eventSummary, 0, len(events))
+	for _, event := range events {
+		summary := &eventSummary{
+			date:            event.Date,
+			TournamentID:    event.TournamentID,
+			Date:            event.EventDate,
+			EventName:       event.EventName,
+			Placement:       event.Placement,
+			QualifierFights: []map[string]interface{}{},
+		}
+		for _, fight := range event.Fights {
+			outcome := fightOutcome(fight)
+			round := strings.ToUpper(strings.TrimSpace(fight.Round))
+			if strings.HasPrefix(round, "Q") {
+				summary.QualifierFights = append(summary.QualifierFights, map[string]interface{}{
+					"round":     round,
+					"opponent":  fight.OpponentName,
+					"outcome":   outcome,
+					"result_by": fight.ResultBy,
+				})
+				switch outcome {
+				case "win":
+					summary.QualifierWins++
+					// Winning Q2W or Q3 earns a bracket spot
+					if round == "Q2W" || round == "Q3" {
+						summary.MadeBracket = true
+					}
+				case "loss":
+					summary.QualifierLosses++
+				}
+				continue
+			}
+
+			// Any non-qualifier fight is a bracket fight
+			summary.MadeBracket = true
+			switch outcome {
+			case "win":
+				summary.BracketWins++
+			case "loss":
+				summary.BracketLosses++
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].date.After(summaries[j].date)
+	})
+
+	for _, summary := range summaries {
+		if total := summary.QualifierWins + summary.QualifierLosses; total > 0 {
+			rate := float64(summary.QualifierWins) / float64(total)
+			summary.QualifierWinRate = &rate
+		}
+	}
+
+	result := map[string]interface{}{
+		"bot_name":           botName,
+		"event_count":        len(summaries),
+		"events":             summaries,
+		"unmatched_fights":   unmatched,
+		"outcome_derivation": "Events, opponents, and outcomes come from the BrettZone match each fight's video links to; unmatched_fights counts fights without such a link. Placement is derived from the event's finished main bracket. Winning Q2W or Q3, or fighting any bracket round, counts as making the bracket.",
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get a consistency score for a bot from its event placement history.
+// The score is 100 * (1 - CV), clamped to 0-100, where CV is the coefficient
+// of variation (standard deviation / mean) of the bot's placements. A bot that
+// always finishes in the same spot scores 100; a feast-or-famine bot scores low.
+// Placements come from each event's BrettZone bracket (see brettZonePlacement).
+func getNHRLConsistencyTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok {
+		return "", fmt.Errorf("bot_name is required for get_consistency operation")
+	}
+
+	events, _, err := getNHRLBotEvents(botName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot events: %w", err)
+	}
+
+	placements := make([]map[string]interface{}, 0, len(events))
+	var values []float64
+	for _, event := range events {
+		if event.Placement == nil {
+			continue
+		}
+		values = append(values, float64(*event.Placement))
+		placements = append(placements, map[string]interface{}{
+			"event_date": event.EventDate,
+			"event_name": event.EventName,
+			"placement":  *event.Placement,
+		})
+	}
+
+	result := map[string]interface{}{
+		"bot_name":    botName,
+		"event_count": len(events),
+		"placements":  placements,
+		"metric":      "consistency_score = 100 * (1 - stddev/mean of placements), clamped to 0-100; higher is more consistent",
+	}
+
+	if len(values) < 2 {
+		result["consistency_score"] = nil
+		result["message"] = "At least two events with a recorded placement are needed to measure consistency"
+	} else {
+		sum, best, worst := 0.0, values[0], values[0]
+		for _, v := range values {
+			sum += v
+			best = math.Min(best, v)
+			worst = math.Max(worst, v)
+		}
+		mean := sum / float64(len(values))
+
+		variance := 0.0
+		for _, v := range values {
+			variance += (v - mean) * (v - mean)
+		}
+		stddev := math.Sqrt(variance / float64(len(values)))
+
+		cv := stddev / mean
+		score := math.Max(0, math.Min(100, 100*(1-cv)))
+
+		result["mean_placement"] = math.Round(mean*100) / 100
+		result["placement_stddev"] = math.Round(stddev*100) / 100
+		result["coefficient_of_variation"] = math.Round(cv*1000) / 1000
+		result["best_placement"] = int(best)
+		result["worst_placement"] = int(worst)
+		result["consistency_score"] = math.Round(score*10) / 10
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// eliminationType reports whether beating an opponent in a round ended the
+// opponent's event run: "qualifier" for Redemption (Q2L) and Bubble (Q3),
+// "bracket" for a main bracket knockout, or "" when the loser played on.
+// doubleElim marks events whose bracket had a losers side, where a winners
+// bracket loss only drops the opponent down; playedReset marks events where
+// the bot also fought a grand final reset, so its grand final win was not final.
+func eliminationType(round string, doubleElim, playedReset bool) string {
+	code := strings.ToUpper(strings.TrimSpace(round))
+	switch code {
+	case "Q1", "Q2W":
+		return ""
+	case "Q2L", "Q3":
+		return "qualifier"
+	case "LF", "GFR":
+		return "bracket"
+	case "WF":
+		if doubleElim {
+			return ""
+		}
+		return "bracket"
+	case "GF":
+		if playedReset {
+			return ""
+		}
+		return "bracket"
+	}
+
+	bracket, _, _ := getBrettZoneRoundOrder(code)
+	switch bracket {
+	case "winners":
+		if doubleElim {
+			return ""
+		}
+		return "bracket"
+	case "losers":
+		return "bracket"
+	case "grand_finals":
+		return "bracket"
+	}
+	return ""
+}
+
+// eventHasLosersBracket reports whether a BrettZone tournament's main bracket
+// had a losers side, from any losers bracket or losers final match among all
+// of the event's matches. known is false when the matches cannot be loaded.
+func eventHasLosersBracket(tournamentID string) (doubleElim, known bool) {
+	matches, err := getBrettZoneMatchesCached(tournamentID)
+	if err != nil {
+		return false, false
+	}
+	for _, match := range matches {
+		if bracket, _, _ := getBrettZoneRoundOrder(match.Round); bracket == "losers" {
+			return true, true
+		}
+	}
+	return false, true
+}
+
+// Get every opponent a bot knocked out of an event
+func getNHRLBodyCountTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok || botName == "" {
+		return "", fmt.Errorf("bot_name is required for get_body_count operation")
+	}
+
+	events, _, err := getNHRLBotEvents(botName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot events: %w", err)
+	}
+
+	type elimination struct {
+		EventDate string `json:"event_date"`
+		EventName string `json:"event_name,omitempty"`
+		Round     string `json:"round"`
+		Opponent  string `json:"opponent"`
+		ResultBy  string `json:"result_by"`
+		Type      string `json:"type"`
+	}
+	eliminations := []elimination{}
+	bracketCount, qualifierCount := 0, 0
+	victims := make(map[string]bool)
+	var formatUnknown []string
+	for _, event := range events {
+		// The bracket format is a property of the event, not of this bot's run:
+		// a bot knocked out in the winners bracket never fights a losers round
+		doubleElim, known := eventHasLosersBracket(event.TournamentID)
+		if !known {
+			formatUnknown = append(formatUnknown, event.EventName)
+		}
+		playedReset := false
+		for _, fight := range event.Fights {
+			if strings.ToUpper(strings.TrimSpace(fight.Round)) == "GFR" {
+				playedReset = true
+			}
+		}
+
+		for _, fight := range event.Fights {
+			if fightOutcome(fight) != "win" {
+				continue
+			}
+			kind := eliminationType(fight.Round, doubleElim, playedReset)
+			if kind == "" {
+				continue
+			}
+			if kind == "bracket" {
+				bracketCount++
+			} else {
+				qualifierCount++
+			}
+			victims[strings.ToLower(normalizeBotName(fight.OpponentName))] = true
+			eliminations = append(eliminations, elimination{
+				EventDate: event.EventDate,
+				EventName: event.EventName,
+				Round:     strings.ToUpper(strings.TrimSpace(fight.Round)),
+				Opponent:  fight.OpponentName,
+				ResultBy:  fight.ResultBy,
+				Type:      kind,
+			})
+		}
+	}
+
+	result := map[string]interface{}{
+		"bot_name":               botName,
+		"body_count":             len(eliminations),
+		"bracket_eliminations":   bracketCount,
+		"qualifier_eliminations": qualifierCount,
+		"distinct_victims":       len(victims),
+		"eliminations":           eliminations,
+		"note":                   "An elimination is a win that ended the opponent's event run, judged from round codes: Redemption (Q2L) and Bubble (Q3) wins end a qualifier run; main bracket wins eliminate unless the event had a losers bracket, where only losers bracket, losers final, and deciding grand final wins do. Whether an event had a losers bracket is read from all of its BrettZone matches.",
+	}
+	if len(formatUnknown) > 0 {
+		result["format_unknown_events"] = formatUnknown
+		result["warning"] = "Bracket format could not be loaded for some events; they were treated as single elimination"
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get a bot's record in finals rounds (WF, LF, GF, GFR)
+func getNHRLFinalsRecordTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok {
+		return "", fmt.Errorf("bot_name is required for get_finals_record operation")
+	}
+
+	fights, err := getNHRLFightsResolved(botName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot fights: %w", err)
+	}
+
+	wins, losses := 0, 0
+	byRound := make(map[string]map[string]int)
+	finals := make([]map[string]interface{}, 0)
+	for _, fight := range fights {
+		if !isFinalsRound(fight.Round) {
+			continue
+		}
+
+		round := strings.ToUpper(strings.TrimSpace(fight.Round))
+		if byRound[round] == nil {
+			byRound[round] = map[string]int{"wins": 0, "losses": 0}
+		}
+
+		outcome := fightOutcome(fight)
+		switch outcome {
+		case "win":
+			wins++
+			byRound[round]["wins"]++
+		case "loss":
+			losses++
+			byRound[round]["losses"]++
+		}
+
+		finals = append(finals, map[string]interface{}{
+			"date":              fight.Date,
+			"round":             round,
+			"opponent":          fight.OpponentName,
+			"outcome":           outcome,
+			"result_by":         fight.ResultBy,
+			"fight_length_secs": fight.FightLengthSecs,
+			"video_link":        fight.VideoLink,
+		})
+	}
+
+	// Newest first
+	sort.SliceStable(finals, func(i, j int) bool {
+		dateI, _ := parseStatsbookDate(finals[i]["date"].(string))
+		dateJ, _ := parseStatsbookDate(finals[j]["date"].(string))
+		return dateI.After(dateJ)
+	})
+
+	result := map[string]interface{}{
+		"bot_name":     botName,
+		"finals_count": len(finals),
+		"wins":         wins,
+		"losses":       losses,
+		"by_round":     byRound,
+		"fights":       finals,
+	}
+	if wins+losses > 0 {
+		result["win_pct"] = math.Round(float64(wins)/float64(wins+losses)*1000) / 10
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get a bot's knockout wins and knockout losses from its fight history
+func getNHRLBotKOsTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok {
+		return "", fmt.Errorf("bot_name is required for get_bot_kos operation")
+	}
+
+	fights, err := getNHRLFightsResolved(botName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot fights: %w", err)
+	}
+
+	koWins := make([]map[string]interface{}, 0)
+	koLosses := make([]map[string]interface{}, 0)
+	for _, fight := range fights {
+		// KO and TKO both count; JD, DQ, and forfeits do not
+		method := strings.ToUpper(strings.TrimSpace(fight.ResultBy))
+		if !strings.Contains(method, "KO") {
+			continue
+		}
+
+		entry := map[string]interface{}{
+			"opponent":          fight.OpponentName,
+			"date":              fight.Date,
+			"round":             fight.Round,
+			"result_by":         fight.ResultBy,
+			"fight_length_secs": fight.FightLengthSecs,
+			"video_link":        fight.VideoLink,
+		}
+
+		switch fightOutcome(fight) {
+		case "win":
+			koWins = append(koWins, entry)
+		case "loss":
+			koLosses = append(koLosses, entry)
+		}
+	}
+
+	// Newest first
+	byDate := func(entries []map[string]interface{}) {
+		sort.SliceStable(entries, func(i, j int) bool {
+			dateI, _ := parseStatsbookDate(entries[i]["date"].(string))
+			dateJ, _ := parseStatsbookDate(entries[j]["date"].(string))
+			return dateI.After(dateJ)
+		})
+	}
+	byDate(koWins)
+	byDate(koLosses)
+
+	result := map[string]interface{}{
+		"bot_name":      botName,
+		"ko_win_count":  len(koWins),
+		"ko_loss_count": len(koLosses),
+		"ko_wins":       koWins,
+		"ko_losses":     koLosses,
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get how each fight in a bot's current or longest streak was decided
+func getNHRLStreakCompositionTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok {
+		return "", fmt.Errorf("bot_name is required for get_streak_composition operation")
+	}
+
+	streakKind := "current"
+	if s, ok := args["streak"].(string); ok && s != "" {
+		streakKind = strings.ToLower(s)
+	}
+	if streakKind != "current" && streakKind != "longest_win" && streakKind != "longest_loss" {
+		return "", fmt.Errorf("unsupported streak: %s (supported: current, longest_win, longest_loss)", streakKind)
+	}
+
+	fights, err := getNHRLFightsResolved(botName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot fights: %w", err)
+	}
+
+	// Oldest first, keeping only fights with a known result
+	var decided []NHRLFight
+	for _, fight := range fights {
+		if fightOutcome(fight) != "unknown" {
+			decided = append(decided, fight)
+		}
+	}
+	sort.SliceStable(decided, func(i, j int) bool {
+		dateI, _ := parseStatsbookDate(decided[i].Date)
+		dateJ, _ := parseStatsbookDate(decided[j].Date)
+		if !dateI.Equal(dateJ) {
+			return dateI.Before(dateJ)
+		}
+		return decided[i].MatchNum < decided[j].MatchNum
+	})
+	if len(decided) == 0 {
+		return "", fmt.Errorf("no decided fights found for %s", botName)
+	}
+
+	// Find the streak's [start, end) bounds among runs of the same outcome
+	start, end := 0, 0
+	runStart := 0
+	for i := 1; i <= len(decided); i++ {
+		if i < len(decided) && fightOutcome(decided[i]) == fightOutcome(decided[runStart]) {
+			continue
+		}
+		outcome := fightOutcome(decided[runStart])
+		switch {
+		case streakKind == "current" && i == len(decided):
+			start, end = runStart, i
+		case streakKind == "longest_win" && outcome == "win" && i-runStart > end-start:
+			start, end = runStart, i
+		case streakKind == "longest_loss" && outcome == "loss" && i-runStart > end-start:
+			start, end = runStart, i
+		}
+		runStart = i
+	}
+	if end == start {
+		return "", fmt.Errorf("%s has no %s streak", botName, strings.ReplaceAll(streakKind, "_", " "))
+	}
+
+	streakFights := make([]map[string]interface{}, 0, end-start)
+	composition := map[string]int{"ko": 0, "jd": 0, "other": 0}
+	for _, fight := range decided[start:end] {
+		method := fightMethod(fight.ResultBy)
+		composition[method]++
+
+		streakFights = append(streakFights, map[string]interface{}{
+			"date":              fight.Date,
+			"opponent":          fight.OpponentName,
+			"round":             fight.Round,
+			"result":            fightOutcome(fight),
+			"result_by":         fight.ResultBy,
+			"method":            method,
+			"fight_length_secs": fight.FightLengthSecs,
+		})
+	}
+
+	length := end - start
+	outcome := fightOutcome(decided[start])
+	var summary string
+	switch {
+	case composition["ko"] == length:
+		summary = fmt.Sprintf("%d-fight %s streak, all by KO", length, outcome)
+	case composition["jd"] == length:
+		summary = fmt.Sprintf("%d-fight %s streak, all by judges' decision", length, outcome)
+	default:
+		summary = fmt.Sprintf("%d-fight %s streak: %d KO, %d JD, %d other", length, outcome, composition["ko"], composition["jd"], composition["other"])
 	}
 
 	result := map[string]interface{}{
-		"random_fight": randomFight,
+		"bot_name":      botName,
+		"streak":        streakKind,
+		"streak_type":   outcome,
+		"streak_length": length,
+		"is_active":     end == len(decided),
+		"composition":   composition,
+		"summary":       summary,
+		"fights":        streakFights,
+	}
+
+	// Statsbook's own streak figures, for cross-checking
+	if streakStats, err := getNHRLStreakStats(botName); err == nil && streakStats != nil {
+		result["statsbook_streak_stats"] = streakStats
 	}
 
 	jsonData, err := json.MarshalIndent(result, "", "  ")
@@ -722,138 +6682,353 @@ func getNHRLRandomFightTool(args map[string]interface{}) (string, error) {
 	return string(jsonData), nil
 }
 
-// getBrettZoneTournamentMatchesTool handles getting tournament matches from BrettZone
-func getBrettZoneTournamentMatchesTool(args map[string]interface{}) (string, error) {
-	tournamentID, ok := args["tournament_id"].(string)
-	if !ok || tournamentID == "" {
-		return "", fmt.Errorf("tournament_id parameter is required")
+// Get a bot's most common way of winning and its typical KO finish time
+func getNHRLSignatureFinishTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok {
+		return "", fmt.Errorf("bot_name is required for get_signature_finish operation")
 	}
 
-	// Get pagination parameters
-	limit := 25
-	if l, ok := args["limit"].(float64); ok {
-		limit = int(l)
+	fights, err := getNHRLFightsResolved(botName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot fights: %w", err)
 	}
 
-	offset := 0
-	if o, ok := args["offset"].(float64); ok {
-		offset = int(o)
+	wins := 0
+	methodCounts := make(map[string]int)
+	resultByCounts := make(map[string]int)
+	var koTimes []float64
+	for _, fight := range fights {
+		if fightOutcome(fight) != "win" {
+			continue
+		}
+		wins++
+		method := fightMethod(fight.ResultBy)
+		methodCounts[method]++
+		if resultBy := strings.TrimSpace(fight.ResultBy); resultBy != "" {
+			resultByCounts[resultBy]++
+		}
+		if method == "ko" && fight.FightLengthSecs != nil {
+			if secs, err := strconv.ParseFloat(strings.TrimSpace(*fight.FightLengthSecs), 64); err == nil && secs > 0 {
+				koTimes = append(koTimes, secs)
+			}
+		}
 	}
 
-	matches, err := getBrettZoneLatestMatches(tournamentID)
-	if err != nil {
-		return "", fmt.Errorf("failed to get tournament matches: %w", err)
+	result := map[string]interface{}{
+		"bot_name":    botName,
+		"fight_count": len(fights),
+		"win_count":   wins,
 	}
+	if wins == 0 {
+		result["summary"] = fmt.Sprintf("%s has no recorded wins yet", botName)
+	} else {
+		// Most common method, preferring KO then JD on ties
+		signature := "ko"
+		for _, method := range []string{"jd", "other"} {
+			if methodCounts[method] > methodCounts[signature] {
+				signature = method
+			}
+		}
 
-	// Enrich matches with round qualification information
-	enrichedBrettZoneMatches := enrichBrettZoneMatches(matches)
+		// Most common raw result_by among wins, e.g. "KO" or "KO (TKO)"
+		topResultBy, topCount := "", 0
+		for resultBy, count := range resultByCounts {
+			if count > topCount || (count == topCount && resultBy < topResultBy) {
+				topResultBy, topCount = resultBy, count
+			}
+		}
 
-	// Apply pagination before converting to response format
-	paginatedMatches, metadata := paginateSlice(enrichedBrettZoneMatches, limit, offset)
+		result["win_methods"] = methodCounts
+		result["signature_method"] = signature
+		result["signature_share"] = math.Round(ratio(methodCounts[signature], wins)*1000) / 10
+		if topResultBy != "" {
+			result["most_common_result_by"] = topResultBy
+		}
 
-	// Convert to response format with additional information
-	enrichedMatches := make([]map[string]interface{}, len(paginatedMatches))
-	for i, match := range paginatedMatches {
-		enrichedMatch := map[string]interface{}{
-			"tournamentID":     match.TournamentID,
-			"matchID":          match.ID,
-			"matchName":        match.Name,
-			"round":            match.Round,
-			"roundName":        match.RoundName,
-			"roundDescription": match.RoundDescription,
-			"winImplication":   match.WinImplication,
-			"loseImplication":  match.LoseImplication,
-			"cage":             match.Cage,
-			"player1":          match.Player1,
-			"player2":          match.Player2,
-			"winner":           getMatchWinner(match.BrettZoneMatch),
-			"winMethod":        match.WinAnnotation,
-			"matchLengthSecs":  match.MatchLength,
-			"weightClass":      match.WeightClass + "lb",
-			"tournamentName":   match.TournamentName,
-			"cameras":          match.Cams,
-			"isTest":           match.IsTest == "1",
-			"isFreestyle":      match.IsFreestyle == "1",
+		var medianKO float64
+		if len(koTimes) > 0 {
+			sort.Float64s(koTimes)
+			medianKO = koTimes[len(koTimes)/2]
+			if len(koTimes)%2 == 0 {
+				medianKO = (koTimes[len(koTimes)/2-1] + koTimes[len(koTimes)/2]) / 2
+			}
+			result["median_ko_secs"] = medianKO
+			result["timed_ko_wins"] = len(koTimes)
 		}
 
-		// Add timing information if available
-		if match.StartTime != "" && match.StopTime != "" {
-			enrichedMatch["startTime"] = match.StartTime
-			enrichedMatch["stopTime"] = match.StopTime
+		switch {
+		case signature == "ko" && len(koTimes) > 0:
+			result["summary"] = fmt.Sprintf("%s usually KOs opponents around %.0f seconds (%d of %d wins by KO)", botName, medianKO, methodCounts["ko"], wins)
+		case signature == "ko":
+			result["summary"] = fmt.Sprintf("%s usually wins by KO (%d of %d wins)", botName, methodCounts["ko"], wins)
+		case signature == "jd":
+			result["summary"] = fmt.Sprintf("%s usually goes the distance and wins on the judges' decision (%d of %d wins)", botName, methodCounts["jd"], wins)
+		default:
+			result["summary"] = fmt.Sprintf("%s most often wins by %s (%d of %d wins)", botName, topResultBy, topCount, wins)
 		}
+	}
 
-		// Add review URL
-		cageNum := extractCageNumber(match.Cage)
-		reviewURL := generateBrettZoneReviewURL(match.ID, match.TournamentID, cageNum, 3.0)
-		enrichedMatch["reviewURL"] = reviewURL
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
 
-		enrichedMatches[i] = enrichedMatch
+	return string(jsonData), nil
+}
+
+// fightOutcome reports "win", "loss", or "unknown" for a fight resolved by
+// getNHRLFightsResolved. The statsbook row itself does not say who won, so an
+// unresolved fight is always "unknown".
+func fightOutcome(fight NHRLFight) string {
+	switch fight.Result {
+	case "W":
+		return "win"
+	case "L":
+		return "loss"
 	}
+	return "unknown"
+}
 
-	// Sort matches by round and match name for better organization
-	result := map[string]interface{}{
-		"tournamentID":   tournamentID,
-		"tournamentName": "",
-		"totalMatches":   len(paginatedMatches),
-		"matches":        enrichedMatches,
-		"pagination":     metadata,
+// Maximum number of opponents whose bot type is looked up for get_record_vs_bot_type
+const maxBotTypeLookups = 40
+
+// Maximum number of concurrent bot type lookups get_record_vs_bot_type makes
+const botTypeLookupConcurrency = 4
+
+// Get a bot's record against each weapon archetype it has faced
+func getNHRLRecordVsBotTypeTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok {
+		return "", fmt.Errorf("bot_name is required for get_record_vs_bot_type operation")
 	}
 
-	// Set tournament name if we have at least one match
-	if len(enrichedBrettZoneMatches) > 0 {
-		result["tournamentName"] = enrichedBrettZoneMatches[0].TournamentName
+	tournamentID, _ := args["tournament_id"].(string)
+
+	headToHead, err := getNHRLHeadToHeadCached(botName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot head-to-head: %w", err)
+	}
+
+	// Look up the most frequent opponents first so the cap drops the least relevant
+	sort.SliceStable(headToHead, func(i, j int) bool {
+		return headToHead[i].NumFights > headToHead[j].NumFights
+	})
+
+	type archetypeRecord struct {
+		Wins      int      `json:"wins"`
+		Losses    int      `json:"losses"`
+		KOs       int      `json:"kos"`
+		KOd       int      `json:"kod"`
+		Opponents []string `json:"opponents"`
+	}
+
+	// Each lookup is a live stats request with retries, so run a few at a time
+	lookedUp := headToHead
+	if len(lookedUp) > maxBotTypeLookups {
+		lookedUp = lookedUp[:maxBotTypeLookups]
+	}
+	botTypes := make([]string, len(lookedUp))
+	sem := make(chan struct{}, botTypeLookupConcurrency)
+	var wg sync.WaitGroup
+	for i, record := range lookedUp {
+		wg.Add(1)
+		go func(i int, opponentName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			botTypes[i] = getNHRLBotTypeCached(botName, opponentName, tournamentID)
+		}(i, record.OpponentUniqueName)
+	}
+	wg.Wait()
+
+	records := make(map[string]*archetypeRecord)
+	for i, record := range headToHead {
+		archetype := "unknown"
+		if i < len(botTypes) {
+			archetype = classifyBotArchetype(botTypes[i])
+		}
+
+		r, ok := records[archetype]
+		if !ok {
+			r = &archetypeRecord{Opponents: []string{}}
+			records[archetype] = r
+		}
+		r.Wins += record.Wins
+		r.Losses += record.Losses
+		r.KOs += record.KOs
+		r.KOd += record.KOd
+		r.Opponents = append(r.Opponents, record.OpponentUniqueName)
+	}
+
+	byArchetype := make(map[string]interface{}, len(records))
+	for archetype, r := range records {
+		winPct := 0.0
+		if r.Wins+r.Losses > 0 {
+			winPct = float64(r.Wins) / float64(r.Wins+r.Losses)
+		}
+		byArchetype[archetype] = map[string]interface{}{
+			"wins":      r.Wins,
+			"losses":    r.Losses,
+			"win_pct":   winPct,
+			"kos":       r.KOs,
+			"kod":       r.KOd,
+			"opponents": r.Opponents,
+		}
+	}
+
+	result := map[string]interface{}{
+		"bot_name":       botName,
+		"opponent_count": len(headToHead),
+		"by_archetype":   byArchetype,
+	}
+	if len(headToHead) > maxBotTypeLookups {
+		result["note"] = fmt.Sprintf("Bot types were looked up for the %d most frequent opponents; the rest are grouped as unknown", maxBotTypeLookups)
 	}
 
 	jsonData, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal tournament matches data: %w", err)
+		return "", fmt.Errorf("failed to marshal result: %w", err)
 	}
 
 	return string(jsonData), nil
 }
 
-// getBrettZoneMatchReviewURLTool handles generating match review URLs
-func getBrettZoneMatchReviewURLTool(args map[string]interface{}) (string, error) {
-	gameID, ok := args["game_id"].(string)
-	if !ok || gameID == "" {
-		return "", fmt.Errorf("game_id parameter is required")
+// Get bot fight videos, optionally grouped by event
+func getNHRLBotVideosTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok {
+		return "", fmt.Errorf("bot_name is required for get_bot_videos operation")
 	}
 
-	tournamentID, ok := args["tournament_id"].(string)
-	if !ok || tournamentID == "" {
-		return "", fmt.Errorf("tournament_id parameter is required")
+	groupByEvent, _ := args["group_by_event"].(bool)
+
+	// Get pagination parameters
+	limit := 25
+	if l, ok := args["limit"].(float64); ok {
+		limit = int(l)
 	}
 
-	// Optional parameters with defaults
-	cageNum := 1
-	if cage, ok := args["cage_number"].(float64); ok {
-		cageNum = int(cage)
+	offset := 0
+	if o, ok := args["offset"].(float64); ok {
+		offset = int(o)
 	}
 
-	timeSeconds := 3.0
-	if time, ok := args["time_seconds"].(float64); ok {
-		timeSeconds = time
+	// Only grouping needs each fight's BrettZone event
+	fetchFights := getNHRLFights
+	if groupByEvent {
+		fetchFights = getNHRLFightsResolved
+	}
+	fights, err := fetchFights(botName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot fights: %w", err)
 	}
 
-	reviewURL := generateBrettZoneReviewURL(gameID, tournamentID, cageNum, timeSeconds)
+	videos := make([]map[string]interface{}, 0)
+	for _, fight := range fights {
+		if fight.VideoLink == nil || *fight.VideoLink == "" {
+			continue
+		}
+		video := map[string]interface{}{
+			"date":              fight.Date,
+			"round":             fight.Round,
+			"match_num":         fight.MatchNum,
+			"result_by":         fight.ResultBy,
+			"fight_length_secs": fight.FightLengthSecs,
+			"video_link":        *fight.VideoLink,
+		}
+		if groupByEvent {
+			video["tournament_id"] = fight.TournamentID
+			video["event_name"] = fight.EventName
+		}
+		videos = append(videos, video)
+	}
+
+	// Newest first
+	sort.SliceStable(videos, func(i, j int) bool {
+		return videos[i]["date"].(string) > videos[j]["date"].(string)
+	})
 
 	result := map[string]interface{}{
-		"gameID":       gameID,
-		"tournamentID": tournamentID,
-		"cageNumber":   cageNum,
-		"timeSeconds":  timeSeconds,
-		"reviewURL":    reviewURL,
-		"description":  fmt.Sprintf("Watch match %s from tournament %s starting at %.1f seconds", gameID, tournamentID, timeSeconds),
+		"bot_name":    botName,
+		"video_count": len(videos),
+	}
+
+	if groupByEvent {
+		events := groupVideosByEvent(videos)
+		paginatedEvents, metadata := paginateSlice(events, limit, offset)
+		result["event_count"] = len(events)
+		result["events"] = paginatedEvents
+		result["pagination"] = metadata
+	} else {
+		paginatedVideos, metadata := paginateSlice(videos, limit, offset)
+		result["videos"] = paginatedVideos
+		result["pagination"] = metadata
 	}
 
 	jsonData, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal review URL data: %w", err)
+		return "", fmt.Errorf("failed to marshal result: %w", err)
 	}
 
 	return string(jsonData), nil
 }
 
+// groupVideosByEvent groups videos (sorted newest first) by the BrettZone
+// tournament their fight was resolved to. Videos with no resolved tournament
+// are grouped under a label for the month they were fought.
+func groupVideosByEvent(videos []map[string]interface{}) []map[string]interface{} {
+	groups := make([]map[string]interface{}, 0)
+	groupIndex := make(map[string]int)
+
+	for _, video := range videos {
+		dateStr := video["date"].(string)
+		tournamentID, _ := video["tournament_id"].(string)
+		eventName, _ := video["event_name"].(string)
+		eventDate := dateStr
+
+		if fightDate, ok := parseStatsbookDate(dateStr); ok {
+			eventDate = fightDate.Format("2006-01-02")
+			if eventName == "" {
+				eventName = fightDate.Format("2006 January") + " event"
+			}
+		}
+		if eventName == "" {
+			eventName = "Unknown event"
+		}
+
+		key := tournamentID
+		if key == "" {
+			key = eventName
+		}
+		idx, exists := groupIndex[key]
+		if !exists {
+			idx = len(groups)
+			groupIndex[key] = idx
+			groups = append(groups, map[string]interface{}{
+				"event_name": eventName,
+				"event_date": eventDate,
+				"videos":     []map[string]interface{}{},
+			})
+			if tournamentID != "" {
+				groups[idx]["tournament_id"] = tournamentID
+			}
+		} else if eventDate < groups[idx]["event_date"].(string) {
+			// An event is dated by its first fight
+			groups[idx]["event_date"] = eventDate
+		}
+		groups[idx]["videos"] = append(groups[idx]["videos"].([]map[string]interface{}), video)
+	}
+
+	for _, group := range groups {
+		count := len(group["videos"].([]map[string]interface{}))
+		group["video_count"] = count
+		group["label"] = fmt.Sprintf("%s (%d fights)", group["event_name"], count)
+	}
+
+	return groups
+}
+
 // Helper function to determine match winner
 func getMatchWinner(match BrettZoneMatch) string {
 	if match.Player1Wins == "1" {
@@ -960,6 +7135,206 @@ func getNHRLQualificationSystemTool(args map[string]interface{}) (string, error)
 	return string(jsonData), nil
 }
 
+// Bracket formats accepted by plan_bracket
+const (
+	bracketPlanQualifiers = "qualifiers"
+	bracketPlanDirect     = "direct"
+)
+
+// qualifierRoundPlan describes one NHRL qualifying round for a given field size
+type qualifierRoundPlan struct {
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	Entrants int    `json:"entrants"`
+	Fights   int    `json:"fights"`
+	Byes     int    `json:"byes"`
+	Winners  int    `json:"winners"`
+	Losers   int    `json:"losers"`
+	Outcome  string `json:"outcome"`
+}
+
+// planQualifierRound pairs entrants for one qualifying round. An odd entrant
+// out gets a bye, which counts as a win.
+func planQualifierRound(code string, entrants int, outcome string) qualifierRoundPlan {
+	return qualifierRoundPlan{
+		Code:     code,
+		Name:     getQualificationRoundName(code),
+		Entrants: entrants,
+		Fights:   entrants / 2,
+		Byes:     entrants % 2,
+		Winners:  (entrants + 1) / 2,
+		Losers:   entrants / 2,
+		Outcome:  outcome,
+	}
+}
+
+// nextPowerOfTwo returns the smallest power of two >= n
+func nextPowerOfTwo(n int) int {
+	size := 1
+	for size < n {
+		size *= 2
+	}
+	return size
+}
+
+// Plan qualifier rounds and bracket size for an event from its entrant count
+func getNHRLPlanBracketTool(args map[string]interface{}) (string, error) {
+	entrantsFloat, ok := args["entrant_count"].(float64)
+	if !ok {
+		return "", fmt.Errorf("entrant_count is required for plan_bracket operation")
+	}
+	entrants := int(entrantsFloat)
+	if entrantsFloat != float64(entrants) || entrants < 2 {
+		return "", fmt.Errorf("entrant_count must be a whole number of at least 2")
+	}
+
+	format := bracketPlanQualifiers
+	if f, ok := args["format"].(string); ok && f != "" {
+		format = strings.ToLower(f)
+	}
+
+	var rounds []qualifierRoundPlan
+	qualified := entrants
+	switch format {
+	case bracketPlanQualifiers:
+		// Q1 splits the field; Q2W winners qualify, Q2W losers and Q2L winners
+		// meet in Q3, and Q3 winners take the remaining bracket spots
+		q1 := planQualifierRound("Q1", entrants, "Winners to Q2W, losers to Q2L")
+		q2w := planQualifierRound("Q2W", q1.Winners, "Winners qualify, losers drop to Q3")
+		q2l := planQualifierRound("Q2L", q1.Losers, "Winners to Q3, losers eliminated")
+		q3 := planQualifierRound("Q3", q2w.Losers+q2l.Winners, "Winners qualify, losers eliminated")
+		rounds = []qualifierRoundPlan{q1, q2w, q2l, q3}
+		qualified = q2w.Winners + q3.Winners
+	case bracketPlanDirect:
+		// Every entrant goes straight into the bracket
+	default:
+		return "", fmt.Errorf("unsupported format: %s (supported: %s, %s)", format, bracketPlanQualifiers, bracketPlanDirect)
+	}
+
+	bracketSize := nextPowerOfTwo(qualified)
+	bracketRounds := 0
+	for size := bracketSize; size > 1; size /= 2 {
+		bracketRounds++
+	}
+
+	qualifierFights := 0
+	for _, round := range rounds {
+		qualifierFights += round.Fights
+	}
+
+	qualifierStages := 0
+	if len(rounds) > 0 {
+		// Q2W and Q2L run as a single stage
+		qualifierStages = 3
+	}
+
+	result := map[string]interface{}{
+		"entrant_count":            entrants,
+		"format":                   format,
+		"qualifier_rounds":         qualifierStages,
+		"qualifier_round_detail":   rounds,
+		"qualifier_fights":         qualifierFights,
+		"advancing":                qualified,
+		"eliminated_in_qualifiers": entrants - qualified,
+		"bracket_size":             bracketSize,
+		"byes":                     bracketSize - qualified,
+		"bracket_rounds":           bracketRounds,
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+	return string(jsonData), nil
+}
+
+// Build a short, deterministic announcer intro for a bot from its live stats profile
+func getNHRLIntroScriptTool(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok || strings.TrimSpace(botName) == "" {
+		return "", fmt.Errorf("bot_name is required for get_intro_script operation")
+	}
+
+	profile, ok := getNHRLBotProfilesCached([]string{botName})[botName]
+	if !ok {
+		return "", fmt.Errorf("no live stats profile found for %s", botName)
+	}
+
+	name := strings.TrimSpace(profile.BotName)
+	if name == "" {
+		name = botName
+	}
+
+	var lines []string
+	opening := fmt.Sprintf("Coming into the cage, it's %s!", name)
+	if profile.BotPronunciation != nil && strings.TrimSpace(*profile.BotPronunciation) != "" {
+		opening = fmt.Sprintf("Coming into the cage, it's %s! (say: %s)", name, strings.TrimSpace(*profile.BotPronunciation))
+	}
+	lines = append(lines, opening)
+
+	subject := "This bot"
+	if botType := strings.TrimSpace(profile.BotType); botType != "" {
+		subject = "This " + strings.ToLower(botType)
+	}
+
+	driver := strings.TrimSpace(profile.DriverName)
+	hometown := make([]string, 0, 3)
+	for _, part := range []string{profile.City, profile.StateProvince, profile.Country} {
+		if part = strings.TrimSpace(part); part != "" {
+			hometown = append(hometown, part)
+		}
+	}
+	switch {
+	case driver != "" && len(hometown) > 0:
+		lines = append(lines, fmt.Sprintf("%s is driven by %s, out of %s.", subject, driver, strings.Join(hometown, ", ")))
+	case driver != "":
+		lines = append(lines, fmt.Sprintf("%s is driven by %s.", subject, driver))
+	case len(hometown) > 0:
+		lines = append(lines, fmt.Sprintf("%s comes to us from %s.", subject, strings.Join(hometown, ", ")))
+	}
+	if driver != "" && strings.TrimSpace(profile.DriverPronunciation) != "" {
+		lines = append(lines, fmt.Sprintf("(Driver name, say: %s)", strings.TrimSpace(profile.DriverPronunciation)))
+	}
+
+	if profile.TeamName != nil && strings.TrimSpace(*profile.TeamName) != "" {
+		lines = append(lines, fmt.Sprintf("Representing %s.", strings.TrimSpace(*profile.TeamName)))
+	}
+
+	if profile.Fights > 0 {
+		record := fmt.Sprintf("They come in with a %d-%d record", profile.W, profile.L)
+		if profile.WKO > 0 {
+			record += fmt.Sprintf(", %d of those wins by knockout", profile.WKO)
+		}
+		if profile.Ranking > 0 {
+			record += fmt.Sprintf(", ranked number %d", profile.Ranking)
+		}
+		lines = append(lines, record+".")
+	} else {
+		lines = append(lines, "This is their NHRL debut!")
+	}
+
+	if fact := strings.TrimSpace(profile.InterestingFact); fact != "" {
+		lines = append(lines, fact)
+	} else if profile.InterestingFact2 != nil && strings.TrimSpace(*profile.InterestingFact2) != "" {
+		lines = append(lines, strings.TrimSpace(*profile.InterestingFact2))
+	}
+
+	lines = append(lines, fmt.Sprintf("Give it up for %s!", name))
+
+	result := map[string]interface{}{
+		"bot_name": name,
+		"driver":   driver,
+		"script":   strings.Join(lines, " "),
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
 // Get live fight stats between two bots
 func getNHRLLiveFightStatsTool(args map[string]interface{}) (string, error) {
 	bot1, ok := args["bot1"].(string)
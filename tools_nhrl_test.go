@@ -0,0 +1,2978 @@
+package main
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// bzMatch builds a BrettZone match fixture in tournament "t1"; winner is 1 or
+// 2 for a decided match and 0 for one still to be fought
+func bzMatch(id, round, player1, player2 string, winner int) BrettZoneMatch {
+	match := BrettZoneMatch{
+		TournamentID:   "t1",
+		ID:             id,
+		Name:           id,
+		Round:          round,
+		Cage:           "Cage 1",
+		Player1:        player1,
+		Player2:        player2,
+		Player1Wins:    "0",
+		Player2Wins:    "0",
+		WeightClass:    "3",
+		TournamentName: "NHRL June 2025 3lb",
+	}
+	switch winner {
+	case 1:
+		match.Player1Wins = "1"
+	case 2:
+		match.Player2Wins = "1"
+	}
+	return match
+}
+
+// atEvent moves a BrettZone match fixture to another tournament
+func atEvent(match BrettZoneMatch, tournamentID, tournamentName string) BrettZoneMatch {
+	match.TournamentID, match.TournamentName = tournamentID, tournamentName
+	return match
+}
+
+// endedBy sets how a BrettZone match fixture was won and its length in seconds
+func endedBy(match BrettZoneMatch, method, length string) BrettZoneMatch {
+	match.WinAnnotation, match.MatchLength = method, length
+	return match
+}
+
+func TestRecentResultsOnlyCompletedNewestFirst(t *testing.T) {
+	stub := newUpstreamStub(t)
+
+	first := bzMatch("m1", "Q1", "Lynx", "Zeus", 1)
+	first.EndTime = "1750000000"
+	second := bzMatch("m2", "Q1", "Hydra", "Bolt", 2)
+	second.EndTime = "1750000600"
+	third := bzMatch("m3", "Q2W", "Lynx", "Bolt", 1)
+	third.StopTime = "1750001200"
+	pending := bzMatch("m4", "Q2L", "Zeus", "Hydra", 0)
+	pending.EndTime = "1750001800"
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {first, second, pending, third}})
+
+	output, err := getBrettZoneRecentResultsTool(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_recent_results: %v", err)
+	}
+	results := decodeResult(t, output)["results"].([]interface{})
+
+	var ids []string
+	for _, r := range results {
+		ids = append(ids, r.(map[string]interface{})["matchID"].(string))
+	}
+	want := []string{"m3", "m2", "m1"}
+	if len(ids) != len(want) {
+		t.Fatalf("matchIDs = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("matchIDs = %v, want %v (completed only, newest first)", ids, want)
+		}
+	}
+	if winner := results[1].(map[string]interface{})["winner"]; winner != "Bolt" {
+		t.Errorf("m2 winner = %v, want Bolt", winner)
+	}
+}
+
+func TestRecentResultsSinceFilter(t *testing.T) {
+	stub := newUpstreamStub(t)
+
+	old := bzMatch("m1", "Q1", "Lynx", "Zeus", 1)
+	old.EndTime = "1750000000"
+	recent := bzMatch("m2", "Q1", "Hydra", "Bolt", 2)
+	recent.EndTime = "1750000600"
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {old, recent}})
+
+	output, err := getBrettZoneRecentResultsTool(map[string]interface{}{"tournament_id": "t1", "since": "1750000300"})
+	if err != nil {
+		t.Fatalf("get_recent_results: %v", err)
+	}
+	results := decodeResult(t, output)["results"].([]interface{})
+	if len(results) != 1 || results[0].(map[string]interface{})["matchID"] != "m2" {
+		t.Errorf("results = %v, want only m2", results)
+	}
+}
+
+func TestMatchupProbabilityByMeetings(t *testing.T) {
+	tests := []struct {
+		name        string
+		wins        int
+		losses      int
+		probability float64
+		confidence  string
+	}{
+		{"no meetings", 0, 0, 0.5, "none"},
+		{"one meeting", 1, 0, 2.0 / 3.0, "low"},
+		{"five meetings", 4, 1, 5.0 / 7.0, "high"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := newUpstreamStub(t)
+			records := []NHRLHeadToHead{{OpponentUniqueName: "Other", NumFights: 2, Wins: 2}}
+			if tt.wins+tt.losses > 0 {
+				records = append(records, NHRLHeadToHead{
+					OpponentUniqueName: "Zeus",
+					NumFights:          tt.wins + tt.losses,
+					Wins:               tt.wins,
+					Losses:             tt.losses,
+					LastMeeting:        "2025-06-14",
+				})
+			}
+			stub.statsbookByBot("get_head_to_head.php", map[string]interface{}{"Lynx": records})
+
+			output, err := getNHRLMatchupProbabilityTool(map[string]interface{}{"bot1": "Lynx", "bot2": "Zeus"})
+			if err != nil {
+				t.Fatalf("get_matchup_probability: %v", err)
+			}
+			result := decodeResult(t, output)
+			if got := result["meetings"].(float64); int(got) != tt.wins+tt.losses {
+				t.Errorf("meetings = %v, want %d", got, tt.wins+tt.losses)
+			}
+			if got := result["bot1_win_probability"].(float64); got < tt.probability-1e-9 || got > tt.probability+1e-9 {
+				t.Errorf("bot1_win_probability = %v, want %v", got, tt.probability)
+			}
+			if result["confidence"] != tt.confidence {
+				t.Errorf("confidence = %v, want %s", result["confidence"], tt.confidence)
+			}
+			if _, ok := result["last_meeting"]; ok != (tt.wins+tt.losses > 0) {
+				t.Errorf("last_meeting present = %v, want %v", ok, tt.wins+tt.losses > 0)
+			}
+		})
+	}
+}
+
+func TestBotClassStandingPresentAndAbsent(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.json(statsbookHost+"/statsbook/get_stat_summary.php", []NHRLStatSummary{
+		{Bot: "Lynx", Ranking: 1, W: 9, L: 1},
+		{Bot: "Zeus", Ranking: 2, W: 7, L: 3},
+		{Bot: "Hydra", Ranking: 3, W: 4, L: 4},
+	})
+
+	output, err := getNHRLBotClassStandingTool(map[string]interface{}{"bot_name": "zeus"})
+	if err != nil {
+		t.Fatalf("get_bot_class_standing: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["class_size"].(float64) != 3 {
+		t.Errorf("class_size = %v, want 3", result["class_size"])
+	}
+	standing, ok := result["standing"].(map[string]interface{})
+	if !ok || standing["bot"] != "Zeus" || standing["ranking"].(float64) != 2 {
+		t.Errorf("standing = %v, want Zeus ranked 2", result["standing"])
+	}
+	if _, ok := result["message"]; ok {
+		t.Errorf("unexpected message for a ranked bot: %v", result["message"])
+	}
+
+	output, err = getNHRLBotClassStandingTool(map[string]interface{}{"bot_name": "Bolt"})
+	if err != nil {
+		t.Fatalf("get_bot_class_standing: %v", err)
+	}
+	result = decodeResult(t, output)
+	if result["standing"] != nil {
+		t.Errorf("standing = %v, want null for an unranked bot", result["standing"])
+	}
+	if msg, _ := result["message"].(string); msg != "Bot not found in the 3lb Active stat summary" {
+		t.Errorf("message = %q", msg)
+	}
+}
+
+func TestBotVideosGroupedByEvent(t *testing.T) {
+	stub := newUpstreamStub(t)
+	history := stub.fightHistories()
+	history.fight("Lynx", "2025-06-14", bzMatch("g1", "Q1", "Lynx", "Zeus", 1))
+	history.fight("Lynx", "2025-06-15", bzMatch("g2", "Q2W", "Bolt", "Lynx", 1))
+	history.fight("Lynx", "2025-08-09", atEvent(bzMatch("g3", "Q1", "Lynx", "Hydra", 1), "t2", "NHRL August 2025 3lb"))
+
+	output, err := getNHRLBotVideosTool(map[string]interface{}{"bot_name": "Lynx", "group_by_event": true})
+	if err != nil {
+		t.Fatalf("get_bot_videos: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["event_count"].(float64) != 2 {
+		t.Fatalf("event_count = %v, want 2", result["event_count"])
+	}
+	events := result["events"].([]interface{})
+	want := []struct {
+		tournamentID, name, date string
+		videos                   int
+	}{
+		{"t2", "NHRL August 2025 3lb", "2025-08-09", 1},
+		{"t1", "NHRL June 2025 3lb", "2025-06-14", 2},
+	}
+	for i, w := range want {
+		event := events[i].(map[string]interface{})
+		if event["tournament_id"] != w.tournamentID || event["event_name"] != w.name || event["event_date"] != w.date {
+			t.Errorf("event %d = %v %v %v, want %s %s %s", i, event["tournament_id"], event["event_name"], event["event_date"], w.tournamentID, w.name, w.date)
+		}
+		if int(event["video_count"].(float64)) != w.videos {
+			t.Errorf("event %d video_count = %v, want %d", i, event["video_count"], w.videos)
+		}
+	}
+
+	// The flat list needs no event names, so it skips BrettZone
+	const matchesPath = brettZoneHost + "/brettZone/backend/getLatestMatches.php"
+	before := stub.count(matchesPath)
+	output, err = getNHRLBotVideosTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_bot_videos: %v", err)
+	}
+	if videos := decodeResult(t, output)["videos"].([]interface{}); len(videos) != 3 || stub.count(matchesPath) != before {
+		t.Errorf("flat videos = %d after %d BrettZone requests, want 3 and none", len(videos), stub.count(matchesPath)-before)
+	}
+}
+
+func TestClosestFightsOnlyDecisionsLongestFirst(t *testing.T) {
+	stub := newUpstreamStub(t)
+
+	heavyJD := endedBy(bzMatch("m4", "Q1", "Tank", "Brick", 1), "JD", "120")
+	heavyJD.WeightClass = "12"
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {
+		endedBy(bzMatch("m1", "Q1", "Lynx", "Zeus", 1), "KO", "45"),
+		endedBy(bzMatch("m2", "Q1", "Hydra", "Bolt", 2), "JD", "150"),
+		endedBy(bzMatch("m3", "Q2W", "Lynx", "Bolt", 1), "JD", "180"),
+		heavyJD,
+	}})
+
+	for _, tc := range []struct {
+		name string
+		args map[string]interface{}
+		want []string
+	}{
+		{"every weight class", map[string]interface{}{"tournament_id": "t1"}, []string{"m3", "m2", "m4"}},
+		{"weight_class given", map[string]interface{}{"tournament_id": "t1", "weight_class": "3lb"}, []string{"m3", "m2"}},
+	} {
+		output, err := getBrettZoneClosestFightsTool(tc.args)
+		if err != nil {
+			t.Fatalf("%s: get_closest_fights: %v", tc.name, err)
+		}
+		fights := decodeResult(t, output)["fights"].([]interface{})
+		if len(fights) != len(tc.want) {
+			t.Fatalf("%s: fights = %v, want JD fights %v", tc.name, fights, tc.want)
+		}
+		for i, want := range tc.want {
+			fight := fights[i].(map[string]interface{})
+			if fight["matchID"] != want || fight["winMethod"] != "JD" {
+				t.Errorf("%s: fight %d = %v (%v), want %s by JD", tc.name, i, fight["matchID"], fight["winMethod"], want)
+			}
+		}
+	}
+}
+
+func TestClosestFightsFromStatsbookHistories(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.json(statsbookHost+"/statsbook/get_stat_summary.php", []NHRLStatSummary{
+		{Bot: "Lynx", Fights: 3},
+		{Bot: "Bolt", Fights: 2},
+	})
+	length := func(secs string) *string { return &secs }
+	shared := NHRLFight{Date: "2025-06-14", Round: "Q2W", MatchNum: 7, ResultBy: "JD", FightLengthSecs: length("180")}
+	stub.statsbookByBot("get_fights.php", map[string]interface{}{
+		"Lynx": []NHRLFight{
+			{Date: "2025-06-14", Round: "Q1", MatchNum: 2, ResultBy: "KO", FightLengthSecs: length("30")},
+			{Date: "2025-06-14", Round: "Q1", MatchNum: 3, ResultBy: "JD", FightLengthSecs: length("150")},
+			shared,
+		},
+		"Bolt": []NHRLFight{shared},
+	})
+
+	output, err := getBrettZoneClosestFightsTool(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("get_closest_fights: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["source"] != "statsbook" || result["botsScanned"].(float64) != 2 {
+		t.Errorf("source = %v, botsScanned = %v; want statsbook, 2", result["source"], result["botsScanned"])
+	}
+	fights := result["fights"].([]interface{})
+	if len(fights) != 2 {
+		t.Fatalf("fights = %v, want two JD fights with the shared one counted once", fights)
+	}
+	if got := fights[0].(map[string]interface{})["matchLengthSecs"]; got != 180.0 {
+		t.Errorf("first fight length = %v, want 180", got)
+	}
+}
+
+func TestCareerBookendsFromUnsortedFights(t *testing.T) {
+	stub := newUpstreamStub(t)
+
+	// The statsbook lists the fights out of date order
+	history := stub.fightHistories()
+	history.fight("Lynx", "2024-11-09", bzMatch("g2", "Q2L", "Hydra", "Lynx", 2))
+	history.fight("Lynx", "2025-03-15", atEvent(bzMatch("g3", "Q1", "Lynx", "Bolt", 1), "t2", "NHRL March 2025 3lb"))
+	history.fight("Lynx", "2024-11-08", bzMatch("g1", "Q1", "Lynx", "Zeus", 2))
+
+	output, err := getNHRLCareerBookendsTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_career_bookends: %v", err)
+	}
+	result := decodeResult(t, output)
+
+	first := result["first_fight"].(map[string]interface{})
+	if first["date"] != "2024-11-08" || first["opponent_name"] != "Zeus" || first["result"] != "L" {
+		t.Errorf("first_fight = %v, want the 2024-11-08 loss to Zeus", first)
+	}
+	last := result["latest_fight"].(map[string]interface{})
+	if last["date"] != "2025-03-15" || last["opponent_name"] != "Bolt" || last["result"] != "W" {
+		t.Errorf("latest_fight = %v, want the 2025-03-15 win over Bolt", last)
+	}
+	if got := result["career_span_days"].(float64); got != 127 {
+		t.Errorf("career_span_days = %v, want 127", got)
+	}
+}
+
+func TestRivalriesFourMeetingsFirst(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.json(statsbookHost+"/statsbook/get_stat_summary.php", []NHRLStatSummary{
+		{Bot: "Lynx", Fights: 12},
+		{Bot: "Zeus", Fights: 10},
+		{Bot: "Bolt", Fights: 6},
+	})
+	stub.statsbookByBot("get_head_to_head.php", map[string]interface{}{
+		"Lynx": []NHRLHeadToHead{
+			{OpponentUniqueName: "Zeus", NumFights: 4, Wins: 3, Losses: 1, LastMeeting: "2025-06-14"},
+			{OpponentUniqueName: "Bolt", NumFights: 2, Wins: 1, Losses: 1, LastMeeting: "2025-03-08"},
+		},
+		"Zeus": []NHRLHeadToHead{
+			{OpponentUniqueName: "Lynx", NumFights: 4, Wins: 1, Losses: 3, LastMeeting: "2025-06-14"},
+			{OpponentUniqueName: "Hydra", NumFights: 1, Wins: 1},
+		},
+		"Bolt": []NHRLHeadToHead{
+			{OpponentUniqueName: "Lynx", NumFights: 2, Wins: 1, Losses: 1, LastMeeting: "2025-03-08"},
+		},
+	})
+
+	output, err := getNHRLRivalriesTool(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("get_rivalries: %v", err)
+	}
+	result := decodeResult(t, output)
+	if _, ok := result["season"]; ok || result["scan_season"] != "all-time" {
+		t.Errorf("season = %v, scan_season = %v; want only the scanned season, since meetings are all-time", result["season"], result["scan_season"])
+	}
+	rivalries := result["rivalries"].([]interface{})
+	if len(rivalries) != 2 {
+		t.Fatalf("rivalries = %v, want Lynx-Zeus and Lynx-Bolt, each counted once", rivalries)
+	}
+	top := rivalries[0].(map[string]interface{})
+	if top["bot1"] != "Lynx" || top["bot2"] != "Zeus" || top["meetings"].(float64) != 4 || top["record"] != "3-1" {
+		t.Errorf("top rivalry = %v, want Lynx vs Zeus, 4 meetings, 3-1", top)
+	}
+
+	// Head-to-head records are cached between calls
+	h2hPath := statsbookHost + "/statsbook/get_head_to_head.php"
+	calls := stub.count(h2hPath)
+	if _, err := getNHRLRivalriesTool(map[string]interface{}{}); err != nil {
+		t.Fatalf("get_rivalries: %v", err)
+	}
+	if again := stub.count(h2hPath); again != calls {
+		t.Errorf("head-to-head requests went from %d to %d on a repeat call, want cached", calls, again)
+	}
+}
+
+func TestRosterServedFromCache(t *testing.T) {
+	stub := newUpstreamStub(t)
+	summaryPath := statsbookHost + "/statsbook/get_stat_summary_simple.php"
+	stub.json(summaryPath, []NHRLStatSummary{{Bot: "zeus"}, {Bot: "Lynx"}, {Bot: ""}, {Bot: "Bolt"}})
+
+	output, err := getNHRLRosterTool(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("get_roster: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["cached"] != false {
+		t.Errorf("first call cached = %v, want false", result["cached"])
+	}
+	bots := result["bots"].([]interface{})
+	if len(bots) != 3 || bots[0] != "Bolt" || bots[1] != "Lynx" || bots[2] != "zeus" {
+		t.Errorf("bots = %v, want [Bolt Lynx zeus]", bots)
+	}
+
+	output, err = getNHRLRosterTool(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("get_roster: %v", err)
+	}
+	if cached := decodeResult(t, output)["cached"]; cached != true {
+		t.Errorf("second call cached = %v, want true", cached)
+	}
+	if calls := stub.count(summaryPath); calls != 1 {
+		t.Errorf("upstream summary requests = %d, want 1", calls)
+	}
+}
+
+func TestMatchTimelinePhases(t *testing.T) {
+	stub := newUpstreamStub(t)
+
+	full := bzMatch("m1", "Q1", "Lynx", "Zeus", 1)
+	full.AvailableSince = "1750000000"
+	full.CalledSince = "1750000120000" // milliseconds
+	full.StartTime = "2025-06-15 15:05:00"
+	full.StopTime = "2025-06-15T15:08:00Z"
+	full.EndTime = "1750000500"
+	partial := bzMatch("m2", "Q1", "Hydra", "Bolt", 0)
+	partial.AvailableSince = "1750000000"
+	partial.CalledSince = "null"
+	partial.StartTime = "0"
+	partial.MatchLength = "95"
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {full, partial}})
+
+	output, err := getBrettZoneMatchTimelineTool(map[string]interface{}{"tournament_id": "t1", "game_id": "m1"})
+	if err != nil {
+		t.Fatalf("get_match_timeline: %v", err)
+	}
+	result := decodeResult(t, output)
+	durations := result["durations"].(map[string]interface{})
+	// available 15:06:40, called 15:08:40 (ms epoch), started 15:05:00 (before called)
+	if durations["wait_to_call_secs"] != 120.0 {
+		t.Errorf("wait_to_call_secs = %v, want 120", durations["wait_to_call_secs"])
+	}
+	if durations["call_to_start_secs"] != nil {
+		t.Errorf("call_to_start_secs = %v, want null when start precedes the call", durations["call_to_start_secs"])
+	}
+	if durations["fight_length_secs"] != 180.0 {
+		t.Errorf("fight_length_secs = %v, want 180", durations["fight_length_secs"])
+	}
+	for _, e := range result["timeline"].([]interface{}) {
+		if entry := e.(map[string]interface{}); entry["timestamp"] == nil {
+			t.Errorf("phase %v has no timestamp", entry["phase"])
+		}
+	}
+
+	output, err = getBrettZoneMatchTimelineTool(map[string]interface{}{"tournament_id": "t1", "game_id": "m2"})
+	if err != nil {
+		t.Fatalf("get_match_timeline: %v", err)
+	}
+	result = decodeResult(t, output)
+	durations = result["durations"].(map[string]interface{})
+	if durations["wait_to_call_secs"] != nil || durations["call_to_start_secs"] != nil {
+		t.Errorf("durations = %v, want null spans for missing phases", durations)
+	}
+	if durations["fight_length_secs"] != 95.0 {
+		t.Errorf("fight_length_secs = %v, want the 95s match length fallback", durations["fight_length_secs"])
+	}
+	missing := 0
+	for _, e := range result["timeline"].([]interface{}) {
+		if e.(map[string]interface{})["timestamp"] == nil {
+			missing++
+		}
+	}
+	if missing != 4 {
+		t.Errorf("phases without a timestamp = %d, want 4", missing)
+	}
+
+	if _, err := getBrettZoneMatchTimelineTool(map[string]interface{}{"tournament_id": "t1", "game_id": "m9"}); err == nil {
+		t.Error("expected an error for an unknown game_id")
+	}
+}
+
+func TestGlobalLeaderboardAcrossClassSizes(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.statSummaryByClass(map[string][]NHRLStatSummary{
+		"1": { // 3lb
+			{Bot: "Lynx", Ranking: 1, Points: "30", W: 8, L: 2, Fights: 10},
+			{Bot: "Zeus", Ranking: 2, Points: "20", W: 5, L: 5, Fights: 10},
+			{Bot: "Bolt", Ranking: 3, Points: "10", W: 1, L: 4, Fights: 5},
+		},
+		"2": { // 12lb
+			{Bot: "Hydra", Ranking: 1, Points: "50", W: 9, L: 0, Fights: 9},
+			{Bot: "Mole", Ranking: 2, Points: "5", W: 0, L: 3, Fights: 3},
+		},
+		"4": { // 30lb
+			{Bot: "Titan", Ranking: 1, Points: "1", W: 1, L: 1, Fights: 2},
+		},
+	})
+
+	output, err := getNHRLGlobalLeaderboardTool(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("get_global_leaderboard: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["total_bots"].(float64) != 6 {
+		t.Errorf("total_bots = %v, want 6", result["total_bots"])
+	}
+	sizes := result["class_sizes"].(map[string]interface{})
+	if sizes["3lb"] != 3.0 || sizes["12lb"] != 2.0 || sizes["30lb"] != 1.0 {
+		t.Errorf("class_sizes = %v, want 3/2/1", sizes)
+	}
+
+	want := []struct {
+		bot        string
+		class      string
+		percentile float64
+	}{
+		// Class leaders share the 100th percentile and are ordered by win %
+		{"Hydra", "12lb", 100},
+		{"Lynx", "3lb", 100},
+		{"Titan", "30lb", 100},
+		{"Zeus", "3lb", 50},
+		{"Bolt", "3lb", 0},
+		{"Mole", "12lb", 0},
+	}
+	leaderboard := result["leaderboard"].([]interface{})
+	if len(leaderboard) != len(want) {
+		t.Fatalf("leaderboard has %d entries, want %d", len(leaderboard), len(want))
+	}
+	for i, w := range want {
+		entry := leaderboard[i].(map[string]interface{})
+		if entry["bot"] != w.bot || entry["weight_class"] != w.class || entry["points_percentile"] != w.percentile {
+			t.Errorf("rank %d = %v (%v, %v), want %s (%s, %v)", i+1, entry["bot"], entry["weight_class"], entry["points_percentile"], w.bot, w.class, w.percentile)
+		}
+	}
+}
+
+// epoch formats a BrettZone timestamp offset seconds after 2025-06-15T15:06:40Z
+func epoch(offset int) string {
+	return strconv.Itoa(1750000000 + offset)
+}
+
+func TestIdleGapsFindsOneClearGap(t *testing.T) {
+	stub := newUpstreamStub(t)
+
+	timed := func(id, cage string, available, start, stop int) BrettZoneMatch {
+		match := bzMatch(id, "Q1", "Lynx", "Zeus", 1)
+		match.Cage = cage
+		match.AvailableSince, match.StartTime, match.StopTime = epoch(available), epoch(start), epoch(stop)
+		return match
+	}
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {
+		timed("m1", "Cage 1", 0, 0, 180),
+		// Ready at 200 but not started until 500: a 300s idle gap
+		timed("m2", "Cage 1", 200, 500, 600),
+		// Ready before m2 stopped and started 20s after it: too short to count
+		timed("m3", "Cage 1", 550, 620, 700),
+		timed("m4", "Cage 2", 0, 10, 100),
+	}})
+
+	output, err := getBrettZoneIdleGapsTool(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("find_idle_gaps: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["idle_total_secs"] != 300.0 {
+		t.Errorf("idle_total_secs = %v, want 300", result["idle_total_secs"])
+	}
+	cages := result["cages"].([]interface{})
+	if len(cages) != 2 {
+		t.Fatalf("cages = %v, want two", cages)
+	}
+	gaps := cages[0].(map[string]interface{})["gaps"].([]interface{})
+	if len(gaps) != 1 {
+		t.Fatalf("cage 1 gaps = %v, want one", gaps)
+	}
+	gap := gaps[0].(map[string]interface{})
+	if gap["start"] != "2025-06-15T15:10:00Z" || gap["end"] != "2025-06-15T15:15:00Z" ||
+		gap["after_match"] != "m1" || gap["before_match"] != "m2" {
+		t.Errorf("gap = %v, want m1→m2 from 15:10:00 to 15:15:00", gap)
+	}
+	if cage2 := cages[1].(map[string]interface{}); cage2["gap_count"] != 0.0 {
+		t.Errorf("cage 2 gap_count = %v, want 0", cage2["gap_count"])
+	}
+}
+
+func TestDebutBotsPriorVsNoHistory(t *testing.T) {
+	stub := newUpstreamStub(t)
+
+	opener := bzMatch("m1", "Q1", "Lynx", "Zeus", 1)
+	opener.AvailableSince = epoch(0)
+	second := bzMatch("m2", "Q1", "Bolt", "Lynx", 2)
+	second.StartTime = epoch(900)
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {opener, second}})
+	stub.statsbookByBot("get_fights.php", map[string]interface{}{
+		"Lynx": []NHRLFight{{Date: "2024-03-09"}, {Date: "2025-06-15"}},
+		// Zeus's only fights are at this event
+		"Zeus": []NHRLFight{{Date: "2025-06-15"}},
+	})
+
+	output, err := getNHRLDebutBotsTool(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_debut_bots: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["participant_count"] != 3.0 || result["event_start"] != "2025-06-15" {
+		t.Errorf("participant_count = %v, event_start = %v; want 3, 2025-06-15", result["participant_count"], result["event_start"])
+	}
+	debuts := result["debut_bots"].([]interface{})
+	if len(debuts) != 2 {
+		t.Fatalf("debut_bots = %v, want Bolt and Zeus", debuts)
+	}
+	bolt, zeus := debuts[0].(map[string]interface{}), debuts[1].(map[string]interface{})
+	if bolt["bot_name"] != "Bolt" || bolt["first_fight"] != nil {
+		t.Errorf("first debut = %v, want Bolt with no fight history", bolt)
+	}
+	if zeus["bot_name"] != "Zeus" || zeus["first_fight"] != "2025-06-15" {
+		t.Errorf("second debut = %v, want Zeus first fighting at this event", zeus)
+	}
+}
+
+func TestRecordVsBotTypeTwoArchetypes(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.statsbookByBot("get_head_to_head.php", map[string]interface{}{"Lynx": []NHRLHeadToHead{
+		{OpponentUniqueName: "Zeus", NumFights: 3, Wins: 2, Losses: 1, KOs: 1},
+		{OpponentUniqueName: "Bolt", NumFights: 1, Wins: 1, Losses: 0},
+		{OpponentUniqueName: "Hydra", NumFights: 2, Wins: 0, Losses: 2, KOd: 2},
+		{OpponentUniqueName: "Mole", NumFights: 1, Wins: 1, Losses: 0},
+	}})
+	stub.liveStats(map[string]NHRLLiveFightStats{
+		"Zeus":  {BotType: "Vertical Spinner"},
+		"Bolt":  {BotType: "Eggbeater"},
+		"Hydra": {BotType: "Hydraulic Flipper"},
+	})
+
+	output, err := getNHRLRecordVsBotTypeTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_record_vs_bot_type: %v", err)
+	}
+	byArchetype := decodeResult(t, output)["by_archetype"].(map[string]interface{})
+	if len(byArchetype) != 3 {
+		t.Fatalf("by_archetype = %v, want vertical, flipper, and unknown", byArchetype)
+	}
+
+	vertical := byArchetype["vertical"].(map[string]interface{})
+	if vertical["wins"] != 3.0 || vertical["losses"] != 1.0 || vertical["kos"] != 1.0 || vertical["win_pct"] != 0.75 {
+		t.Errorf("vertical = %v, want 3-1 with 1 KO", vertical)
+	}
+	flipper := byArchetype["flipper"].(map[string]interface{})
+	if flipper["wins"] != 0.0 || flipper["losses"] != 2.0 || flipper["kod"] != 2.0 {
+		t.Errorf("flipper = %v, want 0-2, KO'd twice", flipper)
+	}
+	unknown := byArchetype["unknown"].(map[string]interface{})
+	if opponents := unknown["opponents"].([]interface{}); len(opponents) != 1 || opponents[0] != "Mole" {
+		t.Errorf("unknown opponents = %v, want Mole (no type on record)", opponents)
+	}
+}
+
+func TestTournamentMatchesTruncatesLargeTournament(t *testing.T) {
+	stub := newUpstreamStub(t)
+	matches := make([]BrettZoneMatch, maxBrettZoneMatches+10)
+	for i := range matches {
+		matches[i] = bzMatch("m"+strconv.Itoa(i), "Q1", "Lynx", "Zeus", 1)
+	}
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": matches})
+
+	output, err := getBrettZoneTournamentMatchesTool(map[string]interface{}{"tournament_id": "t1", "limit": 5.0})
+	if err != nil {
+		t.Fatalf("get_tournament_matches: %v", err)
+	}
+	result := decodeResult(t, output)
+	want := "Tournament has more than 5000 matches; only the first 5000 were processed"
+	if result["note"] != want {
+		t.Errorf("note = %v, want %q", result["note"], want)
+	}
+	if total := result["pagination"].(map[string]interface{})["total_count"]; total != float64(maxBrettZoneMatches) {
+		t.Errorf("total_count = %v, want %d", total, maxBrettZoneMatches)
+	}
+	if result["totalMatches"] != 5.0 {
+		t.Errorf("totalMatches = %v, want only the enriched page of 5", result["totalMatches"])
+	}
+}
+
+func TestLatestMatchesLimitedStopsDecoding(t *testing.T) {
+	stub := newUpstreamStub(t)
+	matches := make([]BrettZoneMatch, 1000)
+	for i := range matches {
+		matches[i] = bzMatch("m"+strconv.Itoa(i), "Q1", "Lynx", "Zeus", 1)
+	}
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": matches})
+
+	limited, truncated, err := getBrettZoneLatestMatchesLimited("t1", 100)
+	if err != nil {
+		t.Fatalf("getBrettZoneLatestMatchesLimited: %v", err)
+	}
+	if !truncated || len(limited) != 100 || limited[99].ID != "m99" {
+		t.Fatalf("got %d matches (truncated=%v), want the first 100 and truncated", len(limited), truncated)
+	}
+	// Decoding stops at the cap, so the slice never grows toward the full response
+	if cap(limited) > 2*100 {
+		t.Errorf("cap = %d, want memory bounded by the 100-match cap", cap(limited))
+	}
+
+	all, truncated, err := getBrettZoneLatestMatchesLimited("t1", 1000)
+	if err != nil || truncated || len(all) != 1000 {
+		t.Errorf("exact-size limit = %d matches, truncated=%v, err=%v; want all 1000 untruncated", len(all), truncated, err)
+	}
+}
+
+func TestBrettZoneBracketSmallBracket(t *testing.T) {
+	stub := newUpstreamStub(t)
+	// A four-bot double elimination bracket that goes to a reset, served out of order
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {
+		bzMatch("GFR-1", "GFR", "Lynx", "Bolt", 0),
+		bzMatch("LF-1", "LF", "Hydra", "Bolt", 2),
+		bzMatch("GF-1", "GF", "Lynx", "Bolt", 2),
+		bzMatch("W1-2", "W1", "Bolt", "Hydra", 2),
+		bzMatch("WF-1", "WF", "Lynx", "Hydra", 1),
+		bzMatch("Q1-1", "Q1", "Lynx", "Mole", 1),
+		bzMatch("W1-1", "W1", "Lynx", "Zeus", 1),
+		bzMatch("L1-1", "L1", "Zeus", "Bolt", 2),
+	}})
+
+	output, err := getBrettZoneBracketTool(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_brettzone_bracket: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["totalGames"] != 8.0 || result["completedGames"] != 7.0 {
+		t.Errorf("totalGames = %v, completedGames = %v; want 8 and 7", result["totalGames"], result["completedGames"])
+	}
+
+	// Each side's final closes it out, and the reset comes after the grand final
+	want := []struct {
+		bracketType string
+		round       float64
+		games       []string
+	}{
+		{"qualifying", 1, []string{"Q1-1"}},
+		{"winners", 1, []string{"W1-1", "W1-2"}},
+		{"winners", 0, []string{"WF-1"}},
+		{"losers", 1, []string{"L1-1"}},
+		{"losers", 0, []string{"LF-1"}},
+		{"grand_finals", 1, []string{"GF-1"}},
+		{"grand_finals", 2, []string{"GFR-1"}},
+	}
+	rounds := result["rounds"].([]interface{})
+	if len(rounds) != len(want) {
+		t.Fatalf("got %d rounds, want %d", len(rounds), len(want))
+	}
+	for i, w := range want {
+		round := rounds[i].(map[string]interface{})
+		games := round["games"].([]interface{})
+		if round["bracketType"] != w.bracketType || round["round"] != w.round || len(games) != len(w.games) {
+			t.Errorf("round %d = %v %v with %d games, want %s %v with %d", i, round["bracketType"], round["round"], len(games), w.bracketType, w.round, len(w.games))
+			continue
+		}
+		for j, name := range w.games {
+			if games[j].(map[string]interface{})["name"] != name {
+				t.Errorf("round %d game %d = %v, want %s", i, j, games[j].(map[string]interface{})["name"], name)
+			}
+		}
+	}
+	if reset := rounds[6].(map[string]interface{})["games"].([]interface{})[0].(map[string]interface{}); reset["winner"] != "undecided" {
+		t.Errorf("grand final reset winner = %v, want undecided", reset["winner"])
+	}
+}
+
+func TestGiantKillerUpsetsConcentratedOnOneBot(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.statSummaryByClass(map[string][]NHRLStatSummary{"1": {
+		{Bot: "Lynx", Ranking: 1, W: 9, Fights: 12},
+		{Bot: "Zeus", Ranking: 2, W: 7, Fights: 10},
+		{Bot: "Hydra", Ranking: 3, W: 5, Fights: 9},
+		{Bot: "Bolt", Ranking: 4, W: 4, Fights: 8},
+		{Bot: "Kite", W: 1, Fights: 2},
+	}})
+
+	history := stub.fightHistories()
+	history.fight("Bolt", "2025-06-14", bzMatch("g1", "Q1", "Bolt", "Lynx", 1))
+	history.fight("Bolt", "2025-06-14", bzMatch("g2", "W1", "Lynx", "Bolt", 2))
+	history.fight("Bolt", "2025-06-15", bzMatch("g3", "W2", "Zeus", "Bolt", 2))
+	// An upset from another season is not counted
+	history.fight("Bolt", "2024-05-11", atEvent(bzMatch("g5", "Q1", "Bolt", "Lynx", 1), "t0", "NHRL May 2024 3lb"))
+	history.fight("Hydra", "2025-06-14", bzMatch("g4", "Q1", "Hydra", "Lynx", 1))
+	// An unranked bot's win over any ranked bot is an upset
+	history.fight("Kite", "2025-06-14", bzMatch("g6", "Q2L", "Kite", "Hydra", 1))
+
+	output, err := getNHRLGiantKillerTool(map[string]interface{}{"season": "2025"})
+	if err != nil {
+		t.Fatalf("get_giant_killer: %v", err)
+	}
+	result := decodeResult(t, output)
+	killer := result["giant_killer"].(map[string]interface{})
+	if killer["bot"] != "Bolt" || killer["upset_wins"] != 3.0 {
+		t.Fatalf("giant_killer = %v, want Bolt with 3 upset wins", killer)
+	}
+	victims := killer["victims"].([]interface{})
+	lynx := victims[0].(map[string]interface{})
+	if len(victims) != 2 || lynx["opponent"] != "Lynx" || lynx["wins"] != 2.0 || lynx["opponent_rank"] != 1.0 {
+		t.Errorf("victims = %v, want Lynx (twice) then Zeus", victims)
+	}
+	var bots []string
+	for _, entry := range result["leaderboard"].([]interface{}) {
+		bots = append(bots, entry.(map[string]interface{})["bot"].(string))
+	}
+	if strings.Join(bots, ",") != "Bolt,Kite,Hydra" {
+		t.Errorf("leaderboard = %v, want Bolt, then unranked Kite ahead of Hydra", bots)
+	}
+}
+
+func TestSearchByAnnotationSplitDecision(t *testing.T) {
+	stub := newUpstreamStub(t)
+	annotated := func(id, annotation string) BrettZoneMatch {
+		match := bzMatch(id, "Q1", "Lynx", "Zeus", 1)
+		match.WinAnnotation = annotation
+		return match
+	}
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {
+		annotated("m1", "JD - Split Decision"),
+		annotated("m2", "JD - Unanimous Decision"),
+		annotated("m3", "KO"),
+		annotated("m4", "split decision (2-1)"),
+		annotated("m5", ""),
+	}})
+
+	ids := func(output string) []string {
+		var found []string
+		for _, m := range decodeResult(t, output)["matches"].([]interface{}) {
+			found = append(found, m.(map[string]interface{})["matchID"].(string))
+		}
+		return found
+	}
+
+	output, err := getBrettZoneSearchByAnnotationTool(map[string]interface{}{"tournament_id": "t1", "annotation": "split decision"})
+	if err != nil {
+		t.Fatalf("search_by_annotation: %v", err)
+	}
+	if got := ids(output); len(got) != 2 || got[0] != "m1" || got[1] != "m4" {
+		t.Errorf("substring matches = %v, want [m1 m4]", got)
+	}
+
+	output, err = getBrettZoneSearchByAnnotationTool(map[string]interface{}{"tournament_id": "t1", "annotation": "^jd .*(split|unanimous)", "use_regex": true})
+	if err != nil {
+		t.Fatalf("search_by_annotation: %v", err)
+	}
+	if got := ids(output); len(got) != 2 || got[0] != "m1" || got[1] != "m2" {
+		t.Errorf("regex matches = %v, want [m1 m2]", got)
+	}
+
+	if _, err := getBrettZoneSearchByAnnotationTool(map[string]interface{}{"tournament_id": "t1", "annotation": "(split", "use_regex": true}); err == nil {
+		t.Error("an invalid regex was accepted")
+	}
+	long := strings.Repeat("a", maxAnnotationPatternLength+1)
+	if _, err := getBrettZoneSearchByAnnotationTool(map[string]interface{}{"tournament_id": "t1", "annotation": long, "use_regex": true}); err == nil {
+		t.Error("an over-long regex was accepted")
+	}
+}
+
+func TestEventHighlightsCategories(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.statSummaryByClass(map[string][]NHRLStatSummary{"1": {
+		{Bot: "Lynx", Ranking: 1},
+		{Bot: "Zeus", Ranking: 2},
+		{Bot: "Hydra", Ranking: 4},
+		{Bot: "Bolt", Ranking: 5},
+	}})
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {
+		endedBy(bzMatch("m1", "Q1", "Lynx", "Zeus", 1), "KO", "40"),
+		endedBy(bzMatch("m2", "Q1", "Bolt", "Hydra", 1), "JD", "180"),
+		endedBy(bzMatch("m3", "W1", "Lynx", "Bolt", 2), "KO", "25"),
+		endedBy(bzMatch("m4", "GF", "Bolt", "Zeus", 1), "KO", "60"),
+		endedBy(bzMatch("m5", "L1", "Hydra", "Zeus", 0), "", ""),
+	}})
+
+	output, err := getBrettZoneEventHighlightsTool(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_event_highlights: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["matchCount"] != 5.0 || result["decidedMatchCount"] != 4.0 {
+		t.Errorf("matchCount = %v, decidedMatchCount = %v; want 5 and 4", result["matchCount"], result["decidedMatchCount"])
+	}
+	highlights := result["highlights"].(map[string]interface{})
+
+	if ko := highlights["fastestKO"].(map[string]interface{}); ko["matchID"] != "m3" || ko["matchLengthSecs"] != 25.0 {
+		t.Errorf("fastestKO = %v, want m3 at 25s", ko)
+	}
+	if longest := highlights["longestMatch"].(map[string]interface{}); longest["matchID"] != "m2" {
+		t.Errorf("longestMatch = %v, want the 180s decision m2", longest)
+	}
+	upset := highlights["biggestUpset"].(map[string]interface{})
+	if upset["matchID"] != "m3" || upset["winner"] != "Bolt" || upset["rankGap"] != 4.0 {
+		t.Errorf("biggestUpset = %v, want #5 Bolt over #1 Lynx in m3", upset)
+	}
+	undefeated := highlights["undefeatedBots"].([]interface{})
+	if len(undefeated) != 1 || undefeated[0].(map[string]interface{})["bot"] != "Bolt" || undefeated[0].(map[string]interface{})["wins"] != 3.0 {
+		t.Errorf("undefeatedBots = %v, want Bolt at 3-0", undefeated)
+	}
+	champion := highlights["champion"].(map[string]interface{})
+	if champion["bot"] != "Bolt" || champion["runnerUp"] != "Zeus" {
+		t.Errorf("champion = %v, want Bolt over Zeus", champion)
+	}
+}
+
+func TestEventHighlightsChampionWinsBracketReset(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.statSummaryByClass(map[string][]NHRLStatSummary{"1": {}})
+
+	// Bolt takes the grand final from the losers side, and Lynx wins the reset.
+	// At t2 both games are labelled GF, so the one that ended last decides it.
+	first := bzMatch("n1", "GF", "Lynx", "Bolt", 2)
+	first.TournamentID, first.EndTime = "t2", epoch(0)
+	second := bzMatch("n2", "GF", "Lynx", "Bolt", 1)
+	second.TournamentID, second.EndTime = "t2", epoch(600)
+	// At t3 the reset has not been fought yet
+	pending := bzMatch("p2", "GFR", "Lynx", "Bolt", 0)
+	pending.TournamentID = "t3"
+	decided := bzMatch("p1", "GF", "Lynx", "Bolt", 2)
+	decided.TournamentID = "t3"
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{
+		"t1": {
+			bzMatch("m2", "GFR", "Lynx", "Bolt", 1),
+			bzMatch("m1", "GF", "Lynx", "Bolt", 2),
+		},
+		"t2": {first, second},
+		"t3": {pending, decided},
+	})
+
+	for _, tid := range []string{"t1", "t2"} {
+		output, err := getBrettZoneEventHighlightsTool(map[string]interface{}{"tournament_id": tid})
+		if err != nil {
+			t.Fatalf("%s: get_event_highlights: %v", tid, err)
+		}
+		champion := decodeResult(t, output)["highlights"].(map[string]interface{})["champion"].(map[string]interface{})
+		if champion["bot"] != "Lynx" || champion["runnerUp"] != "Bolt" {
+			t.Errorf("%s: champion = %v, want Lynx over Bolt in the reset", tid, champion)
+		}
+	}
+
+	output, err := getBrettZoneEventHighlightsTool(map[string]interface{}{"tournament_id": "t3"})
+	if err != nil {
+		t.Fatalf("t3: get_event_highlights: %v", err)
+	}
+	if champion := decodeResult(t, output)["highlights"].(map[string]interface{})["champion"]; champion != nil {
+		t.Errorf("t3: champion = %v, want none before the reset is fought", champion)
+	}
+}
+
+func TestFindBotLiveInOneOfTwoTournaments(t *testing.T) {
+	stub := newUpstreamStub(t)
+
+	// t1 (3lb): Lynx already fought; t2 (12lb): Lynx is in the cage now and queued again later
+	done := bzMatch("m1", "Q1", "Lynx", "Zeus", 1)
+	fighting := bzMatch("m2", "Q1", "Hydra", "Lynx", 0)
+	fighting.TournamentID, fighting.Cage = "t2", "Cage 3"
+	fighting.StartTime = epoch(0)
+	later := bzMatch("m3", "Q2W", "Lynx", "Bolt", 0)
+	later.TournamentID = "t2"
+	other := bzMatch("m4", "Q1", "Bolt", "Mole", 0)
+	other.TournamentID = "t2"
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {done}, "t2": {later, fighting, other}})
+
+	output, err := getBrettZoneFindBotLiveTool(map[string]interface{}{"bot_name": "lynx", "tournament_ids": "t1, t2"})
+	if err != nil {
+		t.Fatalf("find_bot_live: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["isLive"] != true {
+		t.Errorf("isLive = %v, want true", result["isLive"])
+	}
+	matches := result["matches"].([]interface{})
+	if len(matches) != 2 {
+		t.Fatalf("matches = %v, want Lynx's two open matches in t2", matches)
+	}
+	now := matches[0].(map[string]interface{})
+	if now["tournamentID"] != "t2" || now["matchID"] != "m2" || now["status"] != "fighting" ||
+		now["cage"] != "Cage 3" || now["opponent"] != "Hydra" {
+		t.Errorf("first match = %v, want m2 fighting Hydra in Cage 3 at t2", now)
+	}
+	if url, _ := now["reviewURL"].(string); !strings.Contains(url, "gameID=m2&tournamentID=t2") || !strings.Contains(url, "Cage-3") {
+		t.Errorf("reviewURL = %q, want a Cage 3 link to m2 in t2", url)
+	}
+	if next := matches[1].(map[string]interface{}); next["matchID"] != "m3" || next["status"] != "scheduled" {
+		t.Errorf("second match = %v, want m3 scheduled", next)
+	}
+}
+
+func TestQualifierVsPlacementStrongQualifierPoorPlacement(t *testing.T) {
+	stub := newUpstreamStub(t)
+
+	history := stub.fightHistories()
+	// June: Lynx sweeps qualifying, then goes out first in a four-bot bracket
+	history.fight("Lynx", "2025-06-14", bzMatch("g1", "Q1", "Lynx", "Mole", 1))
+	history.fight("Lynx", "2025-06-14", bzMatch("g2", "Q2W", "Lynx", "Kite", 1))
+	history.fight("Lynx", "2025-06-15", bzMatch("g3", "W1", "Lynx", "Zeus", 2))
+	history.fight("Lynx", "2025-06-15", bzMatch("g6", "L1", "Lynx", "Bolt", 2))
+	history.match(
+		bzMatch("g4", "W1", "Bolt", "Hydra", 2),
+		bzMatch("g5", "W2", "Zeus", "Hydra", 1),
+		bzMatch("g7", "L2", "Hydra", "Bolt", 1),
+		bzMatch("g8", "GF", "Zeus", "Hydra", 1),
+	)
+	// August: Lynx scrapes through qualifying and wins the event
+	august := func(match BrettZoneMatch) BrettZoneMatch { return atEvent(match, "t2", "NHRL August 2025 3lb") }
+	history.fight("Lynx", "2025-08-09", august(bzMatch("h1", "Q1", "Lynx", "Mole", 2)))
+	history.fight("Lynx", "2025-08-09", august(bzMatch("h2", "Q2L", "Lynx", "Kite", 1)))
+	history.fight("Lynx", "2025-08-09", august(bzMatch("h3", "Q3", "Lynx", "Bolt", 1)))
+	history.fight("Lynx", "2025-08-10", august(bzMatch("h4", "W1", "Lynx", "Zeus", 1)))
+	history.fight("Lynx", "2025-08-10", august(bzMatch("h5", "GF", "Lynx", "Hydra", 1)))
+	history.unlinked("Lynx", NHRLFight{Date: "2025-09-01", Round: "Q1"})
+
+	output, err := getNHRLQualifierVsPlacementTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_qualifier_vs_placement: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["event_count"] != 2.0 || result["unmatched_fights"] != 1.0 {
+		t.Fatalf("event_count = %v, unmatched_fights = %v; want 2 and 1", result["event_count"], result["unmatched_fights"])
+	}
+
+	want := []struct {
+		tournamentID                    string
+		qualWins, qualLosses, placement float64
+		bracketWins, bracketLosses      float64
+		rate                            float64
+	}{
+		{"t2", 2, 1, 1, 2, 0, 2.0 / 3},
+		{"t1", 2, 0, 4, 0, 2, 1},
+	}
+	events := result["events"].([]interface{})
+	for i, w := range want {
+		event := events[i].(map[string]interface{})
+		if event["tournament_id"] != w.tournamentID || event["made_bracket"] != true {
+			t.Errorf("event %d = %v made_bracket %v, want %s in the bracket", i, event["tournament_id"], event["made_bracket"], w.tournamentID)
+		}
+		if event["qualifier_wins"] != w.qualWins || event["qualifier_losses"] != w.qualLosses || event["qualifier_win_rate"] != w.rate {
+			t.Errorf("event %d qualifiers = %v-%v (%v), want %v-%v (%v)", i, event["qualifier_wins"], event["qualifier_losses"], event["qualifier_win_rate"], w.qualWins, w.qualLosses, w.rate)
+		}
+		if event["bracket_wins"] != w.bracketWins || event["bracket_losses"] != w.bracketLosses || event["placement"] != w.placement {
+			t.Errorf("event %d bracket = %v-%v placing %v, want %v-%v placing %v", i, event["bracket_wins"], event["bracket_losses"], event["placement"], w.bracketWins, w.bracketLosses, w.placement)
+		}
+	}
+}
+
+func TestActivityTrendPerSeasonCounts(t *testing.T) {
+	stub := newUpstreamStub(t)
+	bySeason := map[string][]NHRLStatSummary{
+		"2018-19": {{Bot: "Lynx", Fights: 3}, {Bot: "Bolt", Fights: 3}},
+		"2021": {
+			{Bot: "Lynx", Fights: 4},
+			{Bot: "Bolt", Fights: 2},
+			{Bot: "Zeus", Fights: 2},
+			{Bot: "Hydra", Fights: 4},
+			{Bot: "Mole", Fights: 0},
+		},
+		"2022": {{Bot: "Lynx", Fights: 1}, {Bot: "Zeus", Fights: 1}},
+	}
+	stub.statsbook("get_stat_summary.php", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("category_id") != "2" {
+			t.Errorf("category_id = %q, want 2", r.URL.Query().Get("category_id"))
+		}
+		season := r.URL.Query().Get("season")
+		if season == "2023" {
+			http.Error(w, "down", http.StatusInternalServerError)
+			return
+		}
+		rows, ok := bySeason[season]
+		if !ok {
+			rows = []NHRLStatSummary{}
+		}
+		writeJSON(w, rows)
+	})
+
+	output, err := getNHRLActivityTrendTool(map[string]interface{}{"weight_class": "12lb"})
+	if err != nil {
+		t.Fatalf("get_activity_trend: %v", err)
+	}
+	result := decodeResult(t, output)
+	trend := result["trend"].([]interface{})
+	if result["season_count"] != float64(len(trend)) || len(trend) < 5 {
+		t.Fatalf("season_count = %v with %d entries, want one entry per season since 2018-2019", result["season_count"], len(trend))
+	}
+
+	want := []struct {
+		season       string
+		bots, fights float64
+		growth       interface{}
+	}{
+		{"2018-2019", 2, 3, nil},
+		{"2020", 0, 0, -100.0},
+		{"2021", 4, 6, 100.0},
+		{"2022", 2, 1, -50.0},
+	}
+	for i, w := range want {
+		entry := trend[i].(map[string]interface{})
+		if entry["season"] != w.season || entry["active_bots"] != w.bots || entry["total_fights"] != w.fights || entry["bot_growth_pct"] != w.growth {
+			t.Errorf("trend[%d] = %v: %v bots, %v fights, growth %v; want %s: %v bots, %v fights, growth %v",
+				i, entry["season"], entry["active_bots"], entry["total_fights"], entry["bot_growth_pct"], w.season, w.bots, w.fights, w.growth)
+		}
+	}
+	failed := trend[4].(map[string]interface{})
+	if failed["season"] != "2023" || failed["error"] == nil || failed["active_bots"] != nil {
+		t.Errorf("trend[4] = %v, want an error entry for 2023", failed)
+	}
+}
+
+func TestBotEventMatchesFiltersToOneBot(t *testing.T) {
+	stub := newUpstreamStub(t)
+	q1 := bzMatch("g1", "Q1", "Lynx", "Mole", 2)
+	q1.WinAnnotation, q1.MatchLength = "KO", "42"
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {
+		bzMatch("g5", "W1", "lynx", "Zeus", 0),
+		bzMatch("g4", "Q3", "Bolt", "Lynx", 2),
+		bzMatch("g9", "Q1", "Zeus", "Hydra", 1),
+		q1,
+		bzMatch("g2", "Q2L", "Lynx", "Kite", 1),
+		bzMatch("g8", "W1", "Bolt", "Hydra", 1),
+	}})
+
+	output, err := getBrettZoneBotEventMatchesTool(map[string]interface{}{"tournament_id": "t1", "bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_bot_event_matches: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["matchCount"] != 4.0 || result["wins"] != 2.0 || result["losses"] != 1.0 {
+		t.Errorf("matchCount = %v, wins = %v, losses = %v; want 4, 2, 1", result["matchCount"], result["wins"], result["losses"])
+	}
+
+	want := []struct{ id, round, opponent, result string }{
+		{"g1", "Q1", "Mole", "loss"},
+		{"g2", "Q2L", "Kite", "win"},
+		{"g4", "Q3", "Bolt", "win"},
+		{"g5", "W1", "Zeus", "pending"},
+	}
+	matches := result["matches"].([]interface{})
+	if len(matches) != len(want) {
+		t.Fatalf("got %d matches, want %d", len(matches), len(want))
+	}
+	for i, w := range want {
+		match := matches[i].(map[string]interface{})
+		if match["matchID"] != w.id || match["round"] != w.round || match["opponent"] != w.opponent || match["result"] != w.result {
+			t.Errorf("match %d = %v %v vs %v (%v), want %s %s vs %s (%s)", i, match["matchID"], match["round"], match["opponent"], match["result"], w.id, w.round, w.opponent, w.result)
+		}
+		if url, _ := match["reviewURL"].(string); !strings.Contains(url, w.id) {
+			t.Errorf("match %d reviewURL = %q, want a link to %s", i, url, w.id)
+		}
+	}
+	if first := matches[0].(map[string]interface{}); first["winMethod"] != "KO" || first["matchLengthSecs"] != "42" {
+		t.Errorf("first match method = %v in %v secs, want KO in 42", first["winMethod"], first["matchLengthSecs"])
+	}
+}
+
+// placedEvent builds a finished four-bot single elimination bracket in
+// tournament tid that bot finishes in place 1, 2, or 3; the bot's opening
+// match is tid+"-w1"
+func placedEvent(tid, bot string, place int) []BrettZoneMatch {
+	opener := bzMatch(tid+"-w1", "W1", bot, "Zeus", 1)
+	final := bzMatch(tid+"-w2", "W2", bot, "Hydra", 1)
+	switch place {
+	case 2:
+		final.Player1Wins, final.Player2Wins = "0", "1"
+	case 3:
+		opener.Player1Wins, opener.Player2Wins = "0", "1"
+		final.Player1 = "Zeus"
+	}
+	matches := []BrettZoneMatch{opener, bzMatch(tid+"-w1b", "W1", "Hydra", "Mole", 1), final}
+	for i := range matches {
+		matches[i].TournamentID = tid
+	}
+	return matches
+}
+
+func TestConsistencySteadyAndErratic(t *testing.T) {
+	stub := newUpstreamStub(t)
+	history := stub.fightHistories()
+	for bot, places := range map[string][]int{"Steady": {2, 2, 2}, "Wild": {1, 3, 1, 3}} {
+		for i, place := range places {
+			matches := placedEvent(bot+strconv.Itoa(i), bot, place)
+			history.fight(bot, "2025-0"+strconv.Itoa(i+3)+"-14", matches[0])
+			history.match(matches...)
+		}
+	}
+
+	for _, tc := range []struct {
+		bot         string
+		events      float64
+		score, mean float64
+		best, worst float64
+	}{
+		{"Steady", 3, 100, 2, 2, 2},
+		{"Wild", 4, 50, 2, 1, 3},
+	} {
+		output, err := getNHRLConsistencyTool(map[string]interface{}{"bot_name": tc.bot})
+		if err != nil {
+			t.Fatalf("get_consistency %s: %v", tc.bot, err)
+		}
+		result := decodeResult(t, output)
+		if placements := result["placements"].([]interface{}); result["event_count"] != tc.events || len(placements) != int(tc.events) {
+			t.Errorf("%s: event_count = %v with %d placements, want %v", tc.bot, result["event_count"], len(placements), tc.events)
+		}
+		if result["consistency_score"] != tc.score || result["mean_placement"] != tc.mean {
+			t.Errorf("%s: consistency_score = %v, mean_placement = %v; want %v and %v", tc.bot, result["consistency_score"], result["mean_placement"], tc.score, tc.mean)
+		}
+		if result["best_placement"] != tc.best || result["worst_placement"] != tc.worst {
+			t.Errorf("%s: placements span %v-%v, want %v-%v", tc.bot, result["best_placement"], result["worst_placement"], tc.best, tc.worst)
+		}
+	}
+}
+
+func TestStatSummarySortedByDerivedKORate(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.statSummaryByClass(map[string][]NHRLStatSummary{"1": {
+		{Bot: "Lynx", Ranking: 1, Fights: 20, W: 15, L: 5, KOs: 6},
+		{Bot: "Bolt", Ranking: 2, Fights: 5, W: 4, L: 1, KOs: 4},
+		{Bot: "Zeus", Ranking: 3, Fights: 2, W: 2, L: 0, KOs: 2},
+	}})
+
+	order := func(sortBy string) []string {
+		t.Helper()
+		output, err := getNHRLWeightClassStatSummaryTool(map[string]interface{}{"weight_class": "3lb", "sort_by": sortBy})
+		if err != nil {
+			t.Fatalf("get_weight_class_stat_summary sort_by %s: %v", sortBy, err)
+		}
+		var bots []string
+		for _, row := range decodeResult(t, output)["stats"].([]interface{}) {
+			bots = append(bots, row.(map[string]interface{})["bot"].(string))
+		}
+		return bots
+	}
+
+	if got := strings.Join(order("kos"), ","); got != "Lynx,Bolt,Zeus" {
+		t.Errorf("sorted by kos = %s, want Lynx,Bolt,Zeus", got)
+	}
+	if got := strings.Join(order("ko_rate"), ","); got != "Zeus,Bolt,Lynx" {
+		t.Errorf("sorted by ko_rate = %s, want Zeus,Bolt,Lynx", got)
+	}
+	if _, err := getNHRLWeightClassStatSummaryTool(map[string]interface{}{"weight_class": "3lb", "sort_by": "ko_ratio"}); err == nil || !strings.Contains(err.Error(), "ko_rate") {
+		t.Errorf("unknown sort_by error = %v, want one listing the valid keys", err)
+	}
+}
+
+func TestTournamentCamerasGroupedByCage(t *testing.T) {
+	stub := newUpstreamStub(t)
+	first := bzMatch("g1", "Q1", "Lynx", "Zeus", 1)
+	first.Cams = `["overhead","side-a"]`
+	second := bzMatch("g2", "Q1", "Bolt", "Hydra", 1)
+	second.Cams = "overhead, side-b"
+	third := bzMatch("g3", "Q1", "Mole", "Kite", 1)
+	third.Cage, third.Cams = "Cage 3", "overhead|pit"
+	silent := bzMatch("g4", "Q1", "Lynx", "Kite", 0)
+	silent.Cams = "null"
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {third, first, silent, second}})
+
+	output, err := getBrettZoneTournamentCamerasTool(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_tournament_cameras: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["matchCount"] != 4.0 || result["cameraCount"] != 4.0 {
+		t.Errorf("matchCount = %v, cameraCount = %v; want 4 and 4", result["matchCount"], result["cameraCount"])
+	}
+
+	want := []struct {
+		cage    string
+		cameras map[string]float64
+	}{
+		{"Cage 1", map[string]float64{"overhead": 2, "side-a": 1, "side-b": 1}},
+		{"Cage 3", map[string]float64{"overhead": 1, "pit": 1}},
+	}
+	byCage := result["camerasByCage"].([]interface{})
+	if len(byCage) != len(want) {
+		t.Fatalf("got %d cages, want %d", len(byCage), len(want))
+	}
+	for i, w := range want {
+		cage := byCage[i].(map[string]interface{})
+		cameras := cage["cameras"].([]interface{})
+		if cage["cage"] != w.cage || len(cameras) != len(w.cameras) {
+			t.Errorf("cage %d = %v with %d cameras, want %s with %d", i, cage["cage"], len(cameras), w.cage, len(w.cameras))
+			continue
+		}
+		for _, c := range cameras {
+			camera := c.(map[string]interface{})
+			if count, ok := w.cameras[camera["camera"].(string)]; !ok || camera["matchCount"] != count {
+				t.Errorf("%s camera %v seen in %v matches, want %v", w.cage, camera["camera"], camera["matchCount"], count)
+			}
+		}
+	}
+}
+
+func TestBotKOsSeparatesWinsFromLosses(t *testing.T) {
+	stub := newUpstreamStub(t)
+	history := stub.fightHistories()
+	history.fight("Lynx", "2025-06-14", endedBy(bzMatch("g1", "Q1", "Lynx", "Zeus", 1), "KO", "41"))
+	history.fight("Lynx", "2025-06-14", endedBy(bzMatch("g2", "Q2W", "Bolt", "Lynx", 2), "TKO", "95"))
+	history.fight("Lynx", "2025-06-15", endedBy(bzMatch("g3", "W1", "Lynx", "Hydra", 1), "JD", "180"))
+	history.fight("Lynx", "2025-06-15", endedBy(bzMatch("g4", "W2", "Lynx", "Mole", 2), "KO", "12"))
+
+	output, err := getNHRLBotKOsTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_bot_kos: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["ko_win_count"] != 2.0 || result["ko_loss_count"] != 1.0 {
+		t.Fatalf("ko_win_count = %v, ko_loss_count = %v; want 2 and 1", result["ko_win_count"], result["ko_loss_count"])
+	}
+
+	wins := result["ko_wins"].([]interface{})
+	// Newest first; both wins fell on the same day, so they keep fight order
+	want := []struct{ opponent, round, method, length string }{
+		{"Zeus", "Q1", "KO", "41"},
+		{"Bolt", "Q2W", "TKO", "95"},
+	}
+	for i, w := range want {
+		win := wins[i].(map[string]interface{})
+		if win["opponent"] != w.opponent || win["round"] != w.round || win["result_by"] != w.method || win["fight_length_secs"] != w.length {
+			t.Errorf("KO win %d = %v, want %s by %s in %s at %ss", i, win, w.opponent, w.method, w.round, w.length)
+		}
+		if link, _ := win["video_link"].(string); link == "" {
+			t.Errorf("KO win %d has no video link", i)
+		}
+	}
+	if loss := result["ko_losses"].([]interface{})[0].(map[string]interface{}); loss["opponent"] != "Mole" || loss["round"] != "W2" {
+		t.Errorf("KO loss = %v, want the W2 loss to Mole", loss)
+	}
+}
+
+func TestRankDeltaAcrossActiveAndAllTime(t *testing.T) {
+	stub := newUpstreamStub(t)
+	// pool builds a ranked summary of size bots, placing the named bots at their ranks
+	pool := func(size int, placed map[string]int) []NHRLStatSummary {
+		rows := make([]NHRLStatSummary, size)
+		for i := range rows {
+			rows[i] = NHRLStatSummary{Bot: "filler" + strconv.Itoa(i+1), Ranking: i + 1}
+		}
+		for bot, rank := range placed {
+			rows[rank-1].Bot = bot
+		}
+		return append(rows, NHRLStatSummary{Bot: "Unranked", Ranking: 0})
+	}
+	bySeason := map[string][]NHRLStatSummary{
+		"Active":   pool(10, map[string]int{"Lynx": 2}),
+		"All-time": pool(40, map[string]int{"Lynx": 20, "Bolt": 5}),
+	}
+	stub.statsbook("get_stat_summary.php", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, bySeason[r.URL.Query().Get("season")])
+	})
+
+	output, err := getNHRLRankDeltaTool(map[string]interface{}{"bot_name": "Lynx", "weight_class": "3lb"})
+	if err != nil {
+		t.Fatalf("get_rank_delta: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["active_rank"] != 2.0 || result["active_ranked"] != 10.0 || result["all_time_rank"] != 20.0 || result["all_time_ranked"] != 40.0 {
+		t.Errorf("ranks = %v of %v Active, %v of %v all-time; want 2 of 10 and 20 of 40",
+			result["active_rank"], result["active_ranked"], result["all_time_rank"], result["all_time_ranked"])
+	}
+	if result["active_top_percent"] != 20.0 || result["all_time_top_percent"] != 50.0 || result["percentile_delta"] != 30.0 {
+		t.Errorf("top percents = %v Active, %v all-time, delta %v; want 20, 50, 30",
+			result["active_top_percent"], result["all_time_top_percent"], result["percentile_delta"])
+	}
+	if interpretation, _ := result["interpretation"].(string); !strings.HasPrefix(interpretation, "Surging") {
+		t.Errorf("interpretation = %q, want Surging", interpretation)
+	}
+
+	output, err = getNHRLRankDeltaTool(map[string]interface{}{"bot_name": "Bolt"})
+	if err != nil {
+		t.Fatalf("get_rank_delta: %v", err)
+	}
+	result = decodeResult(t, output)
+	if result["active_rank"] != 0.0 || result["percentile_delta"] != nil || result["all_time_top_percent"] != 12.5 {
+		t.Errorf("inactive bot = %v, want no Active rank, no delta, and all-time top 12.5%%", result)
+	}
+}
+
+func TestSearchByDurationSubTenSeconds(t *testing.T) {
+	stub := newUpstreamStub(t)
+	timed := func(id, length string, winner int) BrettZoneMatch {
+		match := bzMatch(id, "Q1", "Lynx"+id, "Zeus"+id, winner)
+		match.MatchLength = length
+		return match
+	}
+	heavy := timed("g7", "4", 1)
+	heavy.WeightClass = "12"
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {
+		timed("g1", "9.5", 1),
+		timed("g2", "3", 2),
+		timed("g3", "10", 1),
+		timed("g4", "", 1),
+		timed("g5", "fast", 1),
+		timed("g6", "2", 0),
+		timed("g8", "180", 1),
+		heavy,
+	}})
+
+	output, err := getBrettZoneSearchByDurationTool(map[string]interface{}{"tournament_id": "t1", "max_secs": 9.99})
+	if err != nil {
+		t.Fatalf("search_by_duration: %v", err)
+	}
+	result := decodeResult(t, output)
+	var got []string
+	for _, m := range result["matches"].([]interface{}) {
+		got = append(got, m.(map[string]interface{})["matchID"].(string))
+	}
+	// Shortest first; blank, unparseable, and undecided matches are skipped
+	if strings.Join(got, ",") != "g2,g7,g1" {
+		t.Errorf("sub-10-second matches = %v, want g2,g7,g1", got)
+	}
+	if first := result["matches"].([]interface{})[0].(map[string]interface{}); first["winner"] != "Zeusg2" || first["matchLengthSecs"] != 3.0 {
+		t.Errorf("fastest match = %v, want Zeusg2 winning in 3s", first)
+	}
+
+	output, err = getBrettZoneSearchByDurationTool(map[string]interface{}{"tournament_id": "t1", "max_secs": 9.99, "weight_class": "3lb"})
+	if err != nil {
+		t.Fatalf("search_by_duration 3lb: %v", err)
+	}
+	if result := decodeResult(t, output); result["matchCount"] != 2.0 {
+		t.Errorf("3lb sub-10-second matchCount = %v, want 2", result["matchCount"])
+	}
+
+	if _, err := getBrettZoneSearchByDurationTool(map[string]interface{}{"tournament_id": "t1", "min_secs": 20.0, "max_secs": 10.0}); err == nil {
+		t.Error("min_secs above max_secs succeeded, want an error")
+	}
+}
+
+func TestChampionshipLineageFirstTitlesAndCounts(t *testing.T) {
+	stub := newUpstreamStub(t)
+	// Served newest first, with an undated event and one without a champion
+	stub.json(statsbookHost+"/statsbook/get_event_winners.php", []NHRLEventWinner{
+		{EventDate: "2025-08-09", FirstPlaceName: "Lynx", SecondPlaceName: "Zeus"},
+		{EventDate: "", FirstPlaceName: "Mole", SecondPlaceName: "Bolt"},
+		{EventDate: "2025-06-14", FirstPlaceName: "Lynx", SecondPlaceName: "Bolt"},
+		{EventDate: "2025-05-10", FirstPlaceName: "Zeus", SecondPlaceName: "Lynx"},
+		{EventDate: "2025-07-12", FirstPlaceName: "", SecondPlaceName: "Zeus"},
+		{EventDate: "2025-03-08", FirstPlaceName: "Lynx", SecondPlaceName: "Zeus"},
+	})
+
+	output, err := getNHRLChampionshipLineageTool(map[string]interface{}{"weight_class": "3lb"})
+	if err != nil {
+		t.Fatalf("get_championship_lineage: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["event_count"] != 5.0 || result["distinct_champions"] != 3.0 {
+		t.Errorf("event_count = %v, distinct_champions = %v; want 5 and 3", result["event_count"], result["distinct_champions"])
+	}
+
+	want := []struct {
+		date, champion  string
+		first, defended bool
+		count           float64
+	}{
+		{"2025-03-08", "Lynx", true, false, 1},
+		{"2025-05-10", "Zeus", true, false, 1},
+		{"2025-06-14", "Lynx", false, false, 2},
+		{"2025-08-09", "Lynx", false, true, 3},
+		{"", "Mole", true, false, 1},
+	}
+	lineage := result["lineage"].([]interface{})
+	if len(lineage) != len(want) {
+		t.Fatalf("got %d lineage entries, want %d", len(lineage), len(want))
+	}
+	for i, w := range want {
+		entry := lineage[i].(map[string]interface{})
+		if entry["event_date"] != w.date || entry["champion"] != w.champion || entry["first_title"] != w.first || entry["title_count"] != w.count || entry["defended_title"] != w.defended {
+			t.Errorf("lineage[%d] = %v, want %s won by %s (first %v, count %v, defended %v)", i, entry, w.date, w.champion, w.first, w.count, w.defended)
+		}
+	}
+	if leader := result["title_leaders"].([]interface{})[0].(map[string]interface{}); leader["bot"] != "Lynx" || leader["titles"] != 3.0 {
+		t.Errorf("title leader = %v, want Lynx with 3", leader)
+	}
+}
+
+func TestFinalsRecordSkipsOtherRounds(t *testing.T) {
+	stub := newUpstreamStub(t)
+	history := stub.fightHistories()
+	history.fight("Lynx", "2025-06-14", bzMatch("g1", "Q1", "Lynx", "Zeus", 1))
+	history.fight("Lynx", "2025-06-15", bzMatch("g2", "W1", "Lynx", "Bolt", 1))
+	history.fight("Lynx", "2025-06-15", bzMatch("g3", "WF", "Lynx", "Hydra", 2))
+	history.fight("Lynx", "2025-06-15", bzMatch("g4", "LF", "Lynx", "Bolt", 1))
+	history.fight("Lynx", "2025-06-15", bzMatch("g5", "GF", "Lynx", "Hydra", 1))
+	history.fight("Lynx", "2025-06-15", bzMatch("g6", "GFR", "Lynx", "Hydra", 1))
+
+	output, err := getNHRLFinalsRecordTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_finals_record: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["finals_count"] != 4.0 || result["wins"] != 3.0 || result["losses"] != 1.0 || result["win_pct"] != 75.0 {
+		t.Errorf("finals = %v fights, %v-%v (%v%%); want 4 fights, 3-1 (75%%)", result["finals_count"], result["wins"], result["losses"], result["win_pct"])
+	}
+	var rounds []string
+	for _, f := range result["fights"].([]interface{}) {
+		rounds = append(rounds, f.(map[string]interface{})["round"].(string))
+	}
+	if strings.Join(rounds, ",") != "WF,LF,GF,GFR" {
+		t.Errorf("finals rounds = %v, want WF,LF,GF,GFR", rounds)
+	}
+	byRound := result["by_round"].(map[string]interface{})
+	if wf := byRound["WF"].(map[string]interface{}); wf["wins"] != 0.0 || wf["losses"] != 1.0 {
+		t.Errorf("WF record = %v, want 0-1", wf)
+	}
+	if _, ok := byRound["W1"]; ok {
+		t.Error("by_round includes W1, want finals rounds only")
+	}
+}
+
+func TestPlanBracketSizes(t *testing.T) {
+	for _, tc := range []struct {
+		entrants                         float64
+		format                           string
+		advancing, bracket, byes, rounds float64
+		qualifierFights, qualifierRounds float64
+	}{
+		{16, "qualifiers", 8, 8, 0, 3, 20, 3},
+		{40, "qualifiers", 20, 32, 12, 5, 50, 3},
+		{64, "qualifiers", 32, 32, 0, 5, 80, 3},
+		{40, "direct", 40, 64, 24, 6, 0, 0},
+	} {
+		output, err := getNHRLPlanBracketTool(map[string]interface{}{"entrant_count": tc.entrants, "format": tc.format})
+		if err != nil {
+			t.Fatalf("plan_bracket %v %s: %v", tc.entrants, tc.format, err)
+		}
+		result := decodeResult(t, output)
+		if result["advancing"] != tc.advancing || result["bracket_size"] != tc.bracket || result["byes"] != tc.byes || result["bracket_rounds"] != tc.rounds {
+			t.Errorf("%v %s: %v advance to a %v bracket with %v byes over %v rounds; want %v, %v, %v, %v", tc.entrants, tc.format,
+				result["advancing"], result["bracket_size"], result["byes"], result["bracket_rounds"], tc.advancing, tc.bracket, tc.byes, tc.rounds)
+		}
+		if result["qualifier_fights"] != tc.qualifierFights || result["qualifier_rounds"] != tc.qualifierRounds {
+			t.Errorf("%v %s: %v qualifier fights over %v rounds, want %v over %v", tc.entrants, tc.format,
+				result["qualifier_fights"], result["qualifier_rounds"], tc.qualifierFights, tc.qualifierRounds)
+		}
+		if result["eliminated_in_qualifiers"] != tc.entrants-tc.advancing {
+			t.Errorf("%v %s: eliminated_in_qualifiers = %v, want %v", tc.entrants, tc.format, result["eliminated_in_qualifiers"], tc.entrants-tc.advancing)
+		}
+	}
+
+	if _, err := getNHRLPlanBracketTool(map[string]interface{}{"entrant_count": 12.5}); err == nil {
+		t.Error("fractional entrant_count succeeded, want an error")
+	}
+}
+
+func TestRecordByCageAcrossCagesOneAndThree(t *testing.T) {
+	stub := newUpstreamStub(t)
+	inCage := func(match BrettZoneMatch, cage string) BrettZoneMatch {
+		match.Cage = cage
+		return match
+	}
+	august := func(match BrettZoneMatch) BrettZoneMatch { return atEvent(match, "t2", "NHRL August 2025 3lb") }
+	// Only one fight per event is in the statsbook; the rest come from BrettZone
+	history := stub.fightHistories()
+	history.fight("Lynx", "2025-06-14", inCage(bzMatch("g1", "Q1", "Lynx", "Zeus", 1), "Cage 1"))
+	history.match(
+		inCage(bzMatch("g2", "Q2W", "Bolt", "Lynx", 1), "Cage 3"),
+		inCage(bzMatch("g3", "Q3", "Lynx", "Mole", 1), "Cage 3"),
+		inCage(bzMatch("g4", "W1", "Lynx", "Hydra", 0), "Cage 1"),
+		inCage(bzMatch("g5", "Q1", "Bolt", "Zeus", 1), "Cage 2"),
+	)
+	history.fight("Lynx", "2025-08-09", inCage(august(bzMatch("h1", "Q1", "Kite", "Lynx", 2)), "Cage 1"))
+	history.match(inCage(august(bzMatch("h2", "Q2W", "Lynx", "Zeus", 1)), "Cage 3"))
+
+	want := []struct {
+		cage                 string
+		wins, losses, winPct float64
+	}{
+		{"Cage 1", 2, 0, 100},
+		{"Cage 3", 2, 1, 66.7},
+	}
+	check := func(args map[string]interface{}, scope string, scanned string) {
+		t.Helper()
+		output, err := getBrettZoneRecordByCageTool(args)
+		if err != nil {
+			t.Fatalf("get_record_by_cage: %v", err)
+		}
+		result := decodeResult(t, output)
+		var tournaments []string
+		for _, id := range result["tournamentsScanned"].([]interface{}) {
+			tournaments = append(tournaments, id.(string))
+		}
+		if result["scope"] != scope || strings.Join(tournaments, ",") != scanned {
+			t.Errorf("scope = %v over %v, want %s over %s", result["scope"], tournaments, scope, scanned)
+		}
+		if result["wins"] != 4.0 || result["losses"] != 1.0 {
+			t.Errorf("record = %v-%v, want 4-1", result["wins"], result["losses"])
+		}
+		cages := result["cages"].([]interface{})
+		if len(cages) != len(want) {
+			t.Fatalf("got %d cages, want %d", len(cages), len(want))
+		}
+		for i, w := range want {
+			cage := cages[i].(map[string]interface{})
+			if cage["cage"] != w.cage || cage["wins"] != w.wins || cage["losses"] != w.losses || cage["winPct"] != w.winPct {
+				t.Errorf("cage %d = %v, want %s %v-%v (%v%%)", i, cage, w.cage, w.wins, w.losses, w.winPct)
+			}
+		}
+	}
+
+	check(map[string]interface{}{"bot_name": "Lynx", "tournament_ids": "t1, t2"}, "requested", "t1,t2")
+	// Without a scope the tournaments come from the fight history, newest first
+	check(map[string]interface{}{"bot_name": "Lynx"}, "recent_fight_history", "t2,t1")
+}
+
+func TestExportFightsCSVMatchesFightList(t *testing.T) {
+	stub := newUpstreamStub(t)
+	history := stub.fightHistories()
+	history.fight("Lynx", "2025-06-14", endedBy(bzMatch("g1", "Q1", "Lynx", "Zeus", 1), "KO", "41"))
+	history.fight("Lynx", "2025-06-14", endedBy(bzMatch("g2", "Q2W", "Bolt", "Lynx", 1), "JD, 2-1", "180"))
+	history.unlinked("Lynx", NHRLFight{Date: "2024-03-09", Round: "Q1", ResultBy: "KO"})
+
+	output, err := getNHRLExportFightsTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("export_fights: %v", err)
+	}
+	records, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+	if err != nil {
+		t.Fatalf("export is not valid CSV: %v\n%s", err, output)
+	}
+	if header := strings.Join(records[0], ","); header != "date,round,opponent,result,method,length_secs,video_link" {
+		t.Errorf("header = %s", header)
+	}
+
+	list, err := getNHRLBotFightsTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_bot_fights: %v", err)
+	}
+	if rows, fights := len(records)-1, decodeResult(t, list)["fight_count"]; float64(rows) != fights {
+		t.Errorf("CSV has %d rows, fight list has %v fights", rows, fights)
+	}
+
+	want := [][]string{
+		{"2025-06-14", "Q1", "Zeus", "win", "KO", "41"},
+		{"2025-06-14", "Q2W", "Bolt", "loss", "JD, 2-1", "180"},
+		{"2024-03-09", "Q1", "", "unknown", "KO", ""},
+	}
+	for i, w := range want {
+		row := records[i+1]
+		if strings.Join(row[:6], "|") != strings.Join(w, "|") {
+			t.Errorf("row %d = %q, want %q", i+1, row[:6], w)
+		}
+	}
+	if !strings.Contains(records[1][6], "gameID=g1") || records[3][6] != "" {
+		t.Errorf("video links = %q and %q, want g1's review link and none", records[1][6], records[3][6])
+	}
+}
+
+func TestParityIndexDominatedAndEven(t *testing.T) {
+	stub := newUpstreamStub(t)
+	field := func(wins ...int) []NHRLStatSummary {
+		rows := []NHRLStatSummary{{Bot: "Idle", W: 0, Fights: 0}}
+		for i, w := range wins {
+			rows = append(rows, NHRLStatSummary{Bot: "bot" + strconv.Itoa(i), W: w, Fights: w + 2})
+		}
+		return rows
+	}
+	bySeason := map[string][]NHRLStatSummary{
+		"2023": field(18, 0, 0, 0, 0, 0, 0, 0, 0, 0),
+		"2024": field(3, 3, 3, 3, 3, 3, 3, 3, 3, 3),
+	}
+	stub.statsbook("get_stat_summary.php", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, bySeason[r.URL.Query().Get("season")])
+	})
+
+	for _, tc := range []struct {
+		season, interpretation string
+		parity, topShare       float64
+	}{
+		{"2023", "Dominated", 0.9, 100},
+		{"2024", "Balanced", 0, 10},
+	} {
+		output, err := getNHRLParityIndexTool(map[string]interface{}{"season": tc.season})
+		if err != nil {
+			t.Fatalf("get_parity_index %s: %v", tc.season, err)
+		}
+		result := decodeResult(t, output)
+		if result["active_bots"] != 10.0 || result["top_10pct_bots"] != 1.0 {
+			t.Errorf("%s: active_bots = %v, top_10pct_bots = %v; want 10 and 1", tc.season, result["active_bots"], result["top_10pct_bots"])
+		}
+		if result["parity_index"] != tc.parity || result["top_10pct_win_share"] != tc.topShare {
+			t.Errorf("%s: parity_index = %v, top share = %v; want %v and %v", tc.season, result["parity_index"], result["top_10pct_win_share"], tc.parity, tc.topShare)
+		}
+		if interpretation, _ := result["interpretation"].(string); !strings.HasPrefix(interpretation, tc.interpretation) {
+			t.Errorf("%s: interpretation = %q, want %s", tc.season, interpretation, tc.interpretation)
+		}
+	}
+}
+
+func TestResultsSummaryNamesChampionAndMatchCount(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.statSummaryByClass(map[string][]NHRLStatSummary{})
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {
+		endedBy(bzMatch("g1", "Q1", "Lynx", "Zeus", 1), "KO", "12"),
+		endedBy(bzMatch("g2", "Q1", "Bolt", "Hydra", 1), "KO", "30"),
+		endedBy(bzMatch("g3", "Q2W", "Lynx", "Bolt", 1), "JD", "180"),
+		endedBy(bzMatch("g4", "GF", "Lynx", "Bolt", 2), "KO", "45"),
+		bzMatch("g5", "W1", "Mole", "Kite", 0),
+	}})
+
+	output, err := getBrettZoneResultsSummaryTool(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_results_summary: %v", err)
+	}
+	for _, want := range []string{
+		"## NHRL June 2025 3lb results",
+		"**Champion:** Bolt",
+		"**Finalists:** Bolt vs Lynx",
+		"- Lynx over Zeus in 12s (Q1)",
+		"**Matches fought:** 4",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("markdown summary is missing %q:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "180s") {
+		t.Errorf("markdown summary lists a judges' decision as a KO:\n%s", output)
+	}
+
+	output, err = getBrettZoneResultsSummaryTool(map[string]interface{}{"tournament_id": "t1", "output_format": "text"})
+	if err != nil {
+		t.Fatalf("get_results_summary text: %v", err)
+	}
+	if !strings.Contains(output, "Champion: Bolt (def. Lynx in the final)") || !strings.HasSuffix(output, "4 matches fought") {
+		t.Errorf("text summary = %q, want Bolt over Lynx and 4 matches", output)
+	}
+
+	output, err = getBrettZoneResultsSummaryTool(map[string]interface{}{"tournament_id": "t1", "output_format": "json"})
+	if err != nil {
+		t.Fatalf("get_results_summary json: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["champion"] != "Bolt" || result["decidedMatchCount"] != 4.0 || len(result["notableKOs"].([]interface{})) != 3 {
+		t.Errorf("json summary = %v, want champion Bolt, 4 matches, and 3 notable KOs", result)
+	}
+}
+
+func TestBotsByRegionTwoStates(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.statSummaryByClass(map[string][]NHRLStatSummary{"1": {
+		{Bot: "Zeus", Ranking: 3},
+		{Bot: "Lynx", Ranking: 1},
+		{Bot: "Hydra", Ranking: 0},
+		{Bot: "Bolt", Ranking: 2},
+	}})
+	stub.liveStats(map[string]NHRLLiveFightStats{
+		"Lynx": {DriverName: "Ana", City: "Pittsburgh", StateProvince: "PA", Country: "USA"},
+		"Bolt": {DriverName: "Ben", City: "Erie", StateProvince: "PA", Country: "USA"},
+		"Zeus": {DriverName: "Cy", City: "Albany", StateProvince: "NY", Country: "USA"},
+	})
+	liveStatsPath := statsbookHost + "/live_stats/query/get_fight_stats.php"
+
+	output, err := getNHRLBotsByRegionTool(map[string]interface{}{"weight_class": "3lb"})
+	if err != nil {
+		t.Fatalf("get_bots_by_region: %v", err)
+	}
+	result := decodeResult(t, output)
+	want := []struct {
+		region string
+		bots   string
+	}{
+		{"PA, USA", "Lynx,Bolt"},
+		{"NY, USA", "Zeus"},
+		{"Unknown", "Hydra"},
+	}
+	regions := result["regions"].([]interface{})
+	if result["bots_checked"] != 4.0 || len(regions) != len(want) {
+		t.Fatalf("checked %v bots into %d regions, want 4 into %d: %v", result["bots_checked"], len(regions), len(want), regions)
+	}
+	for i, w := range want {
+		region := regions[i].(map[string]interface{})
+		var bots []string
+		for _, b := range region["bots"].([]interface{}) {
+			bots = append(bots, b.(map[string]interface{})["bot"].(string))
+		}
+		if region["region"] != w.region || strings.Join(bots, ",") != w.bots {
+			t.Errorf("region %d = %v with %v, want %s with %s", i, region["region"], bots, w.region, w.bots)
+		}
+	}
+	if lynx := regions[0].(map[string]interface{})["bots"].([]interface{})[0].(map[string]interface{}); lynx["driver"] != "Ana" || lynx["city"] != "Pittsburgh" {
+		t.Errorf("Lynx = %v, want driver Ana from Pittsburgh", lynx)
+	}
+
+	// Profiles, including Hydra's missing one, are served from the cache on the next call
+	lookups := stub.count(liveStatsPath)
+	output, err = getNHRLBotsByRegionTool(map[string]interface{}{"weight_class": "3lb", "group_by": "country"})
+	if err != nil {
+		t.Fatalf("get_bots_by_region by country: %v", err)
+	}
+	if calls := stub.count(liveStatsPath); calls != lookups {
+		t.Errorf("second call made %d more profile lookups, want 0", calls-lookups)
+	}
+	if first := decodeResult(t, output)["regions"].([]interface{})[0].(map[string]interface{}); first["region"] != "USA" || first["bot_count"] != 3.0 {
+		t.Errorf("first country = %v, want USA with 3 bots", first)
+	}
+}
+
+func TestStreakCompositionMixesKOsAndDecisions(t *testing.T) {
+	stub := newUpstreamStub(t)
+	history := []struct {
+		date     string
+		matchNum int
+		won      bool
+		method   string
+	}{
+		{"2025-03-08", 1, false, "JD"},
+		{"2025-03-08", 2, false, "KO"},
+		{"2025-06-14", 1, true, "KO"},
+		{"2025-06-14", 2, false, "JD"},
+		{"2025-08-09", 1, true, "KO"},
+		{"2025-08-09", 2, true, "JD"},
+		{"2025-08-10", 1, true, "TKO"},
+		{"2025-08-10", 2, true, "Judges decision"},
+	}
+	var matches []BrettZoneMatch
+	var fights []NHRLFight
+	for i, h := range history {
+		id := "g" + strconv.Itoa(i+1)
+		winner := 2
+		if h.won {
+			winner = 1
+		}
+		matches = append(matches, bzMatch(id, "Q1", "Lynx", "Opp"+strconv.Itoa(i+1), winner))
+		fights = append(fights, NHRLFight{Date: h.date, MatchNum: h.matchNum, Round: "Q1", ResultBy: h.method, VideoLink: reviewLink(id, "t1")})
+	}
+	// Served newest first, the way the statsbook lists them
+	for i, j := 0, len(fights)-1; i < j; i, j = i+1, j-1 {
+		fights[i], fights[j] = fights[j], fights[i]
+	}
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": matches})
+	stub.statsbookByBot("get_fights.php", map[string]interface{}{"Lynx": fights})
+
+	for _, tc := range []struct {
+		streak, kind, summary string
+		length, ko, jd        float64
+		active                bool
+	}{
+		{"current", "win", "4-fight win streak: 2 KO, 2 JD, 0 other", 4, 2, 2, true},
+		{"longest_loss", "loss", "2-fight loss streak: 1 KO, 1 JD, 0 other", 2, 1, 1, false},
+	} {
+		output, err := getNHRLStreakCompositionTool(map[string]interface{}{"bot_name": "Lynx", "streak": tc.streak})
+		if err != nil {
+			t.Fatalf("get_streak_composition %s: %v", tc.streak, err)
+		}
+		result := decodeResult(t, output)
+		composition := result["composition"].(map[string]interface{})
+		if result["streak_type"] != tc.kind || result["streak_length"] != tc.length || result["is_active"] != tc.active {
+			t.Errorf("%s: %v %v streak (active %v), want %v %s (active %v)", tc.streak, result["streak_length"], result["streak_type"], result["is_active"], tc.length, tc.kind, tc.active)
+		}
+		if composition["ko"] != tc.ko || composition["jd"] != tc.jd || result["summary"] != tc.summary {
+			t.Errorf("%s: composition %v, summary %q; want %v KO, %v JD, %q", tc.streak, composition, result["summary"], tc.ko, tc.jd, tc.summary)
+		}
+	}
+}
+
+func TestWinMethodsDistinctWithCounts(t *testing.T) {
+	stub := newUpstreamStub(t)
+	annotated := func(id string, winner int, method string) BrettZoneMatch {
+		match := bzMatch(id, "Q1", "Lynx"+id, "Zeus"+id, winner)
+		match.WinAnnotation = method
+		return match
+	}
+	heavy := annotated("g9", 1, "DQ")
+	heavy.WeightClass = "12"
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {
+		annotated("g1", 1, "KO"),
+		annotated("g2", 2, " KO "),
+		annotated("g3", 1, "JD"),
+		annotated("g4", 2, "KO"),
+		annotated("g5", 1, "JD"),
+		annotated("g6", 1, ""),
+		annotated("g7", 2, "TKO"),
+		annotated("g8", 0, "KO"),
+		heavy,
+	}})
+
+	methods := func(result map[string]interface{}) string {
+		var parts []string
+		for _, m := range result["methods"].([]interface{}) {
+			method := m.(map[string]interface{})
+			parts = append(parts, method["method"].(string)+"="+strconv.Itoa(int(method["count"].(float64))))
+		}
+		return strings.Join(parts, ",")
+	}
+
+	output, err := getNHRLWinMethodsTool(map[string]interface{}{"tournament_id": "t1", "weight_class": "3lb"})
+	if err != nil {
+		t.Fatalf("list_win_methods: %v", err)
+	}
+	result := decodeResult(t, output)
+	if got := methods(result); got != "KO=3,JD=2,(blank)=1,TKO=1" {
+		t.Errorf("3lb methods = %s, want KO=3,JD=2,(blank)=1,TKO=1", got)
+	}
+	if result["method_count"] != 4.0 || result["fights_counted"] != 7.0 || result["source"] != "brettzone_win_annotation" {
+		t.Errorf("method_count = %v, fights_counted = %v, source = %v; want 4, 7, brettzone_win_annotation", result["method_count"], result["fights_counted"], result["source"])
+	}
+
+	// Class-wide, a fight between two sampled bots is counted once
+	stub.statSummaryByClass(map[string][]NHRLStatSummary{"1": {{Bot: "Zeus", Fights: 2}, {Bot: "Lynx", Fights: 3}}})
+	stub.statsbookByBot("get_fights.php", map[string]interface{}{
+		"Lynx": []NHRLFight{
+			{Date: "2025-06-14", Round: "Q1", MatchNum: 1, ResultBy: "KO"},
+			{Date: "2025-06-14", Round: "Q2W", MatchNum: 2, ResultBy: "JD"},
+		},
+		"Zeus": []NHRLFight{
+			{Date: "2025-06-14", Round: "Q1", MatchNum: 1, ResultBy: "KO"},
+			{Date: "2025-06-15", Round: "Q3", MatchNum: 3, ResultBy: "KO"},
+		},
+	})
+	output, err = getNHRLWinMethodsTool(map[string]interface{}{"weight_class": "3lb"})
+	if err != nil {
+		t.Fatalf("list_win_methods by class: %v", err)
+	}
+	result = decodeResult(t, output)
+	if got := methods(result); got != "KO=2,JD=1" || result["bots_scanned"] != 2.0 {
+		t.Errorf("class methods = %s from %v bots, want KO=2,JD=1 from 2", got, result["bots_scanned"])
+	}
+}
+
+func TestOpponentQualityTrendAcrossTwoSeasons(t *testing.T) {
+	stub := newUpstreamStub(t)
+	history := stub.fightHistories()
+	june23 := func(match BrettZoneMatch) BrettZoneMatch { return atEvent(match, "t1", "NHRL June 2023 3lb") }
+	june24 := func(match BrettZoneMatch) BrettZoneMatch { return atEvent(match, "t2", "NHRL June 2024 3lb") }
+	history.fight("Lynx", "2023-06-10", june23(bzMatch("g1", "Q1", "Lynx", "Zeus", 1)))
+	history.fight("Lynx", "2023-06-11", june23(bzMatch("g2", "Q2W", "Bolt", "Lynx", 2)))
+	history.fight("Lynx", "2024-06-08", june24(bzMatch("g3", "Q1", "Lynx", "Hydra", 2)))
+	history.fight("Lynx", "2024-06-08", june24(bzMatch("g4", "Q2L", "Mole", "Lynx", 2)))
+	history.fight("Lynx", "2024-06-09", june24(bzMatch("g5", "Q3L", "Lynx", "Kite", 1)))
+	// Zeus and Bolt are mid-table in 2023; Lynx meets the top of the table in 2024
+	bySeason := map[string][]NHRLStatSummary{
+		"2023": {{Bot: "Zeus", Ranking: 2}, {Bot: "Bolt", Ranking: 8}, {Bot: "Lynx", Ranking: 12}},
+		"2024": {{Bot: "Hydra", Ranking: 1}, {Bot: "Mole", Ranking: 3}, {Bot: "Lynx", Ranking: 6}, {Bot: "Kite", Ranking: 0}},
+	}
+	stub.statsbook("get_stat_summary.php", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, bySeason[r.URL.Query().Get("season")])
+	})
+
+	output, err := getNHRLOpponentQualityTrendTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_opponent_quality_trend: %v", err)
+	}
+	result := decodeResult(t, output)
+	trend := result["trend"].([]interface{})
+	if result["season_count"] != 2.0 || len(trend) != 2 {
+		t.Fatalf("trend = %v, want the 2023 and 2024 seasons", trend)
+	}
+	first, second := trend[0].(map[string]interface{}), trend[1].(map[string]interface{})
+	if first["season"] != "2023" || first["fights"] != 2.0 || first["wins"] != 2.0 || first["ranked_opponents"] != 2.0 || first["avg_opponent_rank"] != 5.0 {
+		t.Errorf("2023 = %v, want 2 wins over 2 ranked opponents averaging rank 5", first)
+	}
+	if first["change"] != nil {
+		t.Errorf("2023 change = %v, want none for the first season", first["change"])
+	}
+	// Kite is unranked and left out of the 2024 average
+	if second["season"] != "2024" || second["fights"] != 3.0 || second["wins"] != 2.0 || second["ranked_opponents"] != 2.0 || second["avg_opponent_rank"] != 2.0 || second["change"] != -3.0 {
+		t.Errorf("2024 = %v, want 2 wins, 2 ranked opponents averaging rank 2, change -3", second)
+	}
+	if direction, _ := result["direction"].(string); !strings.HasPrefix(direction, "climbing") {
+		t.Errorf("direction = %q, want climbing", direction)
+	}
+}
+
+func TestFirstTimeWinnersRecentBreakoutFirst(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.json(statsbookHost+"/statsbook/get_event_winners.php", []NHRLEventWinner{
+		{EventDate: "2025-07-12", FirstPlaceName: "Bolt", SecondPlaceName: "Zeus"},
+		{EventDate: "2025-06-14", FirstPlaceName: "Zeus", SecondPlaceName: "Lynx"},
+		{EventDate: "2025-05-10", FirstPlaceName: "Lynx", SecondPlaceName: "Bolt"},
+		{EventDate: "2025-04-12", FirstPlaceName: "Zeus", SecondPlaceName: "Lynx"},
+		{EventDate: "2025-03-08", FirstPlaceName: "Lynx", SecondPlaceName: "Zeus"},
+	})
+
+	output, err := getNHRLFirstTimeWinnersTool(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("get_first_time_winners: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["event_count"] != 5.0 || result["distinct_champions"] != 3.0 {
+		t.Errorf("event_count = %v, distinct_champions = %v; want 5 and 3", result["event_count"], result["distinct_champions"])
+	}
+	winners := result["first_time_winners"].([]interface{})
+	want := []struct {
+		bot, date   string
+		eventsSince float64
+		titles      float64
+	}{
+		{"Bolt", "2025-07-12", 0, 1},
+		{"Zeus", "2025-04-12", 3, 2},
+		{"Lynx", "2025-03-08", 4, 2},
+	}
+	if len(winners) != len(want) {
+		t.Fatalf("first_time_winners = %v, want %d bots", winners, len(want))
+	}
+	for i, w := range want {
+		winner := winners[i].(map[string]interface{})
+		if winner["bot"] != w.bot || winner["first_title_date"] != w.date || winner["events_since"] != w.eventsSince || winner["titles_to_date"] != w.titles {
+			t.Errorf("first_time_winners[%d] = %v, want %s first winning on %s, %v events ago, %v titles", i, winner, w.bot, w.date, w.eventsSince, w.titles)
+		}
+	}
+	if runnerUp := winners[0].(map[string]interface{})["runner_up"]; runnerUp != "Zeus" {
+		t.Errorf("Bolt's runner_up = %v, want Zeus", runnerUp)
+	}
+
+	output, err = getNHRLFirstTimeWinnersTool(map[string]interface{}{"limit": 1.0})
+	if err != nil {
+		t.Fatalf("get_first_time_winners: %v", err)
+	}
+	if winners := decodeResult(t, output)["first_time_winners"].([]interface{}); len(winners) != 1 || winners[0].(map[string]interface{})["bot"] != "Bolt" {
+		t.Errorf("limit 1 = %v, want only Bolt", winners)
+	}
+}
+
+func TestIntroScriptNamesBotAndDriver(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.liveStats(map[string]NHRLLiveFightStats{
+		"Lynx": {
+			BotName:             "Lynx",
+			BotPronunciation:    strPtr("links"),
+			BotType:             "Vertical Spinner",
+			DriverName:          "Sam Ortiz",
+			DriverPronunciation: "or-TEEZ",
+			City:                "Norwalk",
+			StateProvince:       "CT",
+			TeamName:            strPtr("Team Pounce"),
+			Fights:              12,
+			W:                   9,
+			L:                   3,
+			WKO:                 6,
+			Ranking:             4,
+			InterestingFact:     "Lynx was machined from a single billet of titanium.",
+		},
+		"Zeus": {BotName: "Zeus", InterestingFact2: strPtr("Zeus weighs exactly 2.99 pounds.")},
+	})
+	// A lone bot's profile is looked up alongside an opponent from its head-to-head
+	stub.statsbookByBot("get_head_to_head.php", map[string]interface{}{
+		"Lynx": []NHRLHeadToHead{{OpponentUniqueName: "Zeus", NumFights: 1}},
+		"Zeus": []NHRLHeadToHead{{OpponentUniqueName: "Lynx", NumFights: 1}},
+	})
+
+	output, err := getNHRLIntroScriptTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_intro_script: %v", err)
+	}
+	result := decodeResult(t, output)
+	script, _ := result["script"].(string)
+	if result["bot_name"] != "Lynx" || result["driver"] != "Sam Ortiz" {
+		t.Errorf("bot_name = %v, driver = %v; want Lynx and Sam Ortiz", result["bot_name"], result["driver"])
+	}
+	for _, want := range []string{
+		"Coming into the cage, it's Lynx! (say: links)",
+		"This vertical spinner is driven by Sam Ortiz, out of Norwalk, CT.",
+		"(Driver name, say: or-TEEZ)",
+		"Representing Team Pounce.",
+		"They come in with a 9-3 record, 6 of those wins by knockout, ranked number 4.",
+		"Lynx was machined from a single billet of titanium.",
+		"Give it up for Lynx!",
+	} {
+		if !strings.Contains(script, want) {
+			t.Errorf("script = %q, want it to contain %q", script, want)
+		}
+	}
+
+	// Missing driver, hometown, and record are left out rather than templated blank
+	output, err = getNHRLIntroScriptTool(map[string]interface{}{"bot_name": "Zeus"})
+	if err != nil {
+		t.Fatalf("get_intro_script: %v", err)
+	}
+	want := "Coming into the cage, it's Zeus! This is their NHRL debut! Zeus weighs exactly 2.99 pounds. Give it up for Zeus!"
+	if script := decodeResult(t, output)["script"]; script != want {
+		t.Errorf("sparse script = %q, want %q", script, want)
+	}
+}
+
+func TestCareerLengthStatsDifferingCareers(t *testing.T) {
+	stub := newUpstreamStub(t)
+	// Hydra has never fought; Kite's fights are undated
+	stub.statSummaryByClass(map[string][]NHRLStatSummary{"1": {
+		{Bot: "Bolt", Fights: 2, Events: 1},
+		{Bot: "Zeus", Fights: 8, Events: 3},
+		{Bot: "Lynx", Fights: 30, Events: 12},
+		{Bot: "Hydra", Fights: 0, Events: 0},
+		{Bot: "Mole", Fights: 14, Events: 6},
+		{Bot: "Kite", Fights: 1, Events: 1},
+	}})
+	stub.statsbookByBot("get_fights.php", map[string]interface{}{
+		"Lynx": []NHRLFight{{Date: "2025-03-01"}, {Date: "2019-03-01"}, {Date: "2022-08-13"}},
+		"Zeus": []NHRLFight{{Date: "2023-01-10"}, {Date: "2024-07-10"}},
+		"Mole": []NHRLFight{{Date: "2021-05-01"}, {Date: "2024-05-01"}},
+		"Bolt": []NHRLFight{{Date: "2025-06-14"}, {Date: "2025-06-15"}},
+		"Kite": []NHRLFight{{Date: ""}},
+	})
+
+	output, err := getNHRLCareerLengthStatsTool(map[string]interface{}{"weight_class": "3lb"})
+	if err != nil {
+		t.Fatalf("get_career_length_stats: %v", err)
+	}
+	result := decodeResult(t, output)
+	// Careers of 2192, 1096, 547, and 1 days
+	if result["bots_in_class"] != 5.0 || result["bots_measured"] != 4.0 {
+		t.Errorf("bots_in_class = %v, bots_measured = %v; want 5 and 4", result["bots_in_class"], result["bots_measured"])
+	}
+	if result["mean_career_days"] != 959.0 || result["median_career_days"] != 821.5 || result["mean_career_years"] != 2.63 {
+		t.Errorf("mean = %v days (%v years), median = %v days; want 959 (2.63) and 821.5",
+			result["mean_career_days"], result["mean_career_years"], result["median_career_days"])
+	}
+	if unavailable, _ := result["history_unavailable"].([]interface{}); len(unavailable) != 1 || unavailable[0] != "Kite" {
+		t.Errorf("history_unavailable = %v, want [Kite]", result["history_unavailable"])
+	}
+
+	var histogram []string
+	for _, b := range result["histogram"].([]interface{}) {
+		bucket := b.(map[string]interface{})
+		histogram = append(histogram, bucket["range"].(string)+"="+strconv.Itoa(int(bucket["bot_count"].(float64))))
+	}
+	if got := strings.Join(histogram, ","); got != "under 1 year=1,1-2 years=1,2-3 years=0,3-5 years=1,5+ years=1" {
+		t.Errorf("histogram = %s", got)
+	}
+
+	longest := result["longest_careers"].([]interface{})[0].(map[string]interface{})
+	if longest["bot"] != "Lynx" || longest["first_appearance"] != "2019-03-01" || longest["last_appearance"] != "2025-03-01" || longest["career_days"] != 2192.0 {
+		t.Errorf("longest career = %v, want Lynx from 2019-03-01 to 2025-03-01", longest)
+	}
+}
+
+func TestListOpponentsDistinctAndSorted(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.statsbookByBot("get_head_to_head.php", map[string]interface{}{"Lynx": []NHRLHeadToHead{
+		{OpponentUniqueName: "Zeus", NumFights: 2},
+		{OpponentUniqueName: "bolt", NumFights: 1},
+		{OpponentUniqueName: "Mole", NumFights: 4},
+		{OpponentUniqueName: "", NumFights: 1},
+		{OpponentUniqueName: "zeus", NumFights: 1},
+		{OpponentUniqueName: "Kite", NumFights: 1},
+	}})
+
+	output, err := getNHRLListOpponentsTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("list_opponents: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["opponent_count"] != 4.0 || result["total_meetings"] != 9.0 {
+		t.Errorf("opponent_count = %v, total_meetings = %v; want 4 and 9", result["opponent_count"], result["total_meetings"])
+	}
+	// The two Zeus entries differ only in case and merge under the first spelling
+	var opponents []string
+	for _, o := range result["opponents"].([]interface{}) {
+		opponent := o.(map[string]interface{})
+		opponents = append(opponents, opponent["name"].(string)+"="+strconv.Itoa(int(opponent["meetings"].(float64))))
+	}
+	if got := strings.Join(opponents, ","); got != "bolt=1,Kite=1,Mole=4,Zeus=3" {
+		t.Errorf("opponents = %s, want bolt=1,Kite=1,Mole=4,Zeus=3", got)
+	}
+}
+
+func TestComebackRunsQ1LossToSemifinals(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {
+		bzMatch("m1", "Q1", "Lynx", "Zeus", 2),
+		bzMatch("m2", "Q1", "Mole", "Bolt", 2),
+		bzMatch("m3", "Q1", "Kite", "Hydra", 2),
+		bzMatch("m4", "Q1", "Nova", "Wasp", 2),
+		bzMatch("m5", "Q1", "Pike", "Orca", 0),
+		bzMatch("m6", "Q2W", "Zeus", "Bolt", 1),
+		bzMatch("m7", "Q2L", "Lynx", "Mole", 1),
+		bzMatch("m8", "Q2L", "Kite", "Nova", 1),
+		bzMatch("m9", "Q3", "Bolt", "Lynx", 2),
+		bzMatch("m10", "Q3", "Kite", "Wasp", 1),
+		bzMatch("m11", "W1", "Lynx", "Kite", 1),
+		bzMatch("m12", "W2", "Zeus", "Lynx", 2),
+		bzMatch("m13", "W3", "Lynx", "Hydra", 2),
+	}})
+
+	output, err := getBrettZoneComebackRunsTool(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_comeback_runs: %v", err)
+	}
+	result := decodeResult(t, output)
+	// Mole and Nova lost Q1 and Q2L, so only Lynx and Kite made the bracket
+	if result["q1LossCount"] != 4.0 || result["comebackCount"] != 2.0 || result["deepRunCount"] != 1.0 {
+		t.Fatalf("q1LossCount = %v, comebackCount = %v, deepRunCount = %v; want 4, 2, 1",
+			result["q1LossCount"], result["comebackCount"], result["deepRunCount"])
+	}
+
+	comebacks := result["comebacks"].([]interface{})
+	lynx := comebacks[0].(map[string]interface{})
+	var path []string
+	for _, step := range lynx["path"].([]interface{}) {
+		path = append(path, step.(string))
+	}
+	if got := strings.Join(path, ","); got != "Q1 L,Q2L W,Q3 W,W1 W,W2 W,W3 L" {
+		t.Errorf("Lynx path = %s, want Q1 L,Q2L W,Q3 W,W1 W,W2 W,W3 L", got)
+	}
+	if lynx["botName"] != "Lynx" || lynx["bracketWins"] != 2.0 || lynx["bracketLosses"] != 1.0 || lynx["furthestRound"] != "W3" || lynx["deepRun"] != true || lynx["reachedFinals"] != false {
+		t.Errorf("Lynx = %v, want a deep run to the W3 semifinal with 2 bracket wins", lynx)
+	}
+	kite := comebacks[1].(map[string]interface{})
+	if kite["botName"] != "Kite" || kite["bracketWins"] != 0.0 || kite["furthestRound"] != "W1" || kite["deepRun"] != false {
+		t.Errorf("Kite = %v, want a W1 exit that is not a deep run", kite)
+	}
+}
+
+func TestSharedEventsTwoEventsMeetingInOne(t *testing.T) {
+	stub := newUpstreamStub(t)
+	meeting := atEvent(bzMatch("g1", "Q1", "Lynx", "Zeus", 1), "t1", "NHRL March 2025 3lb")
+	history := stub.fightHistories()
+	history.fight("Lynx", "2025-03-08", meeting)
+	history.fight("Lynx", "2025-03-08", atEvent(bzMatch("g2", "Q2W", "Lynx", "Mole", 2), "t1", "NHRL March 2025 3lb"))
+	history.fight("Lynx", "2025-06-14", atEvent(bzMatch("g3", "Q1", "Lynx", "Bolt", 1), "t2", "NHRL June 2025 3lb"))
+	history.fight("Lynx", "2025-08-09", atEvent(bzMatch("g5", "Q1", "Lynx", "Kite", 1), "t3", "NHRL August 2025 3lb"))
+	history.fight("Zeus", "2025-03-08", meeting)
+	history.fight("Zeus", "2025-04-12", atEvent(bzMatch("g6", "Q1", "Zeus", "Nova", 1), "t4", "NHRL April 2025 3lb"))
+	history.fight("Zeus", "2025-06-14", atEvent(bzMatch("g4", "Q1", "Zeus", "Hydra", 1), "t2", "NHRL June 2025 3lb"))
+	stub.statsbookByBot("get_head_to_head.php", map[string]interface{}{"Lynx": []NHRLHeadToHead{
+		{OpponentUniqueName: "Zeus", NumFights: 1, Wins: 1, LastMeeting: "2025-03-08"},
+	}})
+
+	output, err := getNHRLSharedEventsTool(map[string]interface{}{"bot1": "Lynx", "bot2": "Zeus"})
+	if err != nil {
+		t.Fatalf("get_shared_events: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["shared_event_count"] != 2.0 || result["events_met_in"] != 1.0 {
+		t.Fatalf("shared_event_count = %v, events_met_in = %v; want 2 and 1", result["shared_event_count"], result["events_met_in"])
+	}
+
+	// Most recent first
+	events := result["shared_events"].([]interface{})
+	june, march := events[0].(map[string]interface{}), events[1].(map[string]interface{})
+	if june["tournament_id"] != "t2" || june["event_name"] != "NHRL June 2025 3lb" || june["event_date"] != "2025-06-14" || june["fought_each_other"] != false {
+		t.Errorf("shared_events[0] = %v, want the June event where they did not meet", june)
+	}
+	if march["tournament_id"] != "t1" || march["event_date"] != "2025-03-08" || march["fought_each_other"] != true {
+		t.Errorf("shared_events[1] = %v, want the March event where they met", march)
+	}
+
+	headToHead := result["head_to_head"].(map[string]interface{})
+	if headToHead["meetings"] != 1.0 || headToHead["bot1_wins"] != 1.0 || headToHead["bot2_wins"] != 0.0 {
+		t.Errorf("head_to_head = %v, want Lynx 1-0 over one meeting", headToHead)
+	}
+}
+
+func TestAttritionPerStageAcrossTwoEvents(t *testing.T) {
+	stub := newUpstreamStub(t)
+	heavy := bzMatch("x1", "Q1", "Anvil", "Brick", 1)
+	heavy.WeightClass = "12"
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{
+		// A finished event of 8: D and H go out in Redemption, A wins the grand final
+		"e1": {
+			bzMatch("m1", "Q1", "A", "B", 1),
+			bzMatch("m2", "Q1", "C", "D", 1),
+			bzMatch("m3", "Q1", "E", "F", 1),
+			bzMatch("m4", "Q1", "G", "H", 1),
+			bzMatch("m5", "Q2W", "A", "C", 1),
+			bzMatch("m6", "Q2W", "E", "G", 1),
+			bzMatch("m7", "Q2L", "B", "D", 1),
+			bzMatch("m8", "Q2L", "F", "H", 1),
+			bzMatch("m9", "Q3", "C", "B", 1),
+			bzMatch("m10", "Q3", "G", "F", 1),
+			bzMatch("m11", "W1", "A", "C", 1),
+			bzMatch("m12", "W1", "E", "G", 1),
+			bzMatch("m13", "GF", "A", "E", 1),
+		},
+		// An event of 4 still in its first bracket round, plus a 12lb match
+		"e2": {
+			bzMatch("n1", "Q1", "P", "Q", 1),
+			bzMatch("n2", "Q1", "R", "S", 1),
+			bzMatch("n3", "Q2L", "Q", "S", 1),
+			bzMatch("n4", "W1", "P", "R", 0),
+			heavy,
+		},
+	})
+
+	output, err := getBrettZoneAttritionTool(map[string]interface{}{"tournament_ids": "e1, e2", "weight_class": "3lb"})
+	if err != nil {
+		t.Fatalf("get_attrition: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["eventCount"] != 2.0 || result["weightClass"] != "3lb" {
+		t.Errorf("eventCount = %v, weightClass = %v; want 2 and 3lb", result["eventCount"], result["weightClass"])
+	}
+
+	funnelOf := func(counts map[string]interface{}) string {
+		var stages []string
+		for _, stage := range attritionStages {
+			stages = append(stages, strconv.Itoa(int(counts[stage].(float64))))
+		}
+		return strings.Join(stages, ",")
+	}
+	events := result["events"].([]interface{})
+	for i, want := range []string{"8,6,4,2,1", "4,3,2,0,0"} {
+		event := events[i].(map[string]interface{})
+		if got := funnelOf(event["funnel"].(map[string]interface{})); got != want {
+			t.Errorf("%s funnel = %s, want %s", event["tournamentID"], got, want)
+		}
+	}
+
+	want := []struct {
+		stage     string
+		remaining float64
+		percent   float64
+	}{
+		{"entered", 6, 100},
+		{"survived_redemption", 4.5, 75},
+		{"made_bracket", 3, 50},
+		{"reached_finals", 1, 16.7},
+		{"champion", 0.5, 8.3},
+	}
+	for i, w := range want {
+		stage := result["averageFunnel"].([]interface{})[i].(map[string]interface{})
+		if stage["stage"] != w.stage || stage["averageRemaining"] != w.remaining || stage["percentOfField"] != w.percent {
+			t.Errorf("averageFunnel[%d] = %v, want %s averaging %v (%v%% of the field)", i, stage, w.stage, w.remaining, w.percent)
+		}
+	}
+}
+
+func TestAttritionChampionAfterBracketReset(t *testing.T) {
+	stub := newUpstreamStub(t)
+	// B wins the grand final from the losers side; the reset is fought at r1
+	// and still to come at r2
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{
+		"r1": {
+			bzMatch("m1", "WF", "A", "B", 1),
+			bzMatch("m2", "GF", "A", "B", 2),
+			bzMatch("m3", "GFR", "A", "B", 1),
+		},
+		"r2": {
+			bzMatch("n1", "WF", "C", "D", 1),
+			bzMatch("n2", "GFR", "C", "D", 0),
+			bzMatch("n3", "GF", "C", "D", 2),
+		},
+	})
+
+	output, err := getBrettZoneAttritionTool(map[string]interface{}{"tournament_ids": "r1, r2"})
+	if err != nil {
+		t.Fatalf("get_attrition: %v", err)
+	}
+	events := decodeResult(t, output)["events"].([]interface{})
+	for i, want := range []float64{1, 0} {
+		event := events[i].(map[string]interface{})
+		if got := event["funnel"].(map[string]interface{})["champion"]; got != want {
+			t.Errorf("%s champion = %v, want %v", event["tournamentID"], got, want)
+		}
+	}
+}
+
+func TestSignatureFinishFastKOs(t *testing.T) {
+	stub := newUpstreamStub(t)
+	// The TKO has no recorded length, and the KO loss is not one of Lynx's finishes
+	history := stub.fightHistories()
+	history.fight("Lynx", "2025-06-14", endedBy(bzMatch("g1", "Q1", "Lynx", "Zeus", 1), "KO", "45"))
+	history.fight("Lynx", "2025-06-14", endedBy(bzMatch("g2", "Q2W", "Lynx", "Bolt", 1), "KO", "30"))
+	history.fight("Lynx", "2025-06-14", endedBy(bzMatch("g3", "W1", "Lynx", "Mole", 1), "KO (TKO)", ""))
+	history.fight("Lynx", "2025-06-14", endedBy(bzMatch("g4", "W2", "Lynx", "Kite", 1), "JD", "180"))
+	history.fight("Lynx", "2025-06-14", endedBy(bzMatch("g5", "W3", "Lynx", "Hydra", 1), "KO", "52"))
+	history.fight("Lynx", "2025-06-14", endedBy(bzMatch("g6", "WF", "Nova", "Lynx", 1), "KO", "12"))
+
+	output, err := getNHRLSignatureFinishTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_signature_finish: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["fight_count"] != 6.0 || result["win_count"] != 5.0 {
+		t.Errorf("fight_count = %v, win_count = %v; want 6 and 5", result["fight_count"], result["win_count"])
+	}
+	if result["signature_method"] != "ko" || result["signature_share"] != 80.0 || result["most_common_result_by"] != "KO" {
+		t.Errorf("signature = %v at %v%%, most common result_by %v; want ko at 80%% and KO",
+			result["signature_method"], result["signature_share"], result["most_common_result_by"])
+	}
+	if result["median_ko_secs"] != 45.0 || result["timed_ko_wins"] != 3.0 {
+		t.Errorf("median_ko_secs = %v over %v timed KOs, want 45 over 3", result["median_ko_secs"], result["timed_ko_wins"])
+	}
+	if want := "Lynx usually KOs opponents around 45 seconds (4 of 5 wins by KO)"; result["summary"] != want {
+		t.Errorf("summary = %q, want %q", result["summary"], want)
+	}
+}
+
+func TestIronBotMostFightsTopsList(t *testing.T) {
+	stub := newUpstreamStub(t)
+	var season string
+	stub.statsbook("get_stat_summary.php", func(w http.ResponseWriter, r *http.Request) {
+		season = r.URL.Query().Get("season")
+		writeJSON(w, []NHRLStatSummary{
+			{Bot: "Lynx", Fights: 11, Events: 3, W: 8, L: 3, Ranking: 2},
+			{Bot: "Zeus", Fights: 14, Events: 4, W: 9, L: 5, Ranking: 5},
+			{Bot: "Bolt", Fights: 11, Events: 4, W: 6, L: 5, Ranking: 9},
+			{Bot: "Mole", Fights: 0, Events: 0},
+			{Bot: "Kite", Fights: 3, Events: 1, W: 1, L: 2},
+		})
+	})
+
+	output, err := getNHRLIronBotTool(map[string]interface{}{"season": "2024", "limit": 3.0})
+	if err != nil {
+		t.Fatalf("get_iron_bot: %v", err)
+	}
+	if season != "2024" {
+		t.Errorf("season query = %q, want 2024", season)
+	}
+	result := decodeResult(t, output)
+	if result["iron_bot"] != "Zeus" || result["most_fights"] != 14.0 || result["tied_iron_bots"] != nil {
+		t.Errorf("iron_bot = %v with %v fights (tied %v), want Zeus alone with 14", result["iron_bot"], result["most_fights"], result["tied_iron_bots"])
+	}
+	// Bolt and Lynx tie on fights; Bolt fought at more events
+	var order []string
+	for _, b := range result["top_bots"].([]interface{}) {
+		order = append(order, b.(map[string]interface{})["bot"].(string))
+	}
+	if got := strings.Join(order, ","); got != "Zeus,Bolt,Lynx" {
+		t.Errorf("top_bots = %s, want Zeus,Bolt,Lynx", got)
+	}
+}
+
+func TestIronBotTieReportedPastLimit(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.json(statsbookHost+"/statsbook/get_stat_summary.php", []NHRLStatSummary{
+		{Bot: "Lynx", Fights: 14, Events: 3},
+		{Bot: "Zeus", Fights: 14, Events: 4},
+		{Bot: "Bolt", Fights: 9, Events: 4},
+	})
+
+	output, err := getNHRLIronBotTool(map[string]interface{}{"limit": 1.0})
+	if err != nil {
+		t.Fatalf("get_iron_bot: %v", err)
+	}
+	result := decodeResult(t, output)
+	if top := result["top_bots"].([]interface{}); len(top) != 1 {
+		t.Errorf("top_bots = %v, want only the limit of 1", top)
+	}
+	tied, _ := result["tied_iron_bots"].([]interface{})
+	if result["iron_bot"] != "Zeus" || len(tied) != 2 || tied[0] != "Zeus" || tied[1] != "Lynx" {
+		t.Errorf("iron_bot = %v, tied_iron_bots = %v; want Zeus tied with Lynx", result["iron_bot"], result["tied_iron_bots"])
+	}
+}
+
+func TestBotCareerVsSeasonPairedDeltas(t *testing.T) {
+	stub := newUpstreamStub(t)
+	bySeason := map[string]NHRLBotStatsBySeason{
+		"All-time": {Bot: "Lynx", Events: 12, Fights: 40, W: 30, L: 10, KOs: 18, KOd: 4, AvgFightTimeSecs: "95.5"},
+		"Active":   {Bot: "Lynx", Events: 3, Fights: 10, W: 9, L: 1, KOs: 3, KOd: 1, AvgFightTimeSecs: "80.25"},
+	}
+	stub.statsbook("get_stats_by_season.php", func(w http.ResponseWriter, r *http.Request) {
+		stats, ok := bySeason[r.URL.Query().Get("season")]
+		if !ok {
+			writeJSON(w, nil)
+			return
+		}
+		writeJSON(w, stats)
+	})
+
+	output, err := getNHRLBotCareerVsSeasonTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_bot_career_vs_season: %v", err)
+	}
+	result := decodeResult(t, output)
+	career, season := result["all_time"].(map[string]interface{}), result["season_stats"].(map[string]interface{})
+	if career["fights"] != 40.0 || career["win_pct"] != 75.0 || career["ko_pct_of_wins"] != 60.0 || career["kod_pct_of_loss"] != 40.0 || career["avg_fight_secs"] != 95.5 {
+		t.Errorf("all_time = %v, want 40 fights at 75%% wins, 60%% KO wins, 40%% KO losses, 95.5s average", career)
+	}
+	if season["fights"] != 10.0 || season["win_pct"] != 90.0 || season["ko_pct_of_wins"] != 33.3 || season["kod_pct_of_loss"] != 100.0 || season["avg_fight_secs"] != 80.3 {
+		t.Errorf("season_stats = %v, want 10 fights at 90%% wins, 33.3%% KO wins, 100%% KO losses, 80.3s average", season)
+	}
+
+	deltas := result["deltas"].(map[string]interface{})
+	if deltas["win_pct"] != 15.0 || deltas["ko_pct_of_wins"] != -26.7 || deltas["kod_pct_of_loss"] != 60.0 || deltas["avg_fight_secs"] != -15.2 {
+		t.Errorf("deltas = %v, want win_pct 15, ko_pct_of_wins -26.7, kod_pct_of_loss 60, avg_fight_secs -15.2", deltas)
+	}
+	if result["season_share_of_career_fights"] != 25.0 {
+		t.Errorf("season_share_of_career_fights = %v, want 25", result["season_share_of_career_fights"])
+	}
+	if want := "Lynx is 9-1 in the Active season (90.0%), ahead of its 75.0% career win rate"; result["summary"] != want {
+		t.Errorf("summary = %q, want %q", result["summary"], want)
+	}
+
+	// A season with no fights leaves the career side unpaired
+	output, err = getNHRLBotCareerVsSeasonTool(map[string]interface{}{"bot_name": "Lynx", "season": "2019"})
+	if err != nil {
+		t.Fatalf("get_bot_career_vs_season 2019: %v", err)
+	}
+	result = decodeResult(t, output)
+	if result["season_stats"] != nil || result["deltas"] != nil || result["message"] != "No 2019 season fights found for this bot" {
+		t.Errorf("empty season = %v, want no season stats or deltas and a message", result)
+	}
+}
+
+func TestRecordVsTopNInsideAndOutside(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.statSummaryByClass(map[string][]NHRLStatSummary{"1": {
+		{Bot: "Hydra", Ranking: 1},
+		{Bot: "Zeus", Ranking: 4},
+		{Bot: "Mole", Ranking: 10},
+		{Bot: "Bolt", Ranking: 11},
+		{Bot: "Kite", Ranking: 25},
+		{Bot: "Nova", Ranking: 0},
+	}})
+	stub.statsbookByBot("get_head_to_head.php", map[string]interface{}{"Lynx": []NHRLHeadToHead{
+		{OpponentUniqueName: "Zeus", NumFights: 3, Wins: 2, Losses: 1, KOs: 1, LastMeeting: "2025-06-14"},
+		{OpponentUniqueName: "Bolt", NumFights: 3, Wins: 3},
+		{OpponentUniqueName: "Mole", NumFights: 1, Wins: 1},
+		{OpponentUniqueName: "Hydra", NumFights: 2, Losses: 2, KOd: 2},
+		{OpponentUniqueName: "Kite", NumFights: 1, Wins: 1},
+		{OpponentUniqueName: "Nova", NumFights: 1, Losses: 1},
+	}})
+
+	output, err := getNHRLRecordVsTopNTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_record_vs_top_n: %v", err)
+	}
+	result := decodeResult(t, output)
+	// Bolt and Kite rank outside the top 10, and Nova is unranked
+	var opponents []string
+	for _, o := range result["opponents"].([]interface{}) {
+		opponents = append(opponents, o.(map[string]interface{})["opponent"].(string))
+	}
+	if got := strings.Join(opponents, ","); got != "Hydra,Zeus,Mole" || result["top_n"] != 10.0 {
+		t.Errorf("top %v opponents = %s, want Hydra,Zeus,Mole", result["top_n"], got)
+	}
+	record := result["record"].(map[string]interface{})
+	if record["wins"] != 3.0 || record["losses"] != 3.0 || record["kos"] != 1.0 || record["kod"] != 2.0 || record["win_pct"] != 50.0 {
+		t.Errorf("record = %v, want 3-3 (50%%) with 1 KO and 2 KOd", record)
+	}
+	if want := "Lynx is 3-3 all-time against bots currently ranked in the 3lb top 10"; result["summary"] != want {
+		t.Errorf("summary = %q, want %q", result["summary"], want)
+	}
+
+	output, err = getNHRLRecordVsTopNTool(map[string]interface{}{"bot_name": "Lynx", "top_n": 5.0})
+	if err != nil {
+		t.Fatalf("get_record_vs_top_n top 5: %v", err)
+	}
+	result = decodeResult(t, output)
+	if record := result["record"].(map[string]interface{}); result["opponents_faced"] != 2.0 || record["wins"] != 2.0 || record["losses"] != 3.0 || record["win_pct"] != 40.0 {
+		t.Errorf("top 5 = %v over %v opponents, want 2-3 (40%%) against Hydra and Zeus", record, result["opponents_faced"])
+	}
+
+	if _, err := getNHRLRecordVsTopNTool(map[string]interface{}{"bot_name": "Lynx", "top_n": 0.0}); err == nil {
+		t.Error("top_n 0 succeeded, want an error")
+	}
+}
+
+func TestWeeklyDigestAggregatesTwoEvents(t *testing.T) {
+	stub := newUpstreamStub(t)
+	fought := func(tournamentID, weightClass, id, round, winner, loser, method, length string) BrettZoneMatch {
+		match := bzMatch(id, round, winner, loser, 1)
+		match.TournamentID, match.WeightClass = tournamentID, weightClass
+		match.WinAnnotation, match.MatchLength = method, length
+		return match
+	}
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{
+		"e1": {
+			fought("e1", "3", "a1", "Q1", "Lynx", "Zeus", "KO", "40"),
+			fought("e1", "3", "a2", "Q1", "Bolt", "Mole", "KO", "90"),
+			fought("e1", "3", "a3", "W1", "Lynx", "Bolt", "JD", "180"),
+			fought("e1", "3", "a4", "GF", "Lynx", "Bolt", "KO", "25"),
+		},
+		"e2": {
+			fought("e2", "12", "b1", "Q1", "Hydra", "Kite", "KO", "60"),
+			fought("e2", "12", "b2", "GF", "Hydra", "Nova", "JD", "180"),
+		},
+	})
+	stub.statSummaryByClass(map[string][]NHRLStatSummary{
+		"1": {{Bot: "Mole", Ranking: 2}, {Bot: "Lynx", Ranking: 3}, {Bot: "Bolt", Ranking: 20}},
+		"2": {{Bot: "Kite", Ranking: 4}, {Bot: "Hydra", Ranking: 9}},
+	})
+
+	// e3 has no matches yet and is left out
+	output, err := getBrettZoneWeeklyDigestTool(map[string]interface{}{"tournament_ids": []interface{}{"e1", "e2", "e3"}})
+	if err != nil {
+		t.Fatalf("get_weekly_digest: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["eventCount"] != 2.0 || result["failedTournaments"] != nil {
+		t.Errorf("eventCount = %v, failedTournaments = %v; want 2 and none", result["eventCount"], result["failedTournaments"])
+	}
+
+	champions := result["champions"].([]interface{})
+	if len(champions) != 2 {
+		t.Fatalf("champions = %v, want one per event", champions)
+	}
+	for i, want := range [][3]string{{"e1", "Lynx", "Bolt"}, {"e2", "Hydra", "Nova"}} {
+		champion := champions[i].(map[string]interface{})
+		if champion["tournamentID"] != want[0] || champion["champion"] != want[1] || champion["runnerUp"] != want[2] {
+			t.Errorf("champions[%d] = %v, want %s beating %s at %s", i, champion, want[1], want[2], want[0])
+		}
+	}
+
+	// Biggest rank gap first: Bolt (20) over Mole (2), then Hydra (9) over Kite (4)
+	upsets := result["notableUpsets"].([]interface{})
+	if len(upsets) != 2 {
+		t.Fatalf("notableUpsets = %v, want one per event", upsets)
+	}
+	if upset := upsets[0].(map[string]interface{}); upset["tournamentID"] != "e1" || upset["winner"] != "Bolt" || upset["rankGap"] != 18.0 {
+		t.Errorf("notableUpsets[0] = %v, want Bolt's 18-place upset at e1", upset)
+	}
+	if upset := upsets[1].(map[string]interface{}); upset["tournamentID"] != "e2" || upset["winner"] != "Hydra" || upset["rankGap"] != 5.0 {
+		t.Errorf("notableUpsets[1] = %v, want Hydra's 5-place upset at e2", upset)
+	}
+
+	// Judges' decisions are not KOs, however short
+	knockouts := result["fastestKOs"].([]interface{})
+	if len(knockouts) != 2 {
+		t.Fatalf("fastestKOs = %v, want one per event", knockouts)
+	}
+	if ko := knockouts[0].(map[string]interface{}); ko["matchID"] != "a4" || ko["matchLengthSecs"] != 25.0 {
+		t.Errorf("fastestKOs[0] = %v, want the 25s grand final at e1", ko)
+	}
+	if ko := knockouts[1].(map[string]interface{}); ko["matchID"] != "b1" || ko["matchLengthSecs"] != 60.0 {
+		t.Errorf("fastestKOs[1] = %v, want the 60s Q1 KO at e2", ko)
+	}
+
+	var hot []string
+	for _, b := range result["hotBots"].([]interface{}) {
+		bot := b.(map[string]interface{})
+		hot = append(hot, bot["bot"].(string)+"="+strconv.Itoa(int(bot["wins"].(float64))))
+	}
+	if got := strings.Join(hot, ","); got != "Lynx=3,Hydra=2" {
+		t.Errorf("hotBots = %s, want Lynx=3,Hydra=2", got)
+	}
+}
+
+func TestEventVideosURLForEveryMatch(t *testing.T) {
+	stub := newUpstreamStub(t)
+	inCage := func(match BrettZoneMatch, cage string) BrettZoneMatch {
+		match.Cage = cage
+		return match
+	}
+	canned := []BrettZoneMatch{
+		inCage(bzMatch("m4", "W1", "Lynx", "Bolt", 0), "Cage 3"),
+		bzMatch("m2", "Q1", "Bolt", "Mole", 2),
+		inCage(bzMatch("m1", "Q1", "Lynx", "Zeus", 1), "Cage 2"),
+		bzMatch("m3", "Q2W", "Lynx", "Mole", 1),
+	}
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": canned})
+
+	output, err := getBrettZoneEventVideosTool(map[string]interface{}{"tournament_id": "t1", "time_seconds": 5.0})
+	if err != nil {
+		t.Fatalf("get_event_videos: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["matchCount"] != 4.0 || result["decidedCount"] != 3.0 {
+		t.Errorf("matchCount = %v, decidedCount = %v; want 4 and 3", result["matchCount"], result["decidedCount"])
+	}
+
+	// Rounds in bracket order, matches in ID order, each with its review URL
+	urls := make(map[string]string)
+	var order []string
+	for _, r := range result["rounds"].([]interface{}) {
+		round := r.(map[string]interface{})
+		for _, m := range round["matches"].([]interface{}) {
+			match := m.(map[string]interface{})
+			order = append(order, round["round"].(string)+":"+match["matchID"].(string))
+			urls[match["matchID"].(string)], _ = match["reviewURL"].(string)
+		}
+	}
+	if got := strings.Join(order, ","); got != "Q1:m1,Q1:m2,Q2W:m3,W1:m4" {
+		t.Errorf("rounds = %s, want Q1:m1,Q1:m2,Q2W:m3,W1:m4", got)
+	}
+	for _, match := range canned {
+		want := generateBrettZoneReviewURL(match.ID, "t1", extractCageNumber(match.Cage), 5)
+		if urls[match.ID] != want {
+			t.Errorf("%s reviewURL = %q, want %q", match.ID, urls[match.ID], want)
+		}
+	}
+	if !strings.Contains(urls["m4"], "gameID=m4&tournamentID=t1#cams=cam-Cage-3-Overhead-High&t=5.00") {
+		t.Errorf("m4 reviewURL = %q, want the Cage 3 camera at 5 seconds", urls["m4"])
+	}
+}
+
+func TestEventTypeFilterMonthlyAndChampionship(t *testing.T) {
+	stub := newUpstreamStub(t)
+	june := func(match BrettZoneMatch) BrettZoneMatch { return atEvent(match, "m1", "NHRL June 2025 3lb") }
+	worlds := func(match BrettZoneMatch) BrettZoneMatch {
+		return atEvent(match, "c1", "NHRL 2025 World Championship 3lb")
+	}
+	history := stub.fightHistories()
+	history.fight("Lynx", "2025-06-14", endedBy(june(bzMatch("a1", "Q1", "Lynx", "Zeus", 1)), "KO", ""))
+	history.fight("Lynx", "2025-06-14", endedBy(june(bzMatch("a2", "Q2W", "Lynx", "Bolt", 1)), "JD, 3-0", ""))
+	history.fight("Lynx", "2025-11-08", endedBy(worlds(bzMatch("b1", "Q1", "Lynx", "Mole", 1)), "KO", ""))
+	history.fight("Lynx", "2025-11-09", endedBy(worlds(bzMatch("b2", "GF", "Lynx", "Kite", 1)), "JD, 2-1", ""))
+	history.unlinked("Lynx", NHRLFight{Date: "2023-01-01", Round: "Q1", ResultBy: "KO"})
+
+	opponents := func(eventType string) string {
+		t.Helper()
+		output, err := getNHRLBotFightsTool(map[string]interface{}{"bot_name": "Lynx", "event_type": eventType})
+		if err != nil {
+			t.Fatalf("get_bot_fights %s: %v", eventType, err)
+		}
+		result := decodeResult(t, output)
+		if result["event_type"] != eventType || result["unresolved_fights"] != 1.0 {
+			t.Errorf("event_type = %v, unresolved_fights = %v; want %s and the unlinked fight", result["event_type"], result["unresolved_fights"], eventType)
+		}
+		var got []string
+		for _, f := range result["fights"].([]interface{}) {
+			got = append(got, f.(map[string]interface{})["opponent_name"].(string))
+		}
+		return strings.Join(got, ",")
+	}
+	// The unlinked fight has no event and belongs to neither type
+	if got := opponents("monthly"); got != "Zeus,Bolt" {
+		t.Errorf("monthly opponents = %s, want the June fights", got)
+	}
+	if got := opponents("championship"); got != "Mole,Kite" {
+		t.Errorf("championship opponents = %s, want the World Championship fights", got)
+	}
+
+	output, err := getNHRLBotEventParticipantsTool(map[string]interface{}{"bot_name": "Lynx", "event_type": "championship"})
+	if err != nil {
+		t.Fatalf("get_bot_event_participants: %v", err)
+	}
+	result := decodeResult(t, output)
+	events := result["events"].([]interface{})
+	if len(events) != 1 || events[0].(map[string]interface{})["tournament_id"] != "c1" || result["unresolved_fights"] != 1.0 {
+		t.Errorf("championship events = %v (unresolved %v), want only the World Championship", events, result["unresolved_fights"])
+	}
+
+	// Without a filter the fight list is the plain statsbook history
+	const matchesPath = brettZoneHost + "/brettZone/backend/getLatestMatches.php"
+	before := stub.count(matchesPath)
+	output, err = getNHRLBotFightsTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_bot_fights: %v", err)
+	}
+	if fights := decodeResult(t, output)["fight_count"]; fights != 5.0 || stub.count(matchesPath) != before {
+		t.Errorf("unfiltered fight_count = %v after %d BrettZone requests, want all 5 fights and none", fights, stub.count(matchesPath)-before)
+	}
+
+	if _, err := getNHRLBotFightsTool(map[string]interface{}{"bot_name": "Lynx", "event_type": "regional"}); err == nil {
+		t.Error("event_type regional succeeded, want an error")
+	}
+}
+
+func TestFightsToWinDifferentBracketSizes(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.json(statsbookHost+"/statsbook/get_event_winners.php", []NHRLEventWinner{
+		{EventDate: "2025-06-14", FirstPlaceName: "Lynx"},
+		{EventDate: "2025-03-08", FirstPlaceName: "Hydra"},
+	})
+	history := stub.fightHistories()
+	march := func(match BrettZoneMatch) BrettZoneMatch { return atEvent(match, "t3", "NHRL March 2025 3lb") }
+	// A 16-bot event: two qualifier wins and two bracket wins
+	history.fight("Lynx", "2025-06-14", bzMatch("a1", "Q1", "Lynx", "Zeus", 1))
+	history.fight("Lynx", "2025-06-14", bzMatch("a2", "Q2W", "Lynx", "Bolt", 1))
+	history.fight("Lynx", "2025-06-15", bzMatch("a3", "W1", "Lynx", "Mole", 1))
+	history.fight("Lynx", "2025-06-15", bzMatch("a4", "GF", "Lynx", "Kite", 1))
+	// An event two days later is a different tournament and not part of this title
+	history.fight("Lynx", "2025-06-16", atEvent(bzMatch("c1", "Q1", "Lynx", "Nova", 1), "t2", "NHRL June 2025 Invitational"))
+	history.fight("Lynx", "2025-06-16", atEvent(bzMatch("c2", "GF", "Wasp", "Lynx", 1), "t2", "NHRL June 2025 Invitational"))
+	// A larger event takes six wins, with a loss on the way
+	history.fight("Hydra", "2025-03-08", march(bzMatch("b1", "Q1", "Hydra", "Nova", 1)))
+	history.fight("Hydra", "2025-03-08", march(bzMatch("b2", "Q2W", "Hydra", "Wasp", 1)))
+	history.fight("Hydra", "2025-03-09", march(bzMatch("b3", "W1", "Hydra", "Zeus", 1)))
+	history.fight("Hydra", "2025-03-09", march(bzMatch("b4", "W2", "Bolt", "Hydra", 1)))
+	history.fight("Hydra", "2025-03-09", march(bzMatch("b5", "L3", "Hydra", "Mole", 1)))
+	history.fight("Hydra", "2025-03-09", march(bzMatch("b6", "L4", "Hydra", "Kite", 1)))
+	history.fight("Hydra", "2025-03-09", march(bzMatch("b7", "GF", "Hydra", "Bolt", 1)))
+	// A win at another event is not counted toward this title
+	history.fight("Hydra", "2025-01-11", atEvent(bzMatch("b8", "Q1", "Hydra", "Lynx", 1), "t4", "NHRL January 2025 3lb"))
+
+	output, err := getNHRLFightsToWinTool(map[string]interface{}{"weight_class": "3lb"})
+	if err != nil {
+		t.Fatalf("get_fights_to_win: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["event_count"] != 2.0 || result["mean_wins"] != 5.0 || result["median_wins"] != 5.0 ||
+		result["min_wins"] != 4.0 || result["max_wins"] != 6.0 {
+		t.Errorf("result = %v, want 4 and 6 wins averaging 5", result)
+	}
+
+	events := result["events"].([]interface{})
+	want := []struct {
+		champion, tournament     string
+		qualifier, bracket, lost float64
+	}{{"Lynx", "t1", 2, 2, 0}, {"Hydra", "t3", 2, 4, 1}}
+	for i, w := range want {
+		event := events[i].(map[string]interface{})
+		if event["champion"] != w.champion || event["tournament_id"] != w.tournament || event["qualifier_wins"] != w.qualifier ||
+			event["bracket_wins"] != w.bracket || event["losses"] != w.lost {
+			t.Errorf("event %d = %v, want %s at %s with %v qualifier and %v bracket wins, %v losses",
+				i, event, w.champion, w.tournament, w.qualifier, w.bracket, w.lost)
+		}
+	}
+}
+
+func TestFunFactsReferenceTheData(t *testing.T) {
+	stub := newUpstreamStub(t)
+	history := stub.fightHistories()
+	history.fight("Lynx", "2024-05-11", endedBy(bzMatch("g2", "Q1", "Lynx", "Bolt", 1), "KO", "38"))
+	history.fight("Lynx", "2023-03-11", endedBy(bzMatch("g1", "Q1", "Lynx", "Zeus", 1), "KO", "75"))
+	history.fight("Lynx", "2024-08-10", endedBy(bzMatch("g3", "Q1", "Lynx", "Zeus", 2), "KO", "20"))
+	history.fight("Lynx", "2025-01-11", endedBy(bzMatch("g4", "Q1", "Lynx", "Zeus", 1), "JD, 3-0", "180"))
+	// Kite's only fight has no BrettZone link, so its opponent is unknown
+	history.unlinked("Kite", NHRLFight{Date: "2024-01-13", Round: "Q1", ResultBy: "KO"})
+	stub.statsbookByBot("get_streak_stats.php", map[string]interface{}{"Lynx": NHRLStreakStats{LongestWinStreak: 4}, "Kite": nil})
+	stub.statsbookByBot("get_head_to_head.php", map[string]interface{}{"Lynx": []NHRLHeadToHead{
+		{OpponentUniqueName: "Bolt", NumFights: 1, Wins: 1},
+		{OpponentUniqueName: "Zeus", NumFights: 3, Wins: 2, Losses: 1},
+	}})
+
+	facts := func(args map[string]interface{}) []string {
+		t.Helper()
+		output, err := getNHRLFunFactsTool(args)
+		if err != nil {
+			t.Fatalf("get_fun_facts: %v", err)
+		}
+		result := decodeResult(t, output)
+		if _, ok := result["unavailable_sources"]; ok {
+			t.Errorf("unavailable_sources = %v, want every source answered", result["unavailable_sources"])
+		}
+		var got []string
+		for _, f := range result["facts"].([]interface{}) {
+			got = append(got, f.(map[string]interface{})["fact"].(string))
+		}
+		return got
+	}
+
+	want := []string{
+		"Lynx made its NHRL debut on March 11, 2023 against Zeus and has fought 3 times since.",
+		"Lynx's fastest KO came in just 38 seconds, against Bolt on 2024-05-11.",
+		"Lynx's longest winning streak is 4 fights in a row.",
+		"Lynx has met Zeus 3 times, more than any other opponent, going 2-1.",
+	}
+	if got := facts(map[string]interface{}{"bot_name": "Lynx"}); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("bot facts =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if got := facts(map[string]interface{}{"bot_name": "Kite"}); strings.Join(got, "\n") != "Kite made its NHRL debut on January 13, 2024 and has fought 0 times since." {
+		t.Errorf("facts for an unresolved debut = %q", got)
+	}
+
+	stub.statsbook("get_fastest_kos.php", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []NHRLFastestKO{{BotName: "Hydra", OpponentName: "Kite", FightLengthSecs: 4, Date: "2024-02-10"}})
+	})
+	stub.statsbook("get_longest_winning_streak.php", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []NHRLWinningStreak{{BotName: "Lynx", StreakLength: 11}})
+	})
+	stub.json(statsbookHost+"/statsbook/get_event_winners.php", []NHRLEventWinner{
+		{EventDate: "2025-06-14", FirstPlaceName: "Lynx"},
+		{EventDate: "2025-03-08", FirstPlaceName: "Hydra"},
+		{EventDate: "2024-11-09", FirstPlaceName: "Lynx"},
+	})
+	stub.statSummaryByClass(map[string][]NHRLStatSummary{
+		"1": {{Bot: "Lynx", Fights: 40, Events: 12}, {Bot: "Hydra", Fights: 52, Events: 15}},
+	})
+
+	want = []string{
+		"The fastest 3lb KO on record took 4 seconds: Hydra over Kite on 2024-02-10.",
+		"The longest 3lb winning streak belongs to Lynx, at 11 fights in a row.",
+		"Lynx has won 2 of the 3 3lb events on record, more than any other bot.",
+		"Hydra has fought more 3lb matches than any other bot: 52 across 15 events.",
+	}
+	if got := facts(map[string]interface{}{"weight_class": "3lb"}); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("class facts =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestVsClassAverageTopAndBottomBot(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.statSummaryByClass(map[string][]NHRLStatSummary{"1": {
+		{Bot: "Lynx", Fights: 10, W: 9, L: 1, KOs: 8},
+		{Bot: "Zeus", Fights: 10, W: 6, L: 4, KOs: 3},
+		{Bot: "Bolt", Fights: 10, W: 5, L: 5, KOs: 5},
+		{Bot: "Mole", Fights: 4, W: 2, L: 2, KOs: 1},
+		{Bot: "Kite", Fights: 8, W: 1, L: 7},
+		// Bots without fights stay out of the averages
+		{Bot: "Idle"},
+	}})
+
+	compare := func(botName string) map[string]interface{} {
+		t.Helper()
+		output, err := getNHRLVsClassAverageTool(map[string]interface{}{"bot_name": botName, "weight_class": "3lb"})
+		if err != nil {
+			t.Fatalf("get_vs_class_average %s: %v", botName, err)
+		}
+		result := decodeResult(t, output)
+		if result["class_size"] != 5.0 {
+			t.Errorf("class_size = %v, want 5", result["class_size"])
+		}
+		return result
+	}
+	check := func(result map[string]interface{}, metric string, bot, average, difference, percentile float64) {
+		t.Helper()
+		got := result["comparison"].(map[string]interface{})[metric].(map[string]interface{})
+		if got["bot"] != bot || got["class_average"] != average || got["difference"] != difference || got["percentile"] != percentile {
+			t.Errorf("%s %s = %v, want bot %v, average %v, difference %v, percentile %v", result["bot_name"], metric, got, bot, average, difference, percentile)
+		}
+	}
+
+	top := compare("Lynx")
+	check(top, "win_pct", 90, 52.5, 37.5, 100)
+	check(top, "ko_rate", 80, 37, 43, 100)
+	check(top, "fights", 10, 8.4, 1.6, 50)
+	if top["summary"] != "Lynx's 90.0% win rate is better than 100% of 3lb bots (class average 52.5%)" {
+		t.Errorf("summary = %q", top["summary"])
+	}
+
+	bottom := compare("Kite")
+	check(bottom, "win_pct", 12.5, 52.5, -40, 0)
+	check(bottom, "ko_rate", 0, 37, -37, 0)
+	check(bottom, "fights", 8, 8.4, -0.4, 25)
+
+	if _, err := getNHRLVsClassAverageTool(map[string]interface{}{"bot_name": "Idle", "weight_class": "3lb"}); err == nil {
+		t.Error("get_vs_class_average for a bot without fights succeeded, want an error")
+	}
+}
+
+func TestBodyCountTwoEliminationsInOneEvent(t *testing.T) {
+	stub := newUpstreamStub(t)
+	march := func(match BrettZoneMatch) BrettZoneMatch { return atEvent(match, "s1", "NHRL March 2025 3lb") }
+	june := func(match BrettZoneMatch) BrettZoneMatch { return atEvent(match, "d1", "NHRL June 2025 3lb") }
+	history := stub.fightHistories()
+	// A single elimination event: the redemption and bubble wins end qualifier
+	// runs, and the bracket win ends Kite's event
+	history.fight("Lynx", "2025-03-08", march(bzMatch("a1", "Q1", "Lynx", "Zeus", 2)))
+	history.fight("Lynx", "2025-03-08", march(bzMatch("a2", "Q2L", "Lynx", "Mole", 1)))
+	history.fight("Lynx", "2025-03-08", march(bzMatch("a3", "Q3", "Lynx", "Bolt", 1)))
+	history.fight("Lynx", "2025-03-09", march(bzMatch("a4", "W1", "Lynx", "Kite", 1)))
+	history.fight("Lynx", "2025-03-09", march(bzMatch("a5", "W2", "Lynx", "Hydra", 2)))
+	// A double elimination event: qualifier and winners bracket wins send the
+	// opponent on, and only the two losers bracket wins eliminate
+	history.fight("Lynx", "2025-06-14", june(bzMatch("b1", "Q1", "Lynx", "Zeus", 1)))
+	history.fight("Lynx", "2025-06-14", june(bzMatch("b2", "Q2W", "Lynx", "Bolt", 1)))
+	history.fight("Lynx", "2025-06-15", june(bzMatch("b3", "W1", "Lynx", "Kite", 1)))
+	history.fight("Lynx", "2025-06-15", june(bzMatch("b4", "W2", "Lynx", "Hydra", 2)))
+	history.fight("Lynx", "2025-06-15", june(bzMatch("b5", "L3", "Lynx", "Nova", 1)))
+	history.fight("Lynx", "2025-06-15", june(bzMatch("b6", "L4", "Lynx", "Wasp", 1)))
+	history.fight("Lynx", "2025-06-15", june(bzMatch("b7", "LF", "Lynx", "Kite", 2)))
+
+	output, err := getNHRLBodyCountTool(map[string]interface{}{"bot_name": "Lynx"})
+	if err != nil {
+		t.Fatalf("get_body_count: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["body_count"] != 5.0 || result["bracket_eliminations"] != 3.0 || result["qualifier_eliminations"] != 2.0 || result["distinct_victims"] != 5.0 {
+		t.Errorf("result = %v, want 3 bracket and 2 qualifier eliminations of 5 bots", result)
+	}
+	if _, ok := result["warning"]; ok {
+		t.Errorf("warning = %v, want both event formats known", result["warning"])
+	}
+
+	var got []string
+	for _, e := range result["eliminations"].([]interface{}) {
+		elimination := e.(map[string]interface{})
+		got = append(got, elimination["event_name"].(string)+" "+elimination["round"].(string)+" "+elimination["opponent"].(string)+" "+elimination["type"].(string))
+	}
+	want := []string{
+		"NHRL March 2025 3lb Q2L Mole qualifier",
+		"NHRL March 2025 3lb Q3 Bolt qualifier",
+		"NHRL March 2025 3lb W1 Kite bracket",
+		"NHRL June 2025 3lb L3 Nova bracket",
+		"NHRL June 2025 3lb L4 Wasp bracket",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("eliminations =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestRivalryTimelineRunningSeriesScore(t *testing.T) {
+	stub := newUpstreamStub(t)
+	// Listed newest first, with the grand final ahead of the earlier W2 meeting
+	history := stub.fightHistories()
+	history.fight("Lynx", "2025-06-15", endedBy(bzMatch("g3", "GF", "Lynx", "Zeus", 1), "JD, 2-1", ""))
+	history.fight("Lynx", "2025-06-15", endedBy(bzMatch("g2", "W2", "Lynx", "Zeus", 2), "KO", ""))
+	history.fight("Lynx", "2025-06-14", endedBy(bzMatch("g9", "Q1", "Lynx", "Bolt", 1), "KO", ""))
+	history.fight("Lynx", "2024-03-09", endedBy(bzMatch("g1", "Q1", "Lynx", "Zeus", 1), "KO", ""))
+
+	output, err := getNHRLRivalryTimelineTool(map[string]interface{}{"bot1": "Lynx", "bot2": "Zeus"})
+	if err != nil {
+		t.Fatalf("get_rivalry_timeline: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["meeting_count"] != 3.0 || result["bot1_wins"] != 2.0 || result["bot2_wins"] != 1.0 || result["series_score"] != "Lynx 2-1 Zeus" {
+		t.Errorf("result = %v, want Lynx leading 2-1 over 3 meetings", result)
+	}
+
+	want := []struct{ date, round, winner, resultBy, series string }{
+		{"2024-03-09", "Q1", "Lynx", "KO", "Lynx 1-0 Zeus"},
+		{"2025-06-15", "W2", "Zeus", "KO", "Lynx 1-1 Zeus"},
+		{"2025-06-15", "GF", "Lynx", "JD, 2-1", "Lynx 2-1 Zeus"},
+	}
+	meetings := result["meetings"].([]interface{})
+	for i, w := range want {
+		meeting := meetings[i].(map[string]interface{})
+		if meeting["date"] != w.date || meeting["round"] != w.round || meeting["winner"] != w.winner ||
+			meeting["result_by"] != w.resultBy || meeting["series_score"] != w.series {
+			t.Errorf("meeting %d = %v, want %+v", i, meeting, w)
+		}
+		if link, _ := meeting["video_link"].(string); link == "" {
+			t.Errorf("meeting %d has no video link", i)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// notesFile is the path of the local note store (set from --notes-file)
+var notesFile string
+
+// notesMutex serializes access to the note store file
+var notesMutex sync.Mutex
+
+// BotNote is a private commentator/builder note attached to a bot
+type BotNote struct {
+	ID        string    `json:"id"`
+	BotName   string    `json:"bot_name"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// defaultNotesFile returns the note store path used when --notes-file is not set
+func defaultNotesFile() string {
+	if configDir, err := os.UserConfigDir(); err == nil {
+		return filepath.Join(configDir, ServerName, "notes.json")
+	}
+	return "nhrl-notes.json"
+}
+
+// notesKey normalizes a bot name for use as a note store key
+func notesKey(botName string) string {
+	return strings.ToLower(normalizeBotName(strings.TrimSpace(botName)))
+}
+
+// loadNotes reads the note store; a missing file is an empty store
+func loadNotes() (map[string][]BotNote, error) {
+	notes := make(map[string][]BotNote)
+
+	data, err := os.ReadFile(notesFile)
+	if os.IsNotExist(err) {
+		return notes, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notes file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &notes); err != nil {
+		return nil, fmt.Errorf("failed to parse notes file: %w", err)
+	}
+
+	return notes, nil
+}
+
+// saveNotes writes the note store atomically
+func saveNotes(notes map[string][]BotNote) error {
+	jsonData, err := json.MarshalIndent(notes, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal notes: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(notesFile), 0755); err != nil {
+		return fmt.Errorf("failed to create notes directory: %w", err)
+	}
+
+	tmpFile := notesFile + ".tmp"
+	if err := os.WriteFile(tmpFile, jsonData, 0644); err != nil {
+		return fmt.Errorf("failed to write notes file: %w", err)
+	}
+	if err := os.Rename(tmpFile, notesFile); err != nil {
+		return fmt.Errorf("failed to write notes file: %w", err)
+	}
+
+	return nil
+}
+
+// handleNHRLNotesTool handles all local bot note operations
+func handleNHRLNotesTool(args map[string]interface{}) (string, error) {
+	operation, ok := args["operation"].(string)
+	if !ok {
+		return "", fmt.Errorf("operation parameter is required")
+	}
+
+	// Check if operation is allowed in current tools mode
+	if !isOperationAllowed("nhrl_notes", operation) {
+		return "", fmt.Errorf(getOperationNotAllowedError(operation))
+	}
+
+	switch operation {
+	case "add_note":
+		return addBotNote(args)
+	case "get_notes":
+		return getBotNotesTool(args)
+	case "delete_note":
+		return deleteBotNote(args)
+	default:
+		return "", fmt.Errorf("unknown operation: %s", operation)
+	}
+}
+
+// getNHRLNotesToolInfo returns the tool definition for local bot notes
+func getNHRLNotesToolInfo() ToolInfo {
+	return ToolInfo{
+		Name: "nhrl_notes",
+		Description: `Keep private notes about NHRL bots for commentary and scouting (e.g. "weapon belt slips after 30s").
+
+Notes are stored locally on the machine running this server, keyed by bot name. They are never sent to TrueFinals, the NHRL statsbook, or BrettZone.`,
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"operation": map[string]interface{}{
+					"type": "string",
+					"description": `The notes operation to perform:
+
+- add_note: Add a note to a bot (requires bot_name, note)
+- get_notes: Get notes for a bot, or all notes if bot_name is omitted
+- delete_note: Delete a note by ID (requires bot_name, note_id)`,
+					"enum": []string{"add_note", "get_notes", "delete_note"},
+				},
+				"bot_name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the bot the note belongs to. Case-insensitive; spaces and underscores are equivalent.",
+				},
+				"note": map[string]interface{}{
+					"type":        "string",
+					"description": "Note text (required for add_note)",
+				},
+				"note_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Note identifier as returned by add_note or get_notes (required for delete_note)",
+				},
+			},
+			"required": []string{"operation"},
+		},
+	}
+}
+
+// Add a note to a bot
+func addBotNote(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok || strings.TrimSpace(botName) == "" {
+		return "", fmt.Errorf("bot_name is required for add_note operation")
+	}
+
+	text, ok := args["note"].(string)
+	if !ok || strings.TrimSpace(text) == "" {
+		return "", fmt.Errorf("note is required for add_note operation")
+	}
+
+	notesMutex.Lock()
+	defer notesMutex.Unlock()
+
+	notes, err := loadNotes()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC()
+	note := BotNote{
+		ID:        fmt.Sprintf("%d", now.UnixNano()),
+		BotName:   strings.TrimSpace(botName),
+		Note:      strings.TrimSpace(text),
+		CreatedAt: now,
+	}
+
+	key := notesKey(botName)
+	notes[key] = append(notes[key], note)
+
+	if err := saveNotes(notes); err != nil {
+		return "", err
+	}
+
+	jsonData, err := json.MarshalIndent(note, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Get notes for a bot, or all notes
+func getBotNotesTool(args map[string]interface{}) (string, error) {
+	notesMutex.Lock()
+	defer notesMutex.Unlock()
+
+	notes, err := loadNotes()
+	if err != nil {
+		return "", err
+	}
+
+	result := map[string]interface{}{}
+
+	if botName, ok := args["bot_name"].(string); ok && strings.TrimSpace(botName) != "" {
+		botNotes := notes[notesKey(botName)]
+		if botNotes == nil {
+			botNotes = []BotNote{}
+		}
+		result["bot_name"] = botName
+		result["note_count"] = len(botNotes)
+		result["notes"] = botNotes
+	} else {
+		all := make([]BotNote, 0)
+		for _, botNotes := range notes {
+			all = append(all, botNotes...)
+		}
+		sort.Slice(all, func(i, j int) bool {
+			return all[i].CreatedAt.Before(all[j].CreatedAt)
+		})
+		result["bot_count"] = len(notes)
+		result["note_count"] = len(all)
+		result["notes"] = all
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Delete a note from a bot
+func deleteBotNote(args map[string]interface{}) (string, error) {
+	botName, ok := args["bot_name"].(string)
+	if !ok || strings.TrimSpace(botName) == "" {
+		return "", fmt.Errorf("bot_name is required for delete_note operation")
+	}
+
+	noteID, ok := args["note_id"].(string)
+	if !ok || noteID == "" {
+		return "", fmt.Errorf("note_id is required for delete_note operation")
+	}
+
+	notesMutex.Lock()
+	defer notesMutex.Unlock()
+
+	notes, err := loadNotes()
+	if err != nil {
+		return "", err
+	}
+
+	key := notesKey(botName)
+	botNotes := notes[key]
+	remaining := make([]BotNote, 0, len(botNotes))
+	for _, note := range botNotes {
+		if note.ID != noteID {
+			remaining = append(remaining, note)
+		}
+	}
+	if len(remaining) == len(botNotes) {
+		return "", fmt.Errorf("note %s not found for bot %s", noteID, botName)
+	}
+
+	if len(remaining) == 0 {
+		delete(notes, key)
+	} else {
+		notes[key] = remaining
+	}
+
+	if err := saveNotes(notes); err != nil {
+		return "", err
+	}
+
+	result := map[string]interface{}{
+		"bot_name":        botName,
+		"note_id":         noteID,
+		"deleted":         true,
+		"remaining_notes": len(remaining),
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
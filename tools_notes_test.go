@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// withNotesFile points the note store at a fresh file for one test
+func withNotesFile(t *testing.T) string {
+	t.Helper()
+	previous := notesFile
+	notesFile = filepath.Join(t.TempDir(), "notes", "notes.json")
+	t.Cleanup(func() { notesFile = previous })
+	return notesFile
+}
+
+func TestNotesRoundTrip(t *testing.T) {
+	withNotesFile(t)
+
+	output, err := addBotNote(map[string]interface{}{"bot_name": "Lynx Mk 2", "note": "  weapon belt slips after 30s "})
+	if err != nil {
+		t.Fatalf("add_note: %v", err)
+	}
+	added := decodeResult(t, output)
+	noteID, _ := added["id"].(string)
+	if noteID == "" || added["note"] != "weapon belt slips after 30s" {
+		t.Fatalf("added note = %v, want a trimmed note with an id", added)
+	}
+
+	// Lookups are case- and spacing-insensitive
+	output, err = getBotNotesTool(map[string]interface{}{"bot_name": "lynx_mk_2"})
+	if err != nil {
+		t.Fatalf("get_notes: %v", err)
+	}
+	notes := decodeResult(t, output)["notes"].([]interface{})
+	if len(notes) != 1 || notes[0].(map[string]interface{})["id"] != noteID {
+		t.Fatalf("notes = %v, want the added note", notes)
+	}
+
+	output, err = deleteBotNote(map[string]interface{}{"bot_name": "Lynx Mk 2", "note_id": noteID})
+	if err != nil {
+		t.Fatalf("delete_note: %v", err)
+	}
+	if remaining := decodeResult(t, output)["remaining_notes"]; remaining != 0.0 {
+		t.Errorf("remaining_notes = %v, want 0", remaining)
+	}
+	if _, err := deleteBotNote(map[string]interface{}{"bot_name": "Lynx Mk 2", "note_id": noteID}); err == nil {
+		t.Error("deleting a missing note succeeded")
+	}
+
+	output, err = getBotNotesTool(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("get_notes: %v", err)
+	}
+	if count := decodeResult(t, output)["note_count"]; count != 0.0 {
+		t.Errorf("note_count after delete = %v, want 0", count)
+	}
+}
+
+func TestNotesPersistAcrossRestarts(t *testing.T) {
+	path := withNotesFile(t)
+
+	if _, err := addBotNote(map[string]interface{}{"bot_name": "Zeus", "note": "new drum this event"}); err != nil {
+		t.Fatalf("add_note: %v", err)
+	}
+	if _, err := addBotNote(map[string]interface{}{"bot_name": "Bolt", "note": "driver's first event"}); err != nil {
+		t.Fatalf("add_note: %v", err)
+	}
+
+	// A restarted server has nothing but the file it is pointed at
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("notes file not written: %v", err)
+	}
+	var stored map[string][]BotNote
+	if err := json.Unmarshal(data, &stored); err != nil || len(stored["zeus"]) != 1 || len(stored["bolt"]) != 1 {
+		t.Fatalf("notes file = %s, want one note each for zeus and bolt", data)
+	}
+
+	output, err := getBotNotesTool(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("get_notes: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["bot_count"] != 2.0 || result["note_count"] != 2.0 {
+		t.Fatalf("after restart = %v, want 2 notes for 2 bots", result)
+	}
+	first := result["notes"].([]interface{})[0].(map[string]interface{})
+	if first["bot_name"] != "Zeus" || first["note"] != "new drum this event" {
+		t.Errorf("oldest note = %v, want Zeus's note", first)
+	}
+}
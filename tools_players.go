@@ -3,6 +3,9 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
+	"strings"
 )
 
 // handlePlayersTool handles all player operations
@@ -36,6 +39,14 @@ func handlePlayersTool(args map[string]interface{}) (string, error) {
 		return bulkUpdatePlayers(args)
 	case "checkin":
 		return checkinPlayer(args)
+	case "get_seed_rationale":
+		return getSeedRationale(args)
+	case "find_duplicate_players":
+		return findDuplicatePlayers(args)
+	case "get_field_rankings":
+		return getFieldRankings(args)
+	case "get_hot_bots":
+		return getHotBots(args)
 	case "disqualify":
 		return disqualifyPlayer(args)
 	default:
@@ -70,6 +81,10 @@ Note: Seeding is crucial for bracket fairness - higher seeds face lower seeds in
 QUERY OPERATIONS:
 - list: Get all participants in a tournament
 - get: Get detailed information about a specific participant
+- get_seed_rationale: Explain each participant's seed against the NHRL rank that would justify it (or mark it "manual")
+- find_duplicate_players: Flag pairs of participants with near-identical names (likely registration typos) with similarity scores (optional min_similarity, default 0.85)
+- get_field_rankings: List participants sorted by current NHRL rank, flagging unranked entrants
+- get_hot_bots: List participants entering the event on an active NHRL winning streak, longest streak first
 
 PARTICIPANT MANAGEMENT (require write access):
 - add: Register a new bot/team to the tournament
@@ -82,7 +97,8 @@ PARTICIPANT MANAGEMENT (require write access):
 - disqualify: Mark participant as disqualified
 - undisqualify: Remove disqualification status`,
 					"enum": []string{
-						"list", "get", "add", "update", "delete",
+						"list", "get", "get_seed_rationale", "find_duplicate_players", "get_field_rankings", "get_hot_bots",
+						"add", "update", "delete",
 						"set_seed", "swap", "check_in", "undo_check_in",
 						"disqualify", "undisqualify",
 					},
@@ -127,6 +143,10 @@ PARTICIPANT MANAGEMENT (require write access):
 					"type":        "boolean",
 					"description": "Whether the participant is disqualified. Disqualified bots cannot compete but remain in bracket.",
 				},
+				"min_similarity": map[string]interface{}{
+					"type":        "number",
+					"description": "Similarity threshold (0-1) for find_duplicate_players. Defaults to 0.85; names identical apart from case, spacing, or punctuation score 1.",
+				},
 				"profile_data": map[string]interface{}{
 					"type":        "object",
 					"description": "Additional participant data including contact info, bot specifications, sponsors, etc.",
@@ -180,6 +200,7 @@ func listPlayers(args map[string]interface{}) (string, error) {
 		"count":   len(enrichedPlayers),
 		"note":    "Player display names and profile details are included for better readability",
 	}
+	flagTestTournamentByID(result, tournamentID)
 
 	jsonData, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
@@ -454,6 +475,360 @@ func randomizeSeeding(args map[string]interface{}) (string, error) {
 	return string(jsonData), nil
 }
 
+// Explain each participant's seed relative to their NHRL rank. There is no
+// stored record of how seeds were assigned, so a seed is attributed to NHRL
+// rank when it matches the seed that ranking the participants by NHRL rank
+// would produce, and reported as manual otherwise.
+func getSeedRationale(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s/players", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to list players: %w", err)
+	}
+
+	players, err := decodeJSONArrayOf[Player](data)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse players response: %w", err)
+	}
+
+	type seededPlayer struct {
+		player Player
+		rank   int // 0 when unranked
+	}
+
+	var seeded []seededPlayer
+	for _, player := range players {
+		if player.IsBye {
+			continue
+		}
+		entry := seededPlayer{player: player}
+		if rank, err := getNHRLBotRank(player.Name); err == nil && rank != nil && rank.Ranking > 0 {
+			entry.rank = rank.Ranking
+		}
+		seeded = append(seeded, entry)
+	}
+
+	// Order the field the way a rank-based seeding would: ranked bots by rank,
+	// then unranked bots in their current seed order
+	seedOf := func(p Player) int {
+		if p.Seed == nil {
+			return int(^uint(0) >> 1)
+		}
+		return *p.Seed
+	}
+	byRank := make([]seededPlayer, len(seeded))
+	copy(byRank, seeded)
+	sort.SliceStable(byRank, func(i, j int) bool {
+		ri, rj := byRank[i].rank, byRank[j].rank
+		if (ri > 0) != (rj > 0) {
+			return ri > 0
+		}
+		if ri != rj {
+			return ri < rj
+		}
+		return seedOf(byRank[i].player) < seedOf(byRank[j].player)
+	})
+	rankSeed := make(map[string]int, len(byRank))
+	for i, entry := range byRank {
+		rankSeed[entry.player.ID] = i + 1
+	}
+
+	sort.SliceStable(seeded, func(i, j int) bool {
+		return seedOf(seeded[i].player) < seedOf(seeded[j].player)
+	})
+
+	rationale := make([]map[string]interface{}, len(seeded))
+	for i, entry := range seeded {
+		record := map[string]interface{}{
+			"player_id":       entry.player.ID,
+			"name":            entry.player.Name,
+			"seed":            entry.player.Seed,
+			"rank_based_seed": rankSeed[entry.player.ID],
+		}
+
+		if entry.rank > 0 {
+			record["nhrl_rank"] = entry.rank
+		} else {
+			record["nhrl_rank"] = nil
+		}
+
+		switch {
+		case entry.player.Seed == nil:
+			record["basis"] = "unseeded"
+			record["explanation"] = "No seed assigned"
+		case entry.rank > 0 && *entry.player.Seed == rankSeed[entry.player.ID]:
+			record["basis"] = "nhrl_rank"
+			record["explanation"] = fmt.Sprintf("Seed %d follows from NHRL rank #%d among this field", *entry.player.Seed, entry.rank)
+		default:
+			record["basis"] = "manual"
+			if entry.rank > 0 {
+				record["explanation"] = fmt.Sprintf("Seed %d differs from the rank-based seed %d (NHRL rank #%d)", *entry.player.Seed, rankSeed[entry.player.ID], entry.rank)
+			} else {
+				record["explanation"] = fmt.Sprintf("Seed %d set manually; bot has no current NHRL rank", *entry.player.Seed)
+			}
+		}
+
+		rationale[i] = record
+	}
+
+	result := map[string]interface{}{
+		"tournament_id": tournamentID,
+		"count":         len(rationale),
+		"participants":  rationale,
+		"note":          "Seeds matching the order of current NHRL (Active season) ranks are attributed to rank; all others are reported as manual",
+	}
+	flagTestTournamentByID(result, tournamentID)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// List the field with each participant's current NHRL rank, best first
+func getFieldRankings(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s/players", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to list players: %w", err)
+	}
+
+	players, err := decodeJSONArrayOf[Player](data)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse players response: %w", err)
+	}
+
+	type rankedPlayer struct {
+		player Player
+		rank   int // 0 when unranked
+	}
+
+	var field []rankedPlayer
+	var lookupErrors []string
+	for _, player := range players {
+		if player.IsBye {
+			continue
+		}
+		rank, err := getNHRLBotRankCached(player.Name)
+		if err != nil {
+			lookupErrors = append(lookupErrors, fmt.Sprintf("%s: %v", player.Name, err))
+		}
+		field = append(field, rankedPlayer{player: player, rank: rank})
+	}
+
+	// Ranked bots by rank, then unranked bots alphabetically
+	sort.SliceStable(field, func(i, j int) bool {
+		ri, rj := field[i].rank, field[j].rank
+		if (ri > 0) != (rj > 0) {
+			return ri > 0
+		}
+		if ri != rj {
+			return ri < rj
+		}
+		return strings.ToLower(field[i].player.Name) < strings.ToLower(field[j].player.Name)
+	})
+
+	rankedCount := 0
+	roster := make([]map[string]interface{}, len(field))
+	for i, entry := range field {
+		record := map[string]interface{}{
+			"player_id": entry.player.ID,
+			"name":      entry.player.Name,
+			"seed":      entry.player.Seed,
+			"nhrl_rank": nil,
+			"unranked":  entry.rank == 0,
+		}
+		if entry.rank > 0 {
+			record["nhrl_rank"] = entry.rank
+			rankedCount++
+		}
+		roster[i] = record
+	}
+
+	result := map[string]interface{}{
+		"tournament_id":  tournamentID,
+		"count":          len(roster),
+		"ranked_count":   rankedCount,
+		"unranked_count": len(roster) - rankedCount,
+		"participants":   roster,
+		"note":           "Ranks are current NHRL (Active season) rankings; unranked entrants are listed last",
+	}
+	if len(lookupErrors) > 0 {
+		result["lookup_errors"] = lookupErrors
+	}
+	flagTestTournamentByID(result, tournamentID)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// List participants entering the event on an active winning streak
+func getHotBots(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s/players", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to list players: %w", err)
+	}
+
+	players, err := decodeJSONArrayOf[Player](data)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse players response: %w", err)
+	}
+
+	var hot []map[string]interface{}
+	var lookupErrors []string
+	checked := 0
+	for _, player := range players {
+		if player.IsBye {
+			continue
+		}
+		checked++
+
+		streak, err := getNHRLStreakStats(player.Name)
+		if err != nil {
+			lookupErrors = append(lookupErrors, fmt.Sprintf("%s: %v", player.Name, err))
+			continue
+		}
+		if streak == nil || streak.CurrentStreak <= 0 || !strings.HasPrefix(strings.ToUpper(streak.CurrentStreakType), "W") {
+			continue
+		}
+
+		hot = append(hot, map[string]interface{}{
+			"player_id":          player.ID,
+			"name":               player.Name,
+			"seed":               player.Seed,
+			"current_win_streak": streak.CurrentStreak,
+			"longest_win_streak": streak.LongestWinStreak,
+			"career_best":        streak.CurrentStreak >= streak.LongestWinStreak,
+		})
+	}
+
+	// Longest active streak first
+	sort.SliceStable(hot, func(i, j int) bool {
+		si, sj := hot[i]["current_win_streak"].(int), hot[j]["current_win_streak"].(int)
+		if si != sj {
+			return si > sj
+		}
+		return strings.ToLower(hot[i]["name"].(string)) < strings.ToLower(hot[j]["name"].(string))
+	})
+
+	result := map[string]interface{}{
+		"tournament_id": tournamentID,
+		"checked_count": checked,
+		"hot_bot_count": len(hot),
+		"hot_bots":      hot,
+		"note":          "Streaks come from the NHRL statsbook and cover fights before this event; career_best marks bots matching their longest-ever win streak",
+	}
+	if len(lookupErrors) > 0 {
+		result["lookup_errors"] = lookupErrors
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Default similarity threshold for find_duplicate_players
+const defaultDuplicateSimilarity = 0.85
+
+// Find participants whose names are suspiciously similar
+func findDuplicatePlayers(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	threshold := defaultDuplicateSimilarity
+	if t, ok := args["min_similarity"].(float64); ok {
+		if t <= 0 || t > 1 {
+			return "", fmt.Errorf("min_similarity must be between 0 and 1")
+		}
+		threshold = t
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s/players", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to list players: %w", err)
+	}
+
+	players, err := decodeJSONArrayOf[Player](data)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse players response: %w", err)
+	}
+
+	var participants []Player
+	for _, player := range players {
+		if !player.IsBye {
+			participants = append(participants, player)
+		}
+	}
+
+	duplicates := make([]map[string]interface{}, 0)
+	for i := 0; i < len(participants); i++ {
+		for j := i + 1; j < len(participants); j++ {
+			a, b := participants[i], participants[j]
+			similarity := botNameSimilarity(a.Name, b.Name)
+			if similarity < threshold {
+				continue
+			}
+			duplicates = append(duplicates, map[string]interface{}{
+				"playerA":    map[string]interface{}{"id": a.ID, "name": a.Name, "seed": a.Seed},
+				"playerB":    map[string]interface{}{"id": b.ID, "name": b.Name, "seed": b.Seed},
+				"similarity": math.Round(similarity*1000) / 1000,
+			})
+		}
+	}
+
+	sort.SliceStable(duplicates, func(i, j int) bool {
+		return duplicates[i]["similarity"].(float64) > duplicates[j]["similarity"].(float64)
+	})
+
+	result := map[string]interface{}{
+		"tournament_id":       tournamentID,
+		"participantCount":    len(participants),
+		"minSimilarity":       threshold,
+		"duplicateCount":      len(duplicates),
+		"suspectedDuplicates": duplicates,
+		"note":                "Review each pair before merging or deleting - similar names can belong to different bots",
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
 // Bulk update players (complete list replacement)
 func bulkUpdatePlayers(args map[string]interface{}) (string, error) {
 	tournamentID, ok := args["tournament_id"].(string)
@@ -0,0 +1,199 @@
+package main
+
+import "testing"
+
+// playerFixture is a TrueFinals player; a zero seed leaves the player unseeded
+type playerFixture struct {
+	id, name string
+	seed     int
+}
+
+// seededPlayers builds a TrueFinals player list response
+func seededPlayers(entries ...playerFixture) []map[string]interface{} {
+	players := make([]map[string]interface{}, len(entries))
+	for i, e := range entries {
+		player := map[string]interface{}{"id": e.id, "name": e.name, "seed": nil}
+		if e.seed > 0 {
+			player["seed"] = e.seed
+		}
+		players[i] = player
+	}
+	return players
+}
+
+func TestSeedRationaleReflectsRanks(t *testing.T) {
+	stub := newUpstreamStub(t)
+	players := seededPlayers(
+		playerFixture{"p1", "Bolt", 1},
+		playerFixture{"p2", "Lynx", 2},
+		playerFixture{"p3", "Hydra", 3},
+		playerFixture{"p4", "Zeus", 4},
+	)
+	players = append(players, map[string]interface{}{"id": "bye1", "name": "BYE", "isBye": true})
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1/players", players)
+	stub.statsbookByBot("get_rank.php", map[string]interface{}{
+		"Bolt":  NHRLRanking{Ranking: 1},
+		"Lynx":  NHRLRanking{Ranking: 2},
+		"Zeus":  NHRLRanking{Ranking: 5},
+		"Hydra": nil,
+	})
+
+	output, err := getSeedRationale(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_seed_rationale: %v", err)
+	}
+	participants := decodeResult(t, output)["participants"].([]interface{})
+	if len(participants) != 4 {
+		t.Fatalf("participants = %v, want four (bye excluded)", participants)
+	}
+
+	want := []struct {
+		name          string
+		nhrlRank      interface{}
+		rankBasedSeed float64
+		basis         string
+	}{
+		{"Bolt", 1.0, 1, "nhrl_rank"},
+		{"Lynx", 2.0, 2, "nhrl_rank"},
+		{"Hydra", nil, 4, "manual"},
+		{"Zeus", 5.0, 3, "manual"},
+	}
+	for i, w := range want {
+		record := participants[i].(map[string]interface{})
+		if record["name"] != w.name || record["nhrl_rank"] != w.nhrlRank ||
+			record["rank_based_seed"] != w.rankBasedSeed || record["basis"] != w.basis {
+			t.Errorf("participant %d = %v, want %s rank %v rank-based seed %v basis %s",
+				i, record, w.name, w.nhrlRank, w.rankBasedSeed, w.basis)
+		}
+	}
+}
+
+func TestFindDuplicatePlayersOneNearDuplicatePair(t *testing.T) {
+	stub := newUpstreamStub(t)
+	players := seededPlayers(
+		playerFixture{"p1", "Ripperoni", 1},
+		playerFixture{"p2", "Lynx", 2},
+		playerFixture{"p3", "Bolt", 0},
+		playerFixture{"p4", "Riperoni", 0},
+		playerFixture{"p5", "Hydra", 3},
+		playerFixture{"p6", "Hydrant Mk II", 0},
+	)
+	players = append(players,
+		map[string]interface{}{"id": "bye1", "name": "BYE", "isBye": true},
+		map[string]interface{}{"id": "bye2", "name": "BYE", "isBye": true},
+	)
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1/players", players)
+
+	output, err := findDuplicatePlayers(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("find_duplicate_players: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["participantCount"] != 6.0 || result["duplicateCount"] != 1.0 {
+		t.Fatalf("participantCount = %v, duplicateCount = %v; want 6 and 1: %v", result["participantCount"], result["duplicateCount"], result["suspectedDuplicates"])
+	}
+	pair := result["suspectedDuplicates"].([]interface{})[0].(map[string]interface{})
+	a, b := pair["playerA"].(map[string]interface{}), pair["playerB"].(map[string]interface{})
+	if a["id"] != "p1" || b["id"] != "p4" || a["seed"] != 1.0 || b["seed"] != nil {
+		t.Errorf("pair = %v and %v, want seeded p1 Ripperoni and unseeded p4 Riperoni", a, b)
+	}
+	if similarity := pair["similarity"].(float64); similarity < defaultDuplicateSimilarity || similarity >= 1 {
+		t.Errorf("similarity = %v, want at least %v and below 1", similarity, defaultDuplicateSimilarity)
+	}
+
+	if _, err := findDuplicatePlayers(map[string]interface{}{"tournament_id": "t1", "min_similarity": 1.5}); err == nil {
+		t.Error("min_similarity 1.5 succeeded, want an error")
+	}
+}
+
+func TestFieldRankingsRankedAndUnranked(t *testing.T) {
+	stub := newUpstreamStub(t)
+	players := seededPlayers(
+		playerFixture{"p1", "Zeus", 1},
+		playerFixture{"p2", "Mole", 2},
+		playerFixture{"p3", "Lynx", 3},
+		playerFixture{"p4", "Kite", 0},
+		playerFixture{"p5", "Bolt", 0},
+	)
+	players = append(players, map[string]interface{}{"id": "bye1", "name": "BYE", "isBye": true})
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1/players", players)
+	stub.statsbookByBot("get_rank.php", map[string]interface{}{
+		"Zeus": NHRLRanking{Ranking: 9},
+		"Lynx": NHRLRanking{Ranking: 2},
+		"Bolt": NHRLRanking{Ranking: 14},
+		"Mole": nil,
+		"Kite": nil,
+	})
+
+	output, err := getFieldRankings(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_field_rankings: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["count"] != 5.0 || result["ranked_count"] != 3.0 || result["unranked_count"] != 2.0 {
+		t.Errorf("count = %v, ranked_count = %v, unranked_count = %v; want 5, 3, 2", result["count"], result["ranked_count"], result["unranked_count"])
+	}
+	if result["lookup_errors"] != nil {
+		t.Errorf("lookup_errors = %v, want none for unranked bots", result["lookup_errors"])
+	}
+
+	participants := result["participants"].([]interface{})
+	want := []struct {
+		name     string
+		rank     interface{}
+		unranked bool
+	}{
+		{"Lynx", 2.0, false},
+		{"Zeus", 9.0, false},
+		{"Bolt", 14.0, false},
+		{"Kite", nil, true},
+		{"Mole", nil, true},
+	}
+	if len(participants) != len(want) {
+		t.Fatalf("participants = %v, want %d (bye excluded)", participants, len(want))
+	}
+	for i, w := range want {
+		record := participants[i].(map[string]interface{})
+		if record["name"] != w.name || record["nhrl_rank"] != w.rank || record["unranked"] != w.unranked {
+			t.Errorf("participant %d = %v, want %s rank %v unranked %v", i, record, w.name, w.rank, w.unranked)
+		}
+	}
+}
+
+func TestHotBotsTwoActiveWinStreaks(t *testing.T) {
+	stub := newUpstreamStub(t)
+	players := seededPlayers(
+		playerFixture{"p1", "Lynx", 1},
+		playerFixture{"p2", "Zeus", 2},
+		playerFixture{"p3", "Bolt", 3},
+		playerFixture{"p4", "Mole", 0},
+		playerFixture{"p5", "Kite", 0},
+	)
+	players = append(players, map[string]interface{}{"id": "bye1", "name": "BYE", "isBye": true})
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1/players", players)
+	stub.statsbookByBot("get_streak_stats.php", map[string]interface{}{
+		"Lynx": NHRLStreakStats{CurrentStreak: 3, CurrentStreakType: "W", LongestWinStreak: 7},
+		"Zeus": NHRLStreakStats{CurrentStreak: 2, CurrentStreakType: "L", LongestWinStreak: 4},
+		"Bolt": NHRLStreakStats{CurrentStreak: 0, CurrentStreakType: "", LongestWinStreak: 1},
+		"Mole": NHRLStreakStats{CurrentStreak: 5, CurrentStreakType: "Win", LongestWinStreak: 5},
+		"Kite": nil,
+	})
+
+	output, err := getHotBots(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_hot_bots: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["checked_count"] != 5.0 || result["hot_bot_count"] != 2.0 || result["lookup_errors"] != nil {
+		t.Fatalf("result = %v, want 2 hot bots among 5 checked and no lookup errors", result)
+	}
+
+	hot := result["hot_bots"].([]interface{})
+	mole, lynx := hot[0].(map[string]interface{}), hot[1].(map[string]interface{})
+	if mole["name"] != "Mole" || mole["current_win_streak"] != 5.0 || mole["career_best"] != true || mole["seed"] != nil {
+		t.Errorf("hot_bots[0] = %v, want unseeded Mole on a career-best 5-win streak", mole)
+	}
+	if lynx["name"] != "Lynx" || lynx["current_win_streak"] != 3.0 || lynx["longest_win_streak"] != 7.0 || lynx["career_best"] != false || lynx["seed"] != 1.0 {
+		t.Errorf("hot_bots[1] = %v, want top seed Lynx on a 3-win streak short of its best of 7", lynx)
+	}
+}
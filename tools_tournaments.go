@@ -3,7 +3,14 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 // handleTournamentsTool handles all tournament operations
@@ -21,6 +28,8 @@ func handleTournamentsTool(args map[string]interface{}) (string, error) {
 	switch operation {
 	case "list":
 		return listTournaments(args)
+	case "list_upcoming_events":
+		return listUpcomingEvents(args)
 	case "get":
 		return getTournament(args)
 	case "details":
@@ -47,12 +56,24 @@ func handleTournamentsTool(args map[string]interface{}) (string, error) {
 		return updateTournamentWebhooks(args)
 	case "start":
 		return startTournament(args)
+	case "reconcile_event":
+		return reconcileEvent(args)
+	case "get_schedule_variance":
+		return getScheduleVariance(args)
+	case "preflight":
+		return preflightTournament(args)
 	case "reset":
 		return resetTournament(args)
+	case "reset_preview":
+		return resetPreview(args)
 	case "push_schedule":
 		return pushTournamentSchedule(args)
 	case "delete":
 		return deleteTournament(args)
+	case "list_tombstones":
+		return listTournamentTombstones(args)
+	case "restore_tombstone":
+		return restoreTournamentTombstone(args)
 	default:
 		return "", fmt.Errorf("unknown operation: %s", operation)
 	}
@@ -88,6 +109,7 @@ Note: Test tournaments (containing 'TEST' or 'test' in the name) are filtered ou
 
 QUERY OPERATIONS (read-only):
 - list: Get all tournaments you have access to (filters test tournaments by default)
+- list_upcoming_events: List tournaments scheduled to start between from and to (default: the next 30 days), soonest first
 - get: Get complete tournament data including bracket, games, and participants
 - details: Get lightweight tournament info without full bracket data
 - format: Get tournament format settings (single elim, double elim, round robin)
@@ -95,6 +117,8 @@ QUERY OPERATIONS (read-only):
 - description: Get tournament description text
 - private: Get private tournament data (webhooks, etc.)
 - webhooks: Get configured webhooks for tournament events
+- reconcile_event: Compare the event's TrueFinals games with its BrettZone matches (counts, players, winners) and list discrepancies (optional brettzone_tournament_id, defaults to tournament_id)
+- get_schedule_variance: Compare each game's scheduled time with its actual BrettZone start time and report per-match and average minutes late (optional brettzone_tournament_id)
 
 MODIFICATION OPERATIONS (require write access):
 - create: Create a new tournament with specified settings
@@ -104,14 +128,21 @@ MODIFICATION OPERATIONS (require write access):
 - update_webhooks: Update webhook configurations
 
 TOURNAMENT CONTROL:
+- preflight: Check a tournament is ready to start (seeds, participant count, locations) without starting it
 - start: Start the tournament (locks bracket and begins matches)
 - reset: Reset tournament bracket (bracket_only or all)
+- reset_preview: Report how many completed games, in-progress games, and recorded results a reset would wipe, and for reset_mode all the players, seeds, and check-ins it also removes, without resetting (read-only; uses reset_mode)
 - push_schedule: Delay all scheduled matches by specified minutes
-- delete: Delete the tournament completely`,
+- delete: Delete the tournament completely (a tombstone snapshot is saved first when --tombstone-dir is set)
+
+TOMBSTONES (require --tombstone-dir):
+- list_tombstones: List locally saved snapshots of deleted tournaments
+- restore_tombstone: Recreate a deleted tournament from a tombstone snapshot`,
 					"enum": []string{
-						"list", "get", "details", "format", "overlay_params", "description", "private", "webhooks",
+						"list", "list_upcoming_events", "get", "details", "format", "overlay_params", "description", "private", "webhooks",
+						"reconcile_event", "get_schedule_variance", "reset_preview",
 						"create", "update", "update_description", "update_overlay_params", "update_webhooks",
-						"start", "reset", "push_schedule", "delete",
+						"preflight", "start", "reset", "push_schedule", "delete", "list_tombstones", "restore_tombstone",
 					},
 				},
 				"tournament_id": map[string]interface{}{
@@ -190,16 +221,32 @@ TOURNAMENT CONTROL:
 				},
 				"reset_mode": map[string]interface{}{
 					"type":        "string",
-					"description": "Reset scope for tournament reset: 'bracket_only' (keep players, reset matches) or 'all' (full reset)",
+					"description": "Reset scope for reset and reset_preview: 'bracket_only' (keep players, reset matches) or 'all' (full reset)",
 					"enum":        []string{"bracket_only", "all"},
 				},
 				"delay_minutes": map[string]interface{}{
 					"type":        "integer",
 					"description": "Number of minutes to delay all scheduled matches. Used with push_schedule operation.",
 				},
+				"tombstone_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Tombstone identifier as returned by list_tombstones. Required for restore_tombstone.",
+				},
+				"brettzone_tournament_id": map[string]interface{}{
+					"type":        "string",
+					"description": "BrettZone tournament identifier to compare against for reconcile_event and get_schedule_variance. Defaults to tournament_id.",
+				},
+				"from": map[string]interface{}{
+					"type":        "string",
+					"description": "Start of the list_upcoming_events window: 'YYYY-MM-DD', RFC 3339, or Unix seconds. Defaults to now.",
+				},
+				"to": map[string]interface{}{
+					"type":        "string",
+					"description": "End of the list_upcoming_events window (inclusive): 'YYYY-MM-DD', RFC 3339, or Unix seconds. Defaults to 30 days after from.",
+				},
 				"include_test_tournaments": map[string]interface{}{
 					"type":        "boolean",
-					"description": "Include test tournaments in list results. Default: false (test tournaments are hidden)",
+					"description": "Include test tournaments in list and list_upcoming_events results. Default: false (test tournaments are hidden)",
 				},
 			},
 			"required": []string{"operation"},
@@ -230,9 +277,11 @@ func listTournaments(args map[string]interface{}) (string, error) {
 	// Filter out test tournaments unless specifically requested
 	var filteredTournaments TournamentListResponse
 	for _, tournament := range tournaments {
-		// Check if title contains "TEST" or "test"
-		if !includeTestTournaments && (strings.Contains(tournament.Title, "TEST") || strings.Contains(tournament.Title, "test")) {
-			continue
+		if isTestTournament(tournament.Title) {
+			if !includeTestTournaments {
+				continue
+			}
+			tournament.IsTest = true
 		}
 		filteredTournaments = append(filteredTournaments, tournament)
 	}
@@ -255,6 +304,202 @@ func listTournaments(args map[string]interface{}) (string, error) {
 	return string(jsonData), nil
 }
 
+// Default length of the list_upcoming_events window
+const defaultUpcomingWindow = 30 * 24 * time.Hour
+
+// parseWindowTime reads a date window bound given as 'YYYY-MM-DD', RFC 3339,
+// or Unix seconds (as a number or string). Dates without a time are midnight UTC.
+func parseWindowTime(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case float64:
+		return time.Unix(int64(v), 0).UTC(), nil
+	case string:
+		v = strings.TrimSpace(v)
+		if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return time.Unix(seconds, 0).UTC(), nil
+		}
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t.UTC(), nil
+		}
+		if t, err := time.Parse("2006-01-02", v); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %v (use YYYY-MM-DD, RFC 3339, or Unix seconds)", value)
+}
+
+// Maximum number of tournaments fetched at once when looking up scheduled start times
+const scheduleLookupConcurrency = 4
+
+// scheduledTournament is an account tournament with its scheduled start time
+type scheduledTournament struct {
+	ID      string
+	Title   string
+	Privacy string
+	Start   time.Time
+}
+
+// scheduledTournamentWindow is the result of listScheduledTournaments
+type scheduledTournamentWindow struct {
+	Tournaments   []scheduledTournament
+	Unscheduled   int
+	LookupsFailed []string
+}
+
+// listScheduledTournaments returns the account's tournaments scheduled to
+// start in [from, to], earliest first. /v1/user/tournaments carries no start
+// times, so each candidate is fetched from /v1/tournaments/{id}, at most
+// scheduleLookupConcurrency at a time. Tournaments that ended before from
+// cannot start in the window and are skipped without a lookup.
+func listScheduledTournaments(from, to time.Time, includeTestTournaments bool) (*scheduledTournamentWindow, error) {
+	data, err := makeAPIRequest("GET", "/v1/user/tournaments", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tournaments: %w", err)
+	}
+
+	var tournaments TournamentListResponse
+	if err := json.Unmarshal(data, &tournaments); err != nil {
+		return nil, fmt.Errorf("failed to parse tournaments response: %w", err)
+	}
+
+	var candidates []TournamentListItem
+	for _, tournament := range tournaments {
+		if !includeTestTournaments && isTestTournament(tournament.Title) {
+			continue
+		}
+		if tournament.EndTime != nil && *tournament.EndTime > 0 && trueFinalsTime(*tournament.EndTime).Before(from) {
+			continue
+		}
+		candidates = append(candidates, tournament)
+	}
+
+	type lookup struct {
+		start *int64
+		err   error
+	}
+	lookups := make([]lookup, len(candidates))
+	sem := make(chan struct{}, scheduleLookupConcurrency)
+	var wg sync.WaitGroup
+	for i, candidate := range candidates {
+		wg.Add(1)
+		go func(i int, tournamentID string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			data, err := makeAPIRequest("GET", fmt.Sprintf("/v1/tournaments/%s", tournamentID), nil)
+			if err != nil {
+				lookups[i].err = err
+				return
+			}
+			var tournament Tournament
+			if err := json.Unmarshal(data, &tournament); err != nil {
+				lookups[i].err = fmt.Errorf("failed to parse tournament response: %w", err)
+				return
+			}
+			lookups[i].start = tournament.ScheduledStartTime
+		}(i, candidate.ID)
+	}
+	wg.Wait()
+
+	window := &scheduledTournamentWindow{}
+	for i, candidate := range candidates {
+		if lookups[i].err != nil {
+			window.LookupsFailed = append(window.LookupsFailed, fmt.Sprintf("%s: %v", candidate.ID, lookups[i].err))
+			continue
+		}
+		if lookups[i].start == nil || *lookups[i].start <= 0 {
+			window.Unscheduled++
+			continue
+		}
+		start := trueFinalsTime(*lookups[i].start)
+		if start.Before(from) || start.After(to) {
+			continue
+		}
+		window.Tournaments = append(window.Tournaments, scheduledTournament{
+			ID:      candidate.ID,
+			Title:   candidate.Title,
+			Privacy: candidate.Privacy,
+			Start:   start,
+		})
+	}
+	if len(candidates) > 0 && len(window.LookupsFailed) == len(candidates) {
+		return nil, fmt.Errorf("failed to look up any tournament start times: %s", window.LookupsFailed[0])
+	}
+
+	sort.SliceStable(window.Tournaments, func(i, j int) bool {
+		return window.Tournaments[i].Start.Before(window.Tournaments[j].Start)
+	})
+	return window, nil
+}
+
+// List tournaments scheduled to start within a date window
+func listUpcomingEvents(args map[string]interface{}) (string, error) {
+	from := time.Now().UTC()
+	if v, ok := args["from"]; ok {
+		t, err := parseWindowTime(v)
+		if err != nil {
+			return "", fmt.Errorf("from: %w", err)
+		}
+		from = t
+	}
+
+	to := from.Add(defaultUpcomingWindow)
+	if v, ok := args["to"]; ok {
+		t, err := parseWindowTime(v)
+		if err != nil {
+			return "", fmt.Errorf("to: %w", err)
+		}
+		// A bare date includes the whole day
+		if s, ok := v.(string); ok && len(strings.TrimSpace(s)) == len("2006-01-02") {
+			t = t.Add(24*time.Hour - time.Second)
+		}
+		to = t
+	}
+	if to.Before(from) {
+		return "", fmt.Errorf("to must not be before from")
+	}
+
+	includeTestTournaments, _ := args["include_test_tournaments"].(bool)
+
+	window, err := listScheduledTournaments(from, to, includeTestTournaments)
+	if err != nil {
+		return "", err
+	}
+
+	upcoming := make([]map[string]interface{}, 0, len(window.Tournaments))
+	for _, tournament := range window.Tournaments {
+		upcoming = append(upcoming, map[string]interface{}{
+			"id":                 tournament.ID,
+			"title":              tournament.Title,
+			"privacy":            tournament.Privacy,
+			"scheduledStartTime": tournament.Start.Format(time.RFC3339),
+			"startUnix":          tournament.Start.Unix(),
+		})
+	}
+
+	result := map[string]interface{}{
+		"from":        from.Format(time.RFC3339),
+		"to":          to.Format(time.RFC3339),
+		"tournaments": upcoming,
+		"count":       len(upcoming),
+	}
+	if window.Unscheduled > 0 {
+		result["unscheduledCount"] = window.Unscheduled
+		result["note"] = fmt.Sprintf("%d tournament(s) have no scheduled start time and were left out", window.Unscheduled)
+	}
+	if len(window.LookupsFailed) > 0 {
+		result["lookupsFailed"] = window.LookupsFailed
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
 // Get tournament by ID
 func getTournament(args map[string]interface{}) (string, error) {
 	tournamentID, ok := args["tournament_id"].(string)
@@ -698,6 +943,390 @@ func updateTournamentWebhooks(args map[string]interface{}) (string, error) {
 	return string(jsonData), nil
 }
 
+// Minimum number of participants each format needs to produce a bracket
+var minParticipantsByFormat = map[string]int{
+	"single_elimination": 2,
+	"double_elimination": 3,
+	"round_robin":        3,
+}
+
+// Check that a tournament is ready to start without starting it
+func preflightTournament(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
+
+	data, err := makeAPIRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	var checks []map[string]interface{}
+	var issues []string
+	addCheck := func(name string, passed bool, detail string) {
+		checks = append(checks, map[string]interface{}{
+			"check":  name,
+			"passed": passed,
+			"detail": detail,
+		})
+		if !passed {
+			issues = append(issues, detail)
+		}
+	}
+
+	// Not already started
+	if tournament.StartTime != nil {
+		addCheck("not_started", false, "Tournament has already been started")
+	} else {
+		addCheck("not_started", true, "Tournament has not been started")
+	}
+
+	// Byes are generated by the bracket and don't need seeds
+	var participants []Player
+	for _, player := range tournament.Players {
+		if !player.IsBye {
+			participants = append(participants, player)
+		}
+	}
+
+	// Enough participants for the format
+	minParticipants, ok := minParticipantsByFormat[tournament.Format.Type]
+	if !ok {
+		minParticipants = 2
+	}
+	addCheck("participant_count", len(participants) >= minParticipants,
+		fmt.Sprintf("%d participants (format %q needs at least %d)", len(participants), tournament.Format.Type, minParticipants))
+
+	// Every participant seeded, with no seed used twice
+	var unseeded []string
+	seedHolders := make(map[int][]string)
+	for _, player := range participants {
+		if player.Seed == nil {
+			unseeded = append(unseeded, player.Name)
+			continue
+		}
+		seedHolders[*player.Seed] = append(seedHolders[*player.Seed], player.Name)
+	}
+
+	if len(unseeded) > 0 {
+		addCheck("all_seeded", false, fmt.Sprintf("%d participants have no seed: %s", len(unseeded), strings.Join(unseeded, ", ")))
+	} else {
+		addCheck("all_seeded", true, "All participants have seeds")
+	}
+
+	var duplicateSeeds []int
+	for seed, names := range seedHolders {
+		if len(names) > 1 {
+			duplicateSeeds = append(duplicateSeeds, seed)
+		}
+	}
+	sort.Ints(duplicateSeeds)
+	if len(duplicateSeeds) > 0 {
+		var parts []string
+		for _, seed := range duplicateSeeds {
+			parts = append(parts, fmt.Sprintf("seed %d (%s)", seed, strings.Join(seedHolders[seed], ", ")))
+		}
+		addCheck("unique_seeds", false, "Duplicate seeds: "+strings.Join(parts, "; "))
+	} else {
+		addCheck("unique_seeds", true, "No duplicate seeds")
+	}
+
+	// At least one location (cage) to run games on
+	addCheck("has_locations", len(tournament.Locations) > 0,
+		fmt.Sprintf("%d locations defined", len(tournament.Locations)))
+
+	if issues == nil {
+		issues = []string{}
+	}
+
+	result := map[string]interface{}{
+		"tournament_id":    tournamentID,
+		"title":            tournament.Title,
+		"format":           tournament.Format.Type,
+		"ready":            len(issues) == 0,
+		"checks":           checks,
+		"issues":           issues,
+		"participantCount": len(participants),
+		"locationCount":    len(tournament.Locations),
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// trueFinalsGameWinner returns the winning slot's player ID for a finished
+// two-player game, or "" when there is no clear winner. A negative score marks
+// a forfeit or disqualification, which the other slot wins.
+func trueFinalsGameWinner(game Game) string {
+	if game.State != "done" || len(game.Slots) != 2 {
+		return ""
+	}
+	a, b := game.Slots[0], game.Slots[1]
+	if a.PlayerID == nil || b.PlayerID == nil {
+		return ""
+	}
+	switch {
+	case a.Score > b.Score:
+		return *a.PlayerID
+	case b.Score > a.Score:
+		return *b.PlayerID
+	}
+	return ""
+}
+
+// Compare an event's TrueFinals games with its BrettZone matches
+func reconcileEvent(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	// NHRL events usually share an ID across both systems
+	brettZoneID := tournamentID
+	if id, ok := args["brettzone_tournament_id"].(string); ok && id != "" {
+		brettZoneID = id
+	}
+
+	data, err := makeAPIRequest("GET", fmt.Sprintf("/v1/tournaments/%s", tournamentID), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	matches, err := getBrettZoneLatestMatches(brettZoneID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get BrettZone matches: %w", err)
+	}
+
+	playerNames := make(map[string]string, len(tournament.Players))
+	for _, player := range tournament.Players {
+		playerNames[player.ID] = player.Name
+	}
+
+	brettZoneByID := make(map[string]BrettZoneMatch, len(matches))
+	brettZoneDecided := 0
+	for _, match := range matches {
+		brettZoneByID[match.ID] = match
+		if getMatchWinner(match) != "undecided" {
+			brettZoneDecided++
+		}
+	}
+
+	discrepancies := make([]map[string]interface{}, 0)
+	addDiscrepancy := func(kind, matchID, detail string) {
+		discrepancies = append(discrepancies, map[string]interface{}{
+			"type":    kind,
+			"matchID": matchID,
+			"detail":  detail,
+		})
+	}
+
+	trueFinalsDecided := 0
+	seen := make(map[string]bool, len(tournament.Games))
+	for _, game := range tournament.Games {
+		seen[game.ID] = true
+
+		var players []string
+		for _, slot := range game.Slots {
+			if slot.PlayerID != nil {
+				players = append(players, playerNames[*slot.PlayerID])
+			}
+		}
+		winner := ""
+		if winnerID := trueFinalsGameWinner(game); winnerID != "" {
+			winner = playerNames[winnerID]
+			trueFinalsDecided++
+		}
+
+		match, ok := brettZoneByID[game.ID]
+		if !ok {
+			// Unplayed games may not have reached BrettZone yet
+			if winner != "" {
+				addDiscrepancy("missing_in_brettzone", game.ID, fmt.Sprintf("TrueFinals has %s won by %s", strings.Join(players, " vs "), winner))
+			}
+			continue
+		}
+
+		if len(players) == 2 {
+			samePlayers := (botNamesMatch(players[0], match.Player1) && botNamesMatch(players[1], match.Player2)) ||
+				(botNamesMatch(players[0], match.Player2) && botNamesMatch(players[1], match.Player1))
+			if !samePlayers {
+				addDiscrepancy("players_mismatch", game.ID, fmt.Sprintf("TrueFinals: %s; BrettZone: %s vs %s", strings.Join(players, " vs "), match.Player1, match.Player2))
+				continue
+			}
+		}
+
+		brettZoneWinner := getMatchWinner(match)
+		switch {
+		case winner == "" && brettZoneWinner == "undecided":
+		case winner == "":
+			addDiscrepancy("result_missing_in_truefinals", game.ID, fmt.Sprintf("BrettZone has %s winning; TrueFinals has no result", brettZoneWinner))
+		case brettZoneWinner == "undecided":
+			addDiscrepancy("result_missing_in_brettzone", game.ID, fmt.Sprintf("TrueFinals has %s winning; BrettZone has no result", winner))
+		case !botNamesMatch(winner, brettZoneWinner):
+			addDiscrepancy("winner_mismatch", game.ID, fmt.Sprintf("TrueFinals winner %s; BrettZone winner %s", winner, brettZoneWinner))
+		}
+	}
+
+	for _, match := range matches {
+		if !seen[match.ID] && getMatchWinner(match) != "undecided" {
+			addDiscrepancy("missing_in_truefinals", match.ID, fmt.Sprintf("BrettZone has %s vs %s won by %s", match.Player1, match.Player2, getMatchWinner(match)))
+		}
+	}
+
+	sort.SliceStable(discrepancies, func(i, j int) bool {
+		return discrepancies[i]["matchID"].(string) < discrepancies[j]["matchID"].(string)
+	})
+
+	result := map[string]interface{}{
+		"tournament_id":           tournamentID,
+		"brettzone_tournament_id": brettZoneID,
+		"matchCounts": map[string]interface{}{
+			"trueFinalsGames":   len(tournament.Games),
+			"trueFinalsDecided": trueFinalsDecided,
+			"brettZoneMatches":  len(matches),
+			"brettZoneDecided":  brettZoneDecided,
+		},
+		"inSync":           len(discrepancies) == 0 && trueFinalsDecided == brettZoneDecided,
+		"discrepancyCount": len(discrepancies),
+		"discrepancies":    discrepancies,
+		"note":             "Games are matched by ID (e.g. W-5, Q1-12) and bot names are compared ignoring case and spacing",
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Compare each game's scheduled time with when it actually started in BrettZone
+func getScheduleVariance(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	brettZoneID := tournamentID
+	if id, ok := args["brettzone_tournament_id"].(string); ok && id != "" {
+		brettZoneID = id
+	}
+
+	data, err := makeAPIRequest("GET", fmt.Sprintf("/v1/tournaments/%s", tournamentID), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	matches, err := getBrettZoneLatestMatches(brettZoneID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get BrettZone matches: %w", err)
+	}
+
+	startTimes := make(map[string]time.Time, len(matches))
+	for _, match := range matches {
+		if t, ok := parseBrettZoneTime(match.StartTime); ok {
+			startTimes[match.ID] = t
+		}
+	}
+
+	type matchVariance struct {
+		GameID      string  `json:"gameID"`
+		Name        string  `json:"name"`
+		ScheduledAt string  `json:"scheduledAt"`
+		StartedAt   string  `json:"startedAt"`
+		MinutesLate float64 `json:"minutesLate"`
+	}
+
+	var variances []matchVariance
+	unscheduled, notStarted := 0, 0
+	totalLate, maxLate := 0.0, 0.0
+	lateCount := 0
+	for _, game := range tournament.Games {
+		if game.ScheduledTime == nil || *game.ScheduledTime <= 0 {
+			unscheduled++
+			continue
+		}
+		started, ok := startTimes[game.ID]
+		if !ok {
+			notStarted++
+			continue
+		}
+
+		scheduled := trueFinalsTime(*game.ScheduledTime)
+		minutesLate := math.Round(started.Sub(scheduled).Minutes()*10) / 10
+		variances = append(variances, matchVariance{
+			GameID:      game.ID,
+			Name:        game.Name,
+			ScheduledAt: scheduled.UTC().Format(time.RFC3339),
+			StartedAt:   started.UTC().Format(time.RFC3339),
+			MinutesLate: minutesLate,
+		})
+
+		totalLate += minutesLate
+		maxLate = math.Max(maxLate, minutesLate)
+		if minutesLate > 0 {
+			lateCount++
+		}
+	}
+
+	sort.SliceStable(variances, func(i, j int) bool {
+		return variances[i].ScheduledAt < variances[j].ScheduledAt
+	})
+
+	result := map[string]interface{}{
+		"tournament_id":           tournamentID,
+		"brettzone_tournament_id": brettZoneID,
+		"comparedCount":           len(variances),
+		"unscheduledCount":        unscheduled,
+		"notStartedCount":         notStarted,
+		"matches":                 variances,
+		"note":                    "Positive minutesLate means the match started after its TrueFinals scheduled time; negative means it started early",
+	}
+	if len(variances) > 0 {
+		result["summary"] = map[string]interface{}{
+			"averageMinutesLate": math.Round(totalLate/float64(len(variances))*10) / 10,
+			"maxMinutesLate":     maxLate,
+			"lateCount":          lateCount,
+			"onTimeOrEarlyCount": len(variances) - lateCount,
+		}
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
 // Start a tournament
 func startTournament(args map[string]interface{}) (string, error) {
 	tournamentID, ok := args["tournament_id"].(string)
@@ -769,6 +1398,146 @@ func resetTournament(args map[string]interface{}) (string, error) {
 	return string(jsonData), nil
 }
 
+// Report what a reset would wipe, without resetting
+func resetPreview(args map[string]interface{}) (string, error) {
+	tournamentID, ok := args["tournament_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tournament_id is required")
+	}
+
+	mode, ok := args["reset_mode"].(string)
+	if !ok {
+		mode = "bracket_only" // Default mode, matching reset
+	}
+	if mode != "bracket_only" && mode != "all" {
+		return "", fmt.Errorf("unsupported reset_mode: %s (supported: bracket_only, all)", mode)
+	}
+
+	data, err := makeAPIRequest("GET", fmt.Sprintf("/v1/tournaments/%s", tournamentID), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament Tournament
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	completedGames, decidedGames, annotatedGames := 0, 0, 0
+	inProgressGames, partialScores, checkIns := 0, 0, 0
+	var completedNames []string
+	for _, game := range tournament.Games {
+		if game.State == "done" {
+			completedGames++
+			completedNames = append(completedNames, game.Name)
+			if trueFinalsGameWinner(game) != "" {
+				decidedGames++
+			}
+		} else {
+			if game.State == "active" || game.State == "called" {
+				inProgressGames++
+			}
+			for _, slot := range game.Slots {
+				if slot.Score > 0 {
+					partialScores++
+					break
+				}
+			}
+		}
+		if game.ResultAnnotation != nil && *game.ResultAnnotation != "" {
+			annotatedGames++
+		}
+		for _, slot := range game.Slots {
+			if slot.CheckInTime != nil && *slot.CheckInTime > 0 {
+				checkIns++
+			}
+		}
+	}
+	sort.Strings(completedNames)
+
+	recordedResults := 0
+	players := 0
+	for _, player := range tournament.Players {
+		if player.IsBye {
+			continue
+		}
+		players++
+		recordedResults += player.Wins + player.Losses + player.Ties
+	}
+
+	wouldLose := map[string]interface{}{
+		"completedGames":       completedGames,
+		"decidedGames":         decidedGames,
+		"gamesWithAnnotations": annotatedGames,
+		"inProgressGames":      inProgressGames,
+		"unfinishedWithScores": partialScores,
+		"matchCheckIns":        checkIns,
+		"playerRecordEntries":  recordedResults / 2,
+	}
+
+	safe := completedGames == 0 && inProgressGames == 0 && partialScores == 0
+	summary := fmt.Sprintf("Reset (%s) would wipe %d completed games and %d in-progress games", mode, completedGames, inProgressGames)
+
+	// bracket_only keeps the roster; an "all" reset also removes every player
+	// along with their seeds, disqualifications, linked profiles, and check-ins
+	var beyondBracketOnly map[string]interface{}
+	if mode == "all" {
+		seeded, disqualified, linkedProfiles := 0, 0, 0
+		for _, player := range tournament.Players {
+			if player.IsBye {
+				continue
+			}
+			if player.Seed != nil {
+				seeded++
+			}
+			if player.IsDisqualified {
+				disqualified++
+			}
+			if player.ProfileInfo != nil {
+				linkedProfiles++
+			}
+		}
+		beyondBracketOnly = map[string]interface{}{
+			"players":              players,
+			"seededPlayers":        seeded,
+			"disqualifiedPlayers":  disqualified,
+			"linkedPlayerProfiles": linkedProfiles,
+			"playerCheckIns":       checkIns,
+		}
+		wouldLose["players"] = players
+		safe = safe && players == 0
+		summary = fmt.Sprintf("%s, and remove all %d players with their seeds and check-ins", summary, players)
+	}
+	if safe {
+		summary = fmt.Sprintf("Reset (%s) would not lose any recorded results", mode)
+	}
+
+	result := map[string]interface{}{
+		"tournament_id":      tournamentID,
+		"tournamentName":     tournament.Title,
+		"resetMode":          mode,
+		"playerCount":        players,
+		"gameCount":          len(tournament.Games),
+		"wouldLose":          wouldLose,
+		"completedGameNames": completedNames,
+		"safeToReset":        safe,
+		"summary":            summary,
+		"note":               "Preview only; nothing was reset. playerRecordEntries halves the players' combined W/L/T totals, so it approximates recorded match results.",
+	}
+	if beyondBracketOnly != nil {
+		result["beyondBracketOnly"] = beyondBracketOnly
+	}
+
+	flagTestTournament(result, tournament.Title)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
 // Push tournament game schedule
 func pushTournamentSchedule(args map[string]interface{}) (string, error) {
 	tournamentID, ok := args["tournament_id"].(string)
@@ -814,6 +1583,16 @@ func deleteTournament(args map[string]interface{}) (string, error) {
 
 	endpoint := fmt.Sprintf("/v1/tournaments/%s", tournamentID)
 
+	// Snapshot the tournament before it is gone so it can be restored later
+	var tombstoneID string
+	if tombstoneDir != "" {
+		id, err := writeTournamentTombstone(tournamentID)
+		if err != nil {
+			return "", fmt.Errorf("failed to write tombstone, tournament not deleted: %w", err)
+		}
+		tombstoneID = id
+	}
+
 	data, err := makeAPIRequest("DELETE", endpoint, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to delete tournament: %w", err)
@@ -824,6 +1603,288 @@ func deleteTournament(args map[string]interface{}) (string, error) {
 		return "", fmt.Errorf("failed to parse response: %w", err)
 	}
 
+	if tombstoneID != "" {
+		if result == nil {
+			result = map[string]interface{}{}
+		}
+		result["tombstone_id"] = tombstoneID
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// TournamentTombstone is a local snapshot of a tournament taken just before it
+// was deleted. Bundle is the export in tournament creation form, ready to POST
+// back to /v1/tournaments; Tournament and Webhooks are the raw responses it was
+// built from.
+type TournamentTombstone struct {
+	TournamentID string                 `json:"tournamentID"`
+	DeletedAt    time.Time              `json:"deletedAt"`
+	Tournament   map[string]interface{} `json:"tournament"`
+	Webhooks     []interface{}          `json:"webhooks"`
+	Bundle       map[string]interface{} `json:"bundle"`
+}
+
+// Tournament fields that creation accepts unchanged from GET /v1/tournaments/{id}
+var tournamentCreateFields = []string{
+	"creatorProfileID", "title", "gameTitleInfo", "eventLocation", "scheduledStartTime", "privacy",
+	"displayCheckInStatus", "logoUrl", "thumbnailUrl", "description",
+}
+
+// tournamentCreateBundle exports a fetched tournament and its webhooks in the
+// shape POST /v1/tournaments accepts. The GET response names things
+// differently: players become participants, format becomes formatOptions
+// (whose brackets are the format's bracketOpts), and locations keep only the
+// fields creation allows. Byes are generated by the bracket and are dropped.
+func tournamentCreateBundle(tournamentID string, tournament map[string]interface{}, webhooks []interface{}) map[string]interface{} {
+	bundle := map[string]interface{}{
+		"tournamentID":    tournamentID,
+		"nonParticipants": []interface{}{},
+	}
+	for _, field := range tournamentCreateFields {
+		bundle[field] = tournament[field]
+	}
+
+	if webhooks == nil {
+		webhooks = []interface{}{}
+	}
+	bundle["webhooks"] = webhooks
+
+	locations := []interface{}{}
+	if rawLocations, ok := tournament["locations"].([]interface{}); ok {
+		for _, l := range rawLocations {
+			location, ok := l.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			blockActive, _ := location["blockActive"].(bool)
+			locations = append(locations, map[string]interface{}{
+				"locationID":  location["id"],
+				"name":        location["name"],
+				"blockActive": blockActive,
+			})
+		}
+	}
+	bundle["locations"] = locations
+
+	participants := []interface{}{}
+	if players, ok := tournament["players"].([]interface{}); ok {
+		for _, p := range players {
+			player, ok := p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if isBye, _ := player["isBye"].(bool); isBye {
+				continue
+			}
+			participants = append(participants, map[string]interface{}{
+				"playerID":    player["id"],
+				"name":        player["name"],
+				"photoUrl":    player["photoUrl"],
+				"profileInfo": player["profileInfo"],
+			})
+		}
+	}
+	bundle["participants"] = participants
+
+	if format, ok := tournament["format"].(map[string]interface{}); ok {
+		formatOptions := make(map[string]interface{}, len(format))
+		for key, value := range format {
+			switch key {
+			case "brackets":
+				// Generated bracket structure; creation takes bracketOpts in its place
+			case "bracketOpts":
+				formatOptions["brackets"] = value
+			default:
+				formatOptions[key] = value
+			}
+		}
+		bundle["formatOptions"] = formatOptions
+	}
+
+	return bundle
+}
+
+// writeTournamentTombstone fetches the full tournament and its webhooks and
+// saves them, with the creation bundle built from them, to the tombstone directory
+func writeTournamentTombstone(tournamentID string) (string, error) {
+	data, err := makeAPIRequest("GET", fmt.Sprintf("/v1/tournaments/%s", tournamentID), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	var tournament map[string]interface{}
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	// Webhooks live behind the private endpoint and are not part of the tournament response
+	data, err = makeAPIRequest("GET", fmt.Sprintf("/v1/tournaments/%s/private/webhooks", tournamentID), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tournament webhooks: %w", err)
+	}
+
+	var webhooks []interface{}
+	if err := json.Unmarshal(data, &webhooks); err != nil {
+		return "", fmt.Errorf("failed to parse webhooks response: %w", err)
+	}
+
+	tombstone := TournamentTombstone{
+		TournamentID: tournamentID,
+		DeletedAt:    time.Now().UTC(),
+		Tournament:   tournament,
+		Webhooks:     webhooks,
+		Bundle:       tournamentCreateBundle(tournamentID, tournament, webhooks),
+	}
+
+	jsonData, err := json.MarshalIndent(tombstone, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal tombstone: %w", err)
+	}
+
+	if err := os.MkdirAll(tombstoneDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create tombstone directory: %w", err)
+	}
+
+	// Written atomically so list_tombstones never reads a half-written snapshot
+	tombstoneID := fmt.Sprintf("%s-%d", tournamentID, tombstone.DeletedAt.Unix())
+	tmpFile := tombstonePath(tombstoneID) + ".tmp"
+	if err := os.WriteFile(tmpFile, jsonData, 0644); err != nil {
+		return "", fmt.Errorf("failed to write tombstone file: %w", err)
+	}
+	if err := os.Rename(tmpFile, tombstonePath(tombstoneID)); err != nil {
+		return "", fmt.Errorf("failed to write tombstone file: %w", err)
+	}
+
+	return tombstoneID, nil
+}
+
+// tombstonePath returns the file path for a tombstone ID
+func tombstonePath(tombstoneID string) string {
+	return filepath.Join(tombstoneDir, tombstoneID+".json")
+}
+
+// readTournamentTombstone loads a tombstone by ID
+func readTournamentTombstone(tombstoneID string) (*TournamentTombstone, error) {
+	// Tombstone IDs are bare file names; reject anything that could escape the directory
+	if tombstoneID == "" || filepath.Base(tombstoneID) != tombstoneID {
+		return nil, fmt.Errorf("invalid tombstone_id: %s", tombstoneID)
+	}
+
+	data, err := os.ReadFile(tombstonePath(tombstoneID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tombstone: %w", err)
+	}
+
+	var tombstone TournamentTombstone
+	if err := json.Unmarshal(data, &tombstone); err != nil {
+		return nil, fmt.Errorf("failed to parse tombstone: %w", err)
+	}
+
+	return &tombstone, nil
+}
+
+// List locally saved tombstones of deleted tournaments
+func listTournamentTombstones(args map[string]interface{}) (string, error) {
+	if tombstoneDir == "" {
+		return "", fmt.Errorf("tombstones are disabled; start the server with --tombstone-dir")
+	}
+
+	entries, err := os.ReadDir(tombstoneDir)
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("failed to read tombstone directory: %w", err)
+	}
+
+	tombstones := make([]map[string]interface{}, 0)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		tombstoneID := strings.TrimSuffix(entry.Name(), ".json")
+		tombstone, err := readTournamentTombstone(tombstoneID)
+		if err != nil {
+			continue
+		}
+
+		summary := map[string]interface{}{
+			"tombstoneID":  tombstoneID,
+			"tournamentID": tombstone.TournamentID,
+			"deletedAt":    tombstone.DeletedAt.Format(time.RFC3339),
+		}
+		if title, ok := tombstone.Tournament["title"].(string); ok {
+			summary["title"] = title
+		}
+		tombstones = append(tombstones, summary)
+	}
+
+	// Most recent deletions first
+	sort.SliceStable(tombstones, func(i, j int) bool {
+		return tombstones[i]["deletedAt"].(string) > tombstones[j]["deletedAt"].(string)
+	})
+
+	result := map[string]interface{}{
+		"tombstones": tombstones,
+		"count":      len(tombstones),
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(jsonData), nil
+}
+
+// Recreate a deleted tournament from its tombstone snapshot
+func restoreTournamentTombstone(args map[string]interface{}) (string, error) {
+	if tombstoneDir == "" {
+		return "", fmt.Errorf("tombstones are disabled; start the server with --tombstone-dir")
+	}
+
+	tombstoneID, ok := args["tombstone_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("tombstone_id is required")
+	}
+
+	tombstone, err := readTournamentTombstone(tombstoneID)
+	if err != nil {
+		return "", err
+	}
+
+	requestBody := tombstone.Bundle
+	if requestBody == nil {
+		return "", fmt.Errorf("tombstone %s has no creation bundle", tombstoneID)
+	}
+	if creatorProfileID, ok := args["creator_profile_id"].(string); ok {
+		requestBody["creatorProfileID"] = creatorProfileID
+	}
+
+	data, err := makeAPIRequest("POST", "/v1/tournaments", requestBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to restore tournament: %w", err)
+	}
+
+	var tournament map[string]interface{}
+	if err := json.Unmarshal(data, &tournament); err != nil {
+		return "", fmt.Errorf("failed to parse tournament response: %w", err)
+	}
+
+	participants, _ := requestBody["participants"].([]interface{})
+	result := map[string]interface{}{
+		"tombstoneID":  tombstoneID,
+		"tournamentID": tombstone.TournamentID,
+		"restored":     true,
+		"tournament":   enrichTournamentData(tournament),
+		"participants": len(participants),
+		"note":         "Bracket progress and match results are not restored; the setup (roster, format, cages, and webhooks) is recreated.",
+	}
+
 	jsonData, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal result: %w", err)
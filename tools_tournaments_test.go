@@ -0,0 +1,455 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// withTombstoneDir points tombstoneDir at a fresh temporary directory for one test
+func withTombstoneDir(t *testing.T) string {
+	t.Helper()
+	previous := tombstoneDir
+	tombstoneDir = t.TempDir()
+	t.Cleanup(func() { tombstoneDir = previous })
+	return tombstoneDir
+}
+
+func TestDeleteWritesTombstoneAndRestoreRecreates(t *testing.T) {
+	stub := newUpstreamStub(t)
+	dir := withTombstoneDir(t)
+
+	tournament := map[string]interface{}{
+		"id":               "t1",
+		"title":            "NHRL June 2025 3lb",
+		"creatorProfileID": "creator1",
+		"privacy":          "public",
+		"players": []interface{}{
+			map[string]interface{}{"id": "p1", "name": "Lynx"},
+			map[string]interface{}{"id": "p2", "name": "Zeus"},
+			map[string]interface{}{"id": "bye1", "name": "BYE", "isBye": true},
+		},
+		"locations": []interface{}{
+			map[string]interface{}{"id": "l1", "name": "Cage 1", "blockActive": true},
+		},
+		"format": map[string]interface{}{
+			"type":        "double_elim",
+			"brackets":    []interface{}{"generated"},
+			"bracketOpts": []interface{}{map[string]interface{}{"size": 2}},
+		},
+	}
+	webhooks := []interface{}{map[string]interface{}{"url": "https://example.com/hook"}}
+
+	deleted := false
+	stub.trueFinals("/v1/tournaments/t1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, tournament)
+		case http.MethodDelete:
+			deleted = true
+			writeJSON(w, map[string]interface{}{"success": true})
+		default:
+			http.Error(w, "unexpected method", http.StatusMethodNotAllowed)
+		}
+	})
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1/private/webhooks", webhooks)
+
+	var created map[string]interface{}
+	stub.trueFinals("/v1/tournaments", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "unexpected method", http.StatusMethodNotAllowed)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+			t.Errorf("decode restore body: %v", err)
+		}
+		writeJSON(w, map[string]interface{}{"id": "t1", "title": created["title"]})
+	})
+
+	output, err := deleteTournament(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if !deleted {
+		t.Fatal("delete did not reach the API")
+	}
+	tombstoneID, _ := decodeResult(t, output)["tombstone_id"].(string)
+	if tombstoneID == "" {
+		t.Fatalf("delete result has no tombstone_id: %s", output)
+	}
+	if _, err := os.Stat(filepath.Join(dir, tombstoneID+".json")); err != nil {
+		t.Fatalf("tombstone file not written: %v", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("tombstone directory has %d entries, want only the tombstone", len(entries))
+	}
+
+	output, err = listTournamentTombstones(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("list_tombstones: %v", err)
+	}
+	listed := decodeResult(t, output)["tombstones"].([]interface{})
+	if len(listed) != 1 || listed[0].(map[string]interface{})["tombstoneID"] != tombstoneID {
+		t.Fatalf("tombstones = %v, want only %s", listed, tombstoneID)
+	}
+
+	output, err = restoreTournamentTombstone(map[string]interface{}{"tombstone_id": tombstoneID})
+	if err != nil {
+		t.Fatalf("restore_tombstone: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["restored"] != true || result["participants"].(float64) != 2 {
+		t.Errorf("restore result = %v, want restored with 2 participants", result)
+	}
+
+	if created["title"] != "NHRL June 2025 3lb" || created["creatorProfileID"] != "creator1" {
+		t.Errorf("restore body = %v, want the original title and creator", created)
+	}
+	if participants := created["participants"].([]interface{}); len(participants) != 2 {
+		t.Errorf("restored participants = %v, want two (byes dropped)", participants)
+	}
+	formatOptions := created["formatOptions"].(map[string]interface{})
+	if _, ok := formatOptions["bracketOpts"]; ok || formatOptions["brackets"] == nil {
+		t.Errorf("formatOptions = %v, want bracketOpts sent as brackets", formatOptions)
+	}
+	if hooks := created["webhooks"].([]interface{}); len(hooks) != 1 {
+		t.Errorf("restored webhooks = %v, want one", hooks)
+	}
+}
+
+func TestRestoreTombstoneRejectsPathEscape(t *testing.T) {
+	withTombstoneDir(t)
+	if _, err := restoreTournamentTombstone(map[string]interface{}{"tombstone_id": "../secrets"}); err == nil {
+		t.Fatal("restore accepted a tombstone_id outside the tombstone directory")
+	}
+}
+
+// seeded returns players with seeds 1..n in order, plus a bracket-generated bye
+func seeded(names ...string) []Player {
+	players := tfPlayers(names...)
+	for i := range players {
+		seed := i + 1
+		players[i].Seed = &seed
+	}
+	return append(players, Player{ID: "bye", Name: "BYE", IsBye: true})
+}
+
+func TestPreflightReadyAndMissingLocations(t *testing.T) {
+	stub := newUpstreamStub(t)
+	stub.json(trueFinalsHost+"/api/v1/tournaments/ready", Tournament{
+		ID:        "ready",
+		Title:     "NHRL June 2025 3lb",
+		Players:   seeded("Lynx", "Zeus", "Bolt"),
+		Locations: []Location{{ID: "l1", Name: "Cage 1"}},
+		Format:    TournamentFormat{Type: "double_elimination"},
+	})
+	stub.json(trueFinalsHost+"/api/v1/tournaments/nocages", Tournament{
+		ID:      "nocages",
+		Title:   "NHRL June 2025 12lb",
+		Players: seeded("Lynx", "Zeus", "Bolt"),
+		Format:  TournamentFormat{Type: "double_elimination"},
+	})
+
+	output, err := preflightTournament(map[string]interface{}{"tournament_id": "ready"})
+	if err != nil {
+		t.Fatalf("preflight: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["ready"] != true || len(result["issues"].([]interface{})) != 0 || result["participantCount"] != 3.0 {
+		t.Errorf("ready tournament = %v, want ready with 3 participants and no issues", result)
+	}
+
+	output, err = preflightTournament(map[string]interface{}{"tournament_id": "nocages"})
+	if err != nil {
+		t.Fatalf("preflight: %v", err)
+	}
+	result = decodeResult(t, output)
+	issues := result["issues"].([]interface{})
+	if result["ready"] != false || len(issues) != 1 || issues[0] != "0 locations defined" {
+		t.Errorf("tournament without cages = ready %v, issues %v; want only the missing locations", result["ready"], issues)
+	}
+	for _, c := range result["checks"].([]interface{}) {
+		check := c.(map[string]interface{})
+		if check["passed"] != (check["check"] != "has_locations") {
+			t.Errorf("check %v passed = %v", check["check"], check["passed"])
+		}
+	}
+}
+
+func TestListUpcomingEventsInsideAndOutsideWindow(t *testing.T) {
+	stub := newUpstreamStub(t)
+	at := func(month time.Month, day, hour int) *int64 {
+		return int64Ptr(time.Date(2025, month, day, hour, 0, 0, 0, time.UTC).UnixMilli())
+	}
+	starts := map[string]*int64{
+		"july-late":   at(time.July, 20, 15),
+		"july-early":  int64Ptr(time.Date(2025, time.July, 5, 15, 0, 0, 0, time.UTC).Unix()),
+		"july-last":   at(time.July, 31, 18),
+		"june":        at(time.June, 14, 15),
+		"august":      at(time.August, 2, 15),
+		"unscheduled": nil,
+		"test":        at(time.July, 10, 15),
+	}
+	list := []TournamentListItem{{ID: "finished", Title: "NHRL March 2025", EndTime: at(time.March, 9, 20)}}
+	for id, start := range starts {
+		title := "NHRL " + id
+		if id == "test" {
+			title = "Test bracket"
+		}
+		list = append(list, TournamentListItem{ID: id, Title: title})
+		stub.json(trueFinalsHost+"/api/v1/tournaments/"+id, Tournament{ID: id, Title: title, ScheduledStartTime: start})
+	}
+	stub.json(trueFinalsHost+"/api/v1/user/tournaments", list)
+
+	output, err := listUpcomingEvents(map[string]interface{}{"from": "2025-07-01", "to": "2025-07-31"})
+	if err != nil {
+		t.Fatalf("list_upcoming_events: %v", err)
+	}
+	result := decodeResult(t, output)
+	var ids []string
+	for _, tournament := range result["tournaments"].([]interface{}) {
+		ids = append(ids, tournament.(map[string]interface{})["id"].(string))
+	}
+	// Soonest first; a bare to date includes that whole day
+	if strings.Join(ids, ",") != "july-early,july-late,july-last" {
+		t.Errorf("upcoming = %v, want july-early,july-late,july-last", ids)
+	}
+	if first := result["tournaments"].([]interface{})[0].(map[string]interface{}); first["scheduledStartTime"] != "2025-07-05T15:00:00Z" {
+		t.Errorf("july-early starts %v, want 2025-07-05T15:00:00Z", first["scheduledStartTime"])
+	}
+	if result["unscheduledCount"] != 1.0 {
+		t.Errorf("unscheduledCount = %v, want 1", result["unscheduledCount"])
+	}
+	if calls := stub.count(trueFinalsHost + "/api/v1/tournaments/finished"); calls != 0 {
+		t.Errorf("looked up a tournament that ended before the window %d times", calls)
+	}
+	if calls := stub.count(trueFinalsHost + "/api/v1/tournaments/test"); calls != 0 {
+		t.Errorf("looked up a test tournament %d times", calls)
+	}
+
+	if _, err := listUpcomingEvents(map[string]interface{}{"from": "2025-07-31", "to": "2025-07-01"}); err == nil {
+		t.Error("a window ending before it starts succeeded, want an error")
+	}
+}
+
+func TestReconcileEventWinnerDisagreement(t *testing.T) {
+	stub := newUpstreamStub(t)
+	decided := func(id, player1, player2 string, score1, score2 float64) Game {
+		game := tfGame(id, "done", player1, player2)
+		game.Slots[0].Score, game.Slots[1].Score = score1, score2
+		return game
+	}
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{
+		ID:      "t1",
+		Title:   "NHRL June 2025 3lb",
+		Players: tfPlayers("Lynx", "Zeus", "Bolt", "Mole"),
+		Games: []Game{
+			decided("Q1-1", "Lynx", "Zeus", 1, 0),
+			decided("Q1-2", "Bolt", "Mole", 1, 0),
+			tfGame("Q2W-1", "available", "Lynx", "Bolt"),
+		},
+	})
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"bz1": {
+		bzMatch("Q1-1", "Q1", "Zeus", "Lynx", 2),
+		bzMatch("Q1-2", "Q1", "Bolt", "Mole", 2),
+		bzMatch("Q2W-1", "Q2W", "Lynx", "Bolt", 0),
+	}})
+
+	output, err := reconcileEvent(map[string]interface{}{"tournament_id": "t1", "brettzone_tournament_id": "bz1"})
+	if err != nil {
+		t.Fatalf("reconcile_event: %v", err)
+	}
+	result := decodeResult(t, output)
+	counts := result["matchCounts"].(map[string]interface{})
+	if counts["trueFinalsGames"] != 3.0 || counts["trueFinalsDecided"] != 2.0 || counts["brettZoneMatches"] != 3.0 || counts["brettZoneDecided"] != 2.0 {
+		t.Errorf("matchCounts = %v, want 3 games with 2 decided in each source", counts)
+	}
+	if result["inSync"] != false || result["discrepancyCount"] != 1.0 {
+		t.Fatalf("inSync = %v, discrepancyCount = %v; want one discrepancy", result["inSync"], result["discrepancyCount"])
+	}
+	// Q1-1 lists the bots in the other order but agrees on the winner
+	discrepancy := result["discrepancies"].([]interface{})[0].(map[string]interface{})
+	if discrepancy["type"] != "winner_mismatch" || discrepancy["matchID"] != "Q1-2" || discrepancy["detail"] != "TrueFinals winner Bolt; BrettZone winner Mole" {
+		t.Errorf("discrepancy = %v, want a Q1-2 winner mismatch between Bolt and Mole", discrepancy)
+	}
+}
+
+func TestScheduleVarianceOnTimeAndLate(t *testing.T) {
+	stub := newUpstreamStub(t)
+	const base = 1750000000
+	scheduledAt := func(game Game, ts int64) Game {
+		game.ScheduledTime = &ts
+		return game
+	}
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{
+		ID:      "t1",
+		Title:   "NHRL June 2025 3lb",
+		Players: tfPlayers("Lynx", "Zeus", "Bolt", "Mole"),
+		Games: []Game{
+			scheduledAt(tfGame("Q1-4", "done", "Zeus", "Mole"), base+1800),
+			scheduledAt(tfGame("Q1-1", "done", "Lynx", "Zeus"), base),
+			// TrueFinals may send milliseconds
+			scheduledAt(tfGame("Q1-2", "done", "Bolt", "Mole"), (base+600)*1000),
+			scheduledAt(tfGame("Q1-3", "done", "Lynx", "Bolt"), base+1200),
+			scheduledAt(tfGame("Q2W-1", "available", "Lynx", "Mole"), base+2400),
+			tfGame("Q2W-2", "available", "Zeus", "Bolt"),
+		},
+	})
+	started := func(id string, offset int) BrettZoneMatch {
+		match := bzMatch(id, "Q1", "Lynx", "Zeus", 1)
+		match.StartTime = epoch(offset)
+		return match
+	}
+	unstarted := bzMatch("Q2W-1", "Q2W", "Lynx", "Mole", 0)
+	stub.brettZoneMatches(map[string][]BrettZoneMatch{"t1": {
+		started("Q1-1", 0),
+		started("Q1-2", 600+900),
+		started("Q1-3", 1200-120),
+		started("Q1-4", 1800+1800),
+		unstarted,
+	}})
+
+	output, err := getScheduleVariance(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("get_schedule_variance: %v", err)
+	}
+	result := decodeResult(t, output)
+	if result["comparedCount"] != 4.0 || result["unscheduledCount"] != 1.0 || result["notStartedCount"] != 1.0 {
+		t.Errorf("compared = %v, unscheduled = %v, not started = %v; want 4, 1, 1",
+			result["comparedCount"], result["unscheduledCount"], result["notStartedCount"])
+	}
+
+	var late []string
+	for _, m := range result["matches"].([]interface{}) {
+		match := m.(map[string]interface{})
+		late = append(late, match["gameID"].(string)+"="+strconv.FormatFloat(match["minutesLate"].(float64), 'f', -1, 64))
+	}
+	if got := strings.Join(late, ","); got != "Q1-1=0,Q1-2=15,Q1-3=-2,Q1-4=30" {
+		t.Errorf("minutesLate in schedule order = %s, want Q1-1=0,Q1-2=15,Q1-3=-2,Q1-4=30", got)
+	}
+	first := result["matches"].([]interface{})[0].(map[string]interface{})
+	if first["scheduledAt"] != "2025-06-15T15:06:40Z" || first["startedAt"] != "2025-06-15T15:06:40Z" {
+		t.Errorf("Q1-1 scheduledAt = %v, startedAt = %v; want both 2025-06-15T15:06:40Z", first["scheduledAt"], first["startedAt"])
+	}
+
+	summary := result["summary"].(map[string]interface{})
+	if summary["averageMinutesLate"] != 10.8 || summary["maxMinutesLate"] != 30.0 || summary["lateCount"] != 2.0 || summary["onTimeOrEarlyCount"] != 2.0 {
+		t.Errorf("summary = %v, want 10.8 minutes late on average, max 30, 2 late and 2 on time or early", summary)
+	}
+}
+
+func TestResetPreviewCountsCompletedGames(t *testing.T) {
+	stub := newUpstreamStub(t)
+	scored := func(id, state string, score1, score2 float64) Game {
+		game := tfGame(id, state, "Lynx", "Bolt")
+		game.Slots[0].Score, game.Slots[1].Score = score1, score2
+		return game
+	}
+	opener := tfGame("Q1-1", "done", "Lynx", "Zeus")
+	opener.Slots[0].Score = 1
+	opener.ResultAnnotation = strPtr("KO")
+	checkedIn := int64(1750000000)
+	opener.Slots[0].CheckInTime, opener.Slots[1].CheckInTime = &checkedIn, &checkedIn
+	second := tfGame("Q1-2", "done", "Bolt", "Mole")
+	second.Slots[0].Score = 1
+
+	players := seeded("Lynx", "Zeus", "Bolt", "Mole")
+	players[0].Wins, players[1].Losses, players[2].Wins, players[3].Losses = 1, 1, 1, 1
+	players[3].IsDisqualified = true
+	stub.json(trueFinalsHost+"/api/v1/tournaments/t1", Tournament{
+		ID:      "t1",
+		Title:   "NHRL June 2025 3lb",
+		Players: players,
+		Games: []Game{
+			opener,
+			second,
+			// Finished without a winner
+			scored("Q1-3", "done", 0, 0),
+			scored("Q2W-1", "active", 1, 0),
+			tfGame("Q2W-2", "available", "Zeus", "Mole"),
+		},
+	})
+
+	output, err := resetPreview(map[string]interface{}{"tournament_id": "t1"})
+	if err != nil {
+		t.Fatalf("reset_preview: %v", err)
+	}
+	result := decodeResult(t, output)
+	lose := result["wouldLose"].(map[string]interface{})
+	if lose["completedGames"] != 3.0 || lose["decidedGames"] != 2.0 || lose["gamesWithAnnotations"] != 1.0 {
+		t.Errorf("wouldLose = %v, want 3 completed games, 2 decided, 1 annotated", lose)
+	}
+	if lose["inProgressGames"] != 1.0 || lose["unfinishedWithScores"] != 1.0 || lose["matchCheckIns"] != 2.0 || lose["playerRecordEntries"] != 2.0 {
+		t.Errorf("wouldLose = %v, want 1 in progress with a score, 2 check-ins, 2 recorded results", lose)
+	}
+	if names := result["completedGameNames"].([]interface{}); len(names) != 3 || names[0] != "Q1-1" || names[2] != "Q1-3" {
+		t.Errorf("completedGameNames = %v, want Q1-1 through Q1-3", names)
+	}
+	if result["safeToReset"] != false || result["summary"] != "Reset (bracket_only) would wipe 3 completed games and 1 in-progress games" {
+		t.Errorf("safeToReset = %v, summary = %q", result["safeToReset"], result["summary"])
+	}
+	if result["beyondBracketOnly"] != nil {
+		t.Errorf("beyondBracketOnly = %v, want none for a bracket_only reset", result["beyondBracketOnly"])
+	}
+
+	// An all-mode reset also removes the roster
+	output, err = resetPreview(map[string]interface{}{"tournament_id": "t1", "reset_mode": "all"})
+	if err != nil {
+		t.Fatalf("reset_preview all: %v", err)
+	}
+	beyond := decodeResult(t, output)["beyondBracketOnly"].(map[string]interface{})
+	if beyond["players"] != 4.0 || beyond["seededPlayers"] != 4.0 || beyond["disqualifiedPlayers"] != 1.0 || beyond["playerCheckIns"] != 2.0 {
+		t.Errorf("beyondBracketOnly = %v, want 4 seeded players, 1 disqualified, 2 check-ins", beyond)
+	}
+
+	if _, err := resetPreview(map[string]interface{}{"tournament_id": "t1", "reset_mode": "games"}); err == nil {
+		t.Error("unsupported reset_mode succeeded, want an error")
+	}
+}
+
+func TestIsTestFlagOnTournamentScopedResponses(t *testing.T) {
+	stub := newUpstreamStub(t)
+	titles := map[string]string{
+		"real":  "NHRL June 2025 3lb",
+		"upper": "TEST - Cage 4 Practice",
+		"lower": "nhrl 3lb test bracket",
+	}
+	for id, title := range titles {
+		stub.json(trueFinalsHost+"/api/v1/tournaments/"+id, Tournament{
+			ID:      id,
+			Title:   title,
+			Players: seeded("Lynx", "Zeus"),
+			Games:   []Game{tfGame("Q1-1", "called", "Lynx", "Zeus")},
+		})
+		stub.trueFinalsLists(id, nil, []Game{tfGame("Q1-1", "called", "Lynx", "Zeus")}, seeded("Lynx", "Zeus"))
+	}
+
+	operations := map[string]func(map[string]interface{}) (string, error){
+		"get":                 getTournament,
+		"get_stalled_matches": getStalledMatches,
+		"get_seed_odds":       getBracketSeedOdds,
+		"list_games":          listGames,
+		"list_players":        listPlayers,
+		"get_all_queues":      getAllLocationQueues,
+	}
+	for name, operation := range operations {
+		for id, wantTest := range map[string]bool{"real": false, "upper": true, "lower": true} {
+			output, err := operation(map[string]interface{}{"tournament_id": id})
+			if err != nil {
+				t.Fatalf("%s %s: %v", name, id, err)
+			}
+			flag, present := decodeResult(t, output)["is_test"]
+			switch {
+			case wantTest && flag != true:
+				t.Errorf("%s on %q: is_test = %v, want true", name, titles[id], flag)
+			case !wantTest && present:
+				t.Errorf("%s on %q: is_test = %v, want it absent", name, titles[id], flag)
+			}
+		}
+	}
+}
@@ -3,7 +3,6 @@ package main
 import (
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"regexp"
@@ -18,7 +17,8 @@ const (
 
 // HTTP client for Wiki API with timeout
 var wikiHttpClient = &http.Client{
-	Timeout: 30 * time.Second,
+	Timeout:   30 * time.Second,
+	Transport: upstreamTransport,
 }
 
 // Wiki API response structures
@@ -65,6 +65,30 @@ type WikiPageExtract struct {
 	} `json:"query"`
 }
 
+type WikiRecentChangesResponse struct {
+	Query struct {
+		RecentChanges []struct {
+			Type      string `json:"type"`
+			Ns        int    `json:"ns"`
+			Title     string `json:"title"`
+			Pageid    int    `json:"pageid"`
+			Revid     int    `json:"revid"`
+			OldRevid  int    `json:"old_revid"`
+			User      string `json:"user"`
+			Timestamp string `json:"timestamp"`
+			Comment   string `json:"comment"`
+			OldLen    int    `json:"oldlen"`
+			NewLen    int    `json:"newlen"`
+		} `json:"recentchanges"`
+	} `json:"query"`
+}
+
+// Lookback window for recent_changes, in days
+const (
+	defaultWikiChangesDays = 30
+	maxWikiChangesDays     = 365
+)
+
 // handleNHRLWikiTool handles all NHRL wiki operations
 func handleNHRLWikiTool(args map[string]interface{}) (string, error) {
 	operation, ok := args["operation"].(string)
@@ -84,6 +108,8 @@ func handleNHRLWikiTool(args map[string]interface{}) (string, error) {
 		return getNHRLWikiPage(args)
 	case "get_page_extract":
 		return getNHRLWikiPageExtract(args)
+	case "recent_changes":
+		return getNHRLWikiRecentChanges(args)
 	default:
 		return "", fmt.Errorf("unknown operation: %s", operation)
 	}
@@ -99,6 +125,7 @@ This tool provides access to:
 - Search functionality to find relevant wiki pages
 - Page content retrieval to read specific wiki articles
 - Page extracts for quick summaries
+- Recent edits, to see what changed in the rules
 
 The NHRL wiki contains detailed information about:
 - Robot combat rules and regulations
@@ -117,8 +144,9 @@ The NHRL wiki contains detailed information about:
 
 - search: Search for wiki pages by keywords
 - get_page: Get the full content of a specific wiki page
-- get_page_extract: Get a plain text extract/summary of a wiki page`,
-					"enum": []string{"search", "get_page", "get_page_extract"},
+- get_page_extract: Get a plain text extract/summary of a wiki page
+- recent_changes: List recent edits to main wiki pages with timestamps and edit summaries (optional days lookback and page filter)`,
+					"enum": []string{"search", "get_page", "get_page_extract", "recent_changes"},
 				},
 				"query": map[string]interface{}{
 					"type":        "string",
@@ -128,9 +156,17 @@ The NHRL wiki contains detailed information about:
 					"type":        "string",
 					"description": "The exact title of the wiki page to retrieve (required for get_page and get_page_extract operations). Case-sensitive.",
 				},
+				"page": map[string]interface{}{
+					"type":        "string",
+					"description": "Only show changes to this page title for recent_changes, e.g. a specific rule page. Case-sensitive.",
+				},
+				"days": map[string]interface{}{
+					"type":        "number",
+					"description": "How many days back recent_changes looks. Defaults to 30, max 365.",
+				},
 				"limit": map[string]interface{}{
 					"type":        "number",
-					"description": "Maximum number of search results to return. Defaults to 10, max 50.",
+					"description": "Maximum number of search results or recent changes to return. Defaults to 10, max 50.",
 				},
 			},
 			"required": []string{"operation"},
@@ -167,7 +203,7 @@ func searchNHRLWiki(args map[string]interface{}) (string, error) {
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	body, err := readResponseBody(resp)
 	if err != nil {
 		return "", fmt.Errorf("failed to read response: %w", err)
 	}
@@ -233,7 +269,7 @@ func getNHRLWikiPage(args map[string]interface{}) (string, error) {
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	body, err := readResponseBody(resp)
 	if err != nil {
 		return "", fmt.Errorf("failed to read response: %w", err)
 	}
@@ -306,7 +342,7 @@ func getNHRLWikiPageExtract(args map[string]interface{}) (string, error) {
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	body, err := readResponseBody(resp)
 	if err != nil {
 		return "", fmt.Errorf("failed to read response: %w", err)
 	}
@@ -348,3 +384,95 @@ func getNHRLWikiPageExtract(args map[string]interface{}) (string, error) {
 
 	return string(jsonData), nil
 }
+
+// getNHRLWikiRecentChanges lists recent edits to main namespace wiki pages
+func getNHRLWikiRecentChanges(args map[string]interface{}) (string, error) {
+	days := defaultWikiChangesDays
+	if d, ok := args["days"].(float64); ok && d > 0 {
+		days = int(d)
+		if days > maxWikiChangesDays {
+			days = maxWikiChangesDays
+		}
+	}
+
+	limit := 10
+	if l, ok := args["limit"].(float64); ok && l > 0 && l <= 50 {
+		limit = int(l)
+	}
+
+	page, _ := args["page"].(string)
+	page = strings.TrimSpace(page)
+
+	since := time.Now().UTC().AddDate(0, 0, -days)
+
+	// Build query parameters; changes are listed newest first back to rcend
+	params := url.Values{}
+	params.Set("action", "query")
+	params.Set("list", "recentchanges")
+	params.Set("rcnamespace", "0")
+	params.Set("rctype", "edit|new")
+	params.Set("rcprop", "title|ids|timestamp|comment|user|sizes")
+	params.Set("rcend", since.Format(time.RFC3339))
+	params.Set("rclimit", fmt.Sprintf("%d", limit))
+	params.Set("format", "json")
+	if page != "" {
+		params.Set("rctitle", page)
+	}
+
+	// Make API request
+	resp, err := wikiHttpClient.Get(WikiBaseURL + "?" + params.Encode())
+	if err != nil {
+		return "", fmt.Errorf("failed to get recent changes: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := readResponseBody(resp)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+
+	// Check for error in response
+	var errorResp map[string]interface{}
+	if err := json.Unmarshal(body, &errorResp); err == nil {
+		if errInfo, ok := errorResp["error"].(map[string]interface{}); ok {
+			return "", fmt.Errorf("wiki API error: %v", errInfo["info"])
+		}
+	}
+
+	var changesResp WikiRecentChangesResponse
+	if err := json.Unmarshal(body, &changesResp); err != nil {
+		return "", fmt.Errorf("failed to parse recent changes response: %w", err)
+	}
+
+	changes := make([]map[string]interface{}, 0, len(changesResp.Query.RecentChanges))
+	for _, change := range changesResp.Query.RecentChanges {
+		changes = append(changes, map[string]interface{}{
+			"title":      change.Title,
+			"pageid":     change.Pageid,
+			"type":       change.Type,
+			"timestamp":  change.Timestamp,
+			"user":       change.User,
+			"summary":    change.Comment,
+			"size_delta": change.NewLen - change.OldLen,
+			"url":        fmt.Sprintf("https://wiki.nhrl.io/wiki/index.php/%s", url.QueryEscape(strings.ReplaceAll(change.Title, " ", "_"))),
+			"diff_url":   fmt.Sprintf("https://wiki.nhrl.io/wiki/index.php?diff=%d&oldid=%d", change.Revid, change.OldRevid),
+		})
+	}
+
+	output := map[string]interface{}{
+		"days":         days,
+		"since":        since.Format(time.RFC3339),
+		"change_count": len(changes),
+		"changes":      changes,
+	}
+	if page != "" {
+		output["page"] = page
+	}
+
+	jsonData, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal results: %w", err)
+	}
+
+	return string(jsonData), nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWikiRecentChanges(t *testing.T) {
+	stub := newUpstreamStub(t)
+	var query map[string]string
+	stub.handle(wikiHost+"/wiki/api.php", func(w http.ResponseWriter, r *http.Request) {
+		query = map[string]string{}
+		for key := range r.URL.Query() {
+			query[key] = r.URL.Query().Get(key)
+		}
+		writeJSON(w, map[string]interface{}{"query": map[string]interface{}{"recentchanges": []map[string]interface{}{
+			{"type": "edit", "ns": 0, "title": "Rules and Regulations", "pageid": 12, "revid": 905, "old_revid": 880,
+				"user": "RulesBot", "timestamp": "2025-06-10T14:00:00Z", "comment": "Clarify spinner weapon limits", "oldlen": 2000, "newlen": 2150},
+			{"type": "new", "ns": 0, "title": "Cage Safety", "pageid": 40, "revid": 870, "old_revid": 0,
+				"user": "Admin", "timestamp": "2025-06-02T09:30:00Z", "comment": "New page", "oldlen": 0, "newlen": 800},
+		}}})
+	})
+
+	before := time.Now().UTC()
+	output, err := getNHRLWikiRecentChanges(map[string]interface{}{"days": 7.0, "page": "Rules and Regulations"})
+	if err != nil {
+		t.Fatalf("recent_changes: %v", err)
+	}
+	if query["list"] != "recentchanges" || query["rcnamespace"] != "0" || query["rctitle"] != "Rules and Regulations" {
+		t.Errorf("query = %v, want a main namespace recentchanges query for the page", query)
+	}
+	rcend, err := time.Parse(time.RFC3339, query["rcend"])
+	if err != nil {
+		t.Fatalf("rcend: %v", err)
+	}
+	if want := before.AddDate(0, 0, -7); rcend.Sub(want) > time.Second || want.Sub(rcend) > time.Second {
+		t.Errorf("rcend = %s, want seven days back (%s)", rcend, want.Format(time.RFC3339))
+	}
+
+	result := decodeResult(t, output)
+	if result["days"] != 7.0 || result["page"] != "Rules and Regulations" || result["change_count"] != 2.0 {
+		t.Fatalf("result = %v, want 2 changes over 7 days for the page", result)
+	}
+	change := result["changes"].([]interface{})[0].(map[string]interface{})
+	if change["title"] != "Rules and Regulations" || change["timestamp"] != "2025-06-10T14:00:00Z" || change["summary"] != "Clarify spinner weapon limits" {
+		t.Errorf("first change = %v, want the rules edit with its timestamp and summary", change)
+	}
+	if change["size_delta"] != 150.0 || change["diff_url"] != "https://wiki.nhrl.io/wiki/index.php?diff=905&oldid=880" {
+		t.Errorf("first change size_delta = %v, diff_url = %v; want 150 and the 880 to 905 diff", change["size_delta"], change["diff_url"])
+	}
+
+	// The lookback is capped, and no page filter is sent without a page
+	if _, err := getNHRLWikiRecentChanges(map[string]interface{}{"days": 1000.0}); err != nil {
+		t.Fatalf("recent_changes: %v", err)
+	}
+	if _, ok := query["rctitle"]; ok {
+		t.Errorf("rctitle = %q, want no page filter", query["rctitle"])
+	}
+	if rcend, _ := time.Parse(time.RFC3339, query["rcend"]); rcend.Before(before.AddDate(0, 0, -maxWikiChangesDays).Add(-time.Second)) {
+		t.Errorf("rcend = %s, want at most %d days back", rcend, maxWikiChangesDays)
+	}
+}
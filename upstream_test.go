@@ -0,0 +1,308 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+// Upstream hosts as the stub sees them (the Host header of the original request)
+const (
+	trueFinalsHost = "truefinals.com"
+	statsbookHost  = "stats.nhrl.io"
+	brettZoneHost  = "brettzone.nhrl.io"
+	wikiHost       = "wiki.nhrl.io"
+)
+
+// upstreamStub serves canned responses for every upstream service. Routes are
+// keyed by host and path ("stats.nhrl.io/statsbook/get_fights.php"); requests
+// to unknown routes get a 404 so a missing fixture fails loudly.
+type upstreamStub struct {
+	t      *testing.T
+	mu     sync.Mutex
+	routes map[string]http.HandlerFunc
+	calls  map[string]int
+}
+
+// redirectTransport sends every request to the stub server, keeping the
+// original Host header so the stub can tell the upstreams apart
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (rt redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	redirected := req.Clone(req.Context())
+	redirected.URL.Scheme = rt.target.Scheme
+	redirected.URL.Host = rt.target.Host
+	if redirected.Host == "" {
+		redirected.Host = req.URL.Host
+	}
+	return http.DefaultTransport.RoundTrip(redirected)
+}
+
+// newUpstreamStub points all upstream HTTP clients at a fresh stub server with
+// empty caches and closed circuit breakers, restoring everything when the test ends
+func newUpstreamStub(t *testing.T) *upstreamStub {
+	t.Helper()
+	stub := &upstreamStub{
+		t:      t,
+		routes: make(map[string]http.HandlerFunc),
+		calls:  make(map[string]int),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Host + r.URL.Path
+		stub.mu.Lock()
+		handler, ok := stub.routes[key]
+		stub.calls[key]++
+		stub.mu.Unlock()
+		if !ok {
+			http.Error(w, "no stub for "+key, http.StatusNotFound)
+			return
+		}
+		handler(w, r)
+	}))
+	target, _ := url.Parse(server.URL)
+
+	previousBase := upstreamTransport.base
+	upstreamTransport.base = redirectTransport{target: target}
+	resetUpstreamState()
+	t.Cleanup(func() {
+		server.Close()
+		upstreamTransport.base = previousBase
+		resetUpstreamState()
+	})
+	return stub
+}
+
+// resetUpstreamState clears the response caches and circuit breakers
+func resetUpstreamState() {
+	for _, cache := range []*ttlCache{nhrlCache, rosterCache, botProfileCache} {
+		cache.mu.Lock()
+		cache.entries = make(map[string]ttlCacheEntry)
+		cache.mu.Unlock()
+	}
+	upstreamTransport.mu.Lock()
+	upstreamTransport.breakers = make(map[string]*circuitBreaker)
+	upstreamTransport.mu.Unlock()
+}
+
+// handle registers a handler for host+path
+func (s *upstreamStub) handle(hostPath string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[hostPath] = handler
+}
+
+// json registers a route that always answers with value encoded as JSON
+func (s *upstreamStub) json(hostPath string, value interface{}) {
+	s.handle(hostPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, value)
+	})
+}
+
+// count reports how many requests reached host+path
+func (s *upstreamStub) count(hostPath string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[hostPath]
+}
+
+// statsbook registers a statsbook endpoint (e.g. "get_fights.php")
+func (s *upstreamStub) statsbook(endpoint string, handler http.HandlerFunc) {
+	s.handle(statsbookHost+"/statsbook/"+endpoint, handler)
+}
+
+// statsbookByBot registers a statsbook endpoint answering per bot_name query value
+func (s *upstreamStub) statsbookByBot(endpoint string, byBot map[string]interface{}) {
+	s.statsbook(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		value, ok := byBot[r.URL.Query().Get("bot_name")]
+		if !ok {
+			value = []interface{}{}
+		}
+		writeJSON(w, value)
+	})
+}
+
+// statSummaryByClass registers get_stat_summary.php answering per category_id
+// (see getWeightClassCategoryID); unlisted classes are empty
+func (s *upstreamStub) statSummaryByClass(byCategory map[string][]NHRLStatSummary) {
+	s.statsbook("get_stat_summary.php", func(w http.ResponseWriter, r *http.Request) {
+		rows, ok := byCategory[r.URL.Query().Get("category_id")]
+		if !ok {
+			rows = []NHRLStatSummary{}
+		}
+		writeJSON(w, rows)
+	})
+}
+
+// liveStats registers the live fight stats form endpoint, answering each
+// bot1/bot2 POST with the stat rows for whichever of the two bots are in byBot
+func (s *upstreamStub) liveStats(byBot map[string]NHRLLiveFightStats) {
+	s.handle(statsbookHost+"/live_stats/query/get_fight_stats.php", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		rows := []NHRLLiveFightStats{}
+		for _, name := range []string{r.PostForm.Get("bot1"), r.PostForm.Get("bot2")} {
+			if stat, ok := byBot[name]; ok {
+				stat.BotName = name
+				rows = append(rows, stat)
+			}
+		}
+		writeJSON(w, rows)
+	})
+}
+
+// brettZoneMatches registers getLatestMatches.php answering per tournamentID
+func (s *upstreamStub) brettZoneMatches(byTournament map[string][]BrettZoneMatch) {
+	s.handle(brettZoneHost+"/brettZone/backend/getLatestMatches.php", func(w http.ResponseWriter, r *http.Request) {
+		matches, ok := byTournament[r.URL.Query().Get("tournamentID")]
+		if !ok {
+			matches = []BrettZoneMatch{}
+		}
+		writeJSON(w, matches)
+	})
+}
+
+// fightHistory is a set of statsbook fight histories whose video links point
+// at BrettZone matches, served the way getNHRLFightsResolved reads them
+type fightHistory struct {
+	fights  map[string][]NHRLFight
+	matches map[string][]BrettZoneMatch
+}
+
+// fightHistories registers get_fights.php and getLatestMatches.php answering
+// from a fightHistory that can be filled in after registration
+func (s *upstreamStub) fightHistories() *fightHistory {
+	h := &fightHistory{fights: make(map[string][]NHRLFight), matches: make(map[string][]BrettZoneMatch)}
+	s.statsbook("get_fights.php", func(w http.ResponseWriter, r *http.Request) {
+		fights, ok := h.fights[r.URL.Query().Get("bot_name")]
+		if !ok {
+			fights = []NHRLFight{}
+		}
+		writeJSON(w, fights)
+	})
+	s.handle(brettZoneHost+"/brettZone/backend/getLatestMatches.php", func(w http.ResponseWriter, r *http.Request) {
+		matches, ok := h.matches[r.URL.Query().Get("tournamentID")]
+		if !ok {
+			matches = []BrettZoneMatch{}
+		}
+		writeJSON(w, matches)
+	})
+	return h
+}
+
+// fight adds match to its tournament and a statsbook row for bot on date that
+// links to it, taking the round, method, and length from the match
+func (h *fightHistory) fight(bot, date string, match BrettZoneMatch) {
+	h.match(match)
+	fight := NHRLFight{Date: date, Round: match.Round, ResultBy: match.WinAnnotation, VideoLink: reviewLink(match.ID, match.TournamentID)}
+	if match.MatchLength != "" {
+		fight.FightLengthSecs = strPtr(match.MatchLength)
+	}
+	h.fights[bot] = append(h.fights[bot], fight)
+}
+
+// match adds BrettZone matches with no statsbook row, skipping ones already added
+func (h *fightHistory) match(matches ...BrettZoneMatch) {
+	for _, match := range matches {
+		known := false
+		for _, m := range h.matches[match.TournamentID] {
+			known = known || m.ID == match.ID
+		}
+		if !known {
+			h.matches[match.TournamentID] = append(h.matches[match.TournamentID], match)
+		}
+	}
+}
+
+// unlinked adds a statsbook row with no BrettZone match behind it
+func (h *fightHistory) unlinked(bot string, fight NHRLFight) {
+	h.fights[bot] = append(h.fights[bot], fight)
+}
+
+// trueFinals registers a TrueFinals API path (e.g. "/v1/tournaments/t1")
+func (s *upstreamStub) trueFinals(path string, handler http.HandlerFunc) {
+	s.handle(trueFinalsHost+"/api"+path, handler)
+}
+
+// trueFinalsLists registers a tournament's locations, games, and players lists
+func (s *upstreamStub) trueFinalsLists(tournamentID string, locations []Location, games []Game, players []Player) {
+	if locations == nil {
+		locations = []Location{}
+	}
+	if games == nil {
+		games = []Game{}
+	}
+	if players == nil {
+		players = []Player{}
+	}
+	base := trueFinalsHost + "/api/v1/tournaments/" + tournamentID
+	s.json(base+"/locations", locations)
+	s.json(base+"/games", games)
+	s.json(base+"/players", players)
+}
+
+// tfGame builds a TrueFinals game fixture with one slot per player ID
+func tfGame(id, state string, playerIDs ...string) Game {
+	game := Game{ID: id, Name: id, State: state}
+	for i, playerID := range playerIDs {
+		game.Slots = append(game.Slots, GameSlot{GameID: id, SlotIdx: i, PlayerID: strPtr(playerID)})
+	}
+	return game
+}
+
+// tfPlayers builds TrueFinals player fixtures; each name doubles as its ID
+func tfPlayers(names ...string) []Player {
+	players := make([]Player, len(names))
+	for i, name := range names {
+		players[i] = Player{ID: name, Name: name}
+	}
+	return players
+}
+
+func int64Ptr(v int64) *int64 { return &v }
+
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(value)
+}
+
+// gzipJSON encodes value as gzip-compressed JSON
+func gzipJSON(t *testing.T, value interface{}) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(value); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("gzip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// decodeResult unmarshals a tool's JSON output
+func decodeResult(t *testing.T, output string) map[string]interface{} {
+	t.Helper()
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("tool output is not a JSON object: %v\n%s", err, output)
+	}
+	return result
+}
+
+// reviewLink builds a BrettZone review link for a statsbook fight fixture
+func reviewLink(gameID, tournamentID string) *string {
+	link := generateBrettZoneReviewURL(gameID, tournamentID, 1, 0)
+	return &link
+}
+
+func strPtr(s string) *string { return &s }